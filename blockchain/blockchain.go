@@ -22,8 +22,10 @@ import (
 )
 
 const (
-	BlockGasTargetDivisor uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
-	defaultCacheSize      int    = 100  // The default size for Blockchain LRU cache structures
+	BlockGasTargetDivisor    uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
+	BaseFeeChangeDenominator uint64 = 8    // The bound divisor of the base fee, used in update calculations
+	ElasticityMultiplier     uint64 = 2    // The ratio between the gas limit and the gas target of a block
+	defaultCacheSize         int    = 100  // The default size for Blockchain LRU cache structures
 )
 
 var (
@@ -38,6 +40,7 @@ var (
 	ErrInvalidStateRoot     = errors.New("invalid block state root")
 	ErrInvalidGasUsed       = errors.New("invalid block gas used")
 	ErrInvalidReceiptsRoot  = errors.New("invalid block receipts root")
+	ErrInvalidBaseFee       = errors.New("invalid block base fee")
 )
 
 // Blockchain is a blockchain reference
@@ -291,6 +294,10 @@ func (b *Blockchain) ComputeGenesis() error {
 		}
 	}
 
+	if err := b.initLogIndex(); err != nil {
+		return err
+	}
+
 	b.logger.Info("genesis", "hash", b.config.Genesis.Hash())
 
 	return nil
@@ -300,6 +307,11 @@ func (b *Blockchain) GetConsensus() Verifier {
 	return b.consensus
 }
 
+// Storage returns the storage of the blockchain, which the consensus shares
+func (b *Blockchain) Storage() storage.Storage {
+	return b.db
+}
+
 // SetConsensus sets the consensus
 func (b *Blockchain) SetConsensus(c Verifier) {
 	b.consensus = c
@@ -402,6 +414,65 @@ func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
 	return common.Max(blockGasTarget, common.Max(parentGasLimit-delta, 0))
 }
 
+// CalculateBaseFee returns the base fee of the next block after parent (EIP-1559).
+// The base fee goes up when the parent used more gas than the gas target, and down when it used less
+func (b *Blockchain) CalculateBaseFee(parent *types.Header) uint64 {
+	forks := b.config.Params.Forks
+	if !forks.IsLondon(parent.Number + 1) {
+		return 0
+	}
+
+	// the first block of the fork starts from the initial base fee,
+	// as well as the blocks after a parent without base fee (london at genesis with no genesis base fee)
+	if !forks.IsLondon(parent.Number) || parent.BaseFee == 0 {
+		return b.initialBaseFee()
+	}
+
+	parentGasTarget := parent.GasLimit / ElasticityMultiplier
+
+	// Check if the parent used exactly the gas target
+	if parent.GasUsed == parentGasTarget {
+		return parent.BaseFee
+	}
+
+	if parent.GasUsed > parentGasTarget {
+		// The parent used more gas than the target,
+		// so the base fee should increase by at least 1
+		delta := baseFeeDelta(parent.BaseFee, parent.GasUsed-parentGasTarget, parentGasTarget)
+
+		return parent.BaseFee + common.Max(delta, 1)
+	}
+
+	// The parent used less gas than the target,
+	// so the base fee should decrease
+	delta := baseFeeDelta(parent.BaseFee, parentGasTarget-parent.GasUsed, parentGasTarget)
+
+	return parent.BaseFee - common.Min(delta, parent.BaseFee)
+}
+
+// initialBaseFee returns the base fee of the first block of the london fork
+func (b *Blockchain) initialBaseFee() uint64 {
+	if b.config.Genesis.BaseFee != 0 {
+		return b.config.Genesis.BaseFee
+	}
+
+	return chain.GenesisBaseFee
+}
+
+// baseFeeDelta calculates baseFee * gasUsedDelta / gasTarget / BaseFeeChangeDenominator
+func baseFeeDelta(baseFee, gasUsedDelta, gasTarget uint64) uint64 {
+	if gasTarget == 0 {
+		return 0
+	}
+
+	delta := new(big.Int).SetUint64(baseFee)
+	delta.Mul(delta, new(big.Int).SetUint64(gasUsedDelta))
+	delta.Div(delta, new(big.Int).SetUint64(gasTarget))
+	delta.Div(delta, new(big.Int).SetUint64(BaseFeeChangeDenominator))
+
+	return delta.Uint64()
+}
+
 // writeGenesis wrapper for the genesis write function
 func (b *Blockchain) writeGenesis(genesis *chain.Genesis) error {
 	header := genesis.GenesisHeader()
@@ -708,6 +779,7 @@ func (b *Blockchain) verifyBlock(block *types.Block) error {
 // - The hashes match up
 // - The block numbers match up
 // - The block gas limit / used matches up
+// - The block base fee matches up
 func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 	// Grab the parent block
 	parentHash := childBlock.ParentHash()
@@ -750,6 +822,11 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 		return fmt.Errorf("invalid gas limit, %w", gasLimitErr)
 	}
 
+	// Make sure the base fee follows the parent
+	if expected := b.CalculateBaseFee(parent); childBlock.Header.BaseFee != expected {
+		return fmt.Errorf("%w, expected %d but found %d", ErrInvalidBaseFee, expected, childBlock.Header.BaseFee)
+	}
+
 	return nil
 }
 
@@ -894,6 +971,10 @@ func (b *Blockchain) WriteBlock(block *types.Block) error {
 		return err
 	}
 
+	if err := b.db.WriteLogIndex(header.Number, blockReceipts); err != nil {
+		return err
+	}
+
 	//	update snapshot
 	if err := b.consensus.ProcessHeaders([]*types.Header{header}); err != nil {
 		return err
@@ -954,7 +1035,7 @@ func (b *Blockchain) updateGasPriceAvgWithBlock(block *types.Block) {
 
 	gasPrices := make([]*big.Int, len(block.Transactions))
 	for i, transaction := range block.Transactions {
-		gasPrices[i] = transaction.GasPrice
+		gasPrices[i] = transaction.EffectiveGasPrice(block.Header.BaseFee)
 	}
 
 	b.updateGasPriceAvg(gasPrices)
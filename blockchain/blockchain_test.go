@@ -633,6 +633,99 @@ func TestCalculateGasLimit(t *testing.T) {
 	}
 }
 
+func TestCalculateBaseFee(t *testing.T) {
+	tests := []struct {
+		name            string
+		parentNumber    uint64
+		parentBaseFee   uint64
+		parentGasLimit  uint64
+		parentGasUsed   uint64
+		genesisBaseFee  uint64
+		expectedBaseFee uint64
+	}{
+		{
+			name:            "should be zero before the london fork",
+			parentNumber:    3,
+			expectedBaseFee: 0,
+		},
+		{
+			name:            "should use the default initial base fee in the first london block",
+			parentNumber:    4,
+			expectedBaseFee: chain.GenesisBaseFee,
+		},
+		{
+			name:            "should use the genesis base fee in the first london block",
+			parentNumber:    4,
+			genesisBaseFee:  500,
+			expectedBaseFee: 500,
+		},
+		{
+			name:            "should not change when the parent used the gas target",
+			parentNumber:    10,
+			parentBaseFee:   1000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   10000000,
+			expectedBaseFee: 1000,
+		},
+		{
+			name:            "should increase by 12.5% when the parent is full",
+			parentNumber:    10,
+			parentBaseFee:   1000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   20000000,
+			expectedBaseFee: 1125,
+		},
+		{
+			name:            "should decrease by 12.5% when the parent is empty",
+			parentNumber:    10,
+			parentBaseFee:   1000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   0,
+			expectedBaseFee: 875,
+		},
+		{
+			name:            "should use the initial base fee after a parent without base fee",
+			parentNumber:    10,
+			parentGasLimit:  20000000,
+			parentGasUsed:   20000000,
+			expectedBaseFee: chain.GenesisBaseFee,
+		},
+		{
+			name:            "should increase by at least 1",
+			parentNumber:    10,
+			parentBaseFee:   1,
+			parentGasLimit:  20000000,
+			parentGasUsed:   10000001,
+			expectedBaseFee: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Blockchain{
+				config: &chain.Chain{
+					Genesis: &chain.Genesis{
+						BaseFee: tt.genesisBaseFee,
+					},
+					Params: &chain.Params{
+						Forks: &chain.Forks{
+							London: chain.NewFork(5),
+						},
+					},
+				},
+			}
+
+			baseFee := b.CalculateBaseFee(&types.Header{
+				Number:   tt.parentNumber,
+				BaseFee:  tt.parentBaseFee,
+				GasLimit: tt.parentGasLimit,
+				GasUsed:  tt.parentGasUsed,
+			})
+			assert.Equal(t, tt.expectedBaseFee, baseFee)
+		})
+	}
+}
+
 // TestGasPriceAverage tests the average gas price of the
 // blockchain
 func TestGasPriceAverage(t *testing.T) {
@@ -844,6 +937,35 @@ func TestBlockchain_VerifyBlockParent(t *testing.T) {
 
 		assert.Error(t, blockchain.verifyBlockParent(block))
 	})
+
+	t.Run("Invalid block base fee", func(t *testing.T) {
+		t.Parallel()
+
+		// Set up the storage callback
+		storageCallback := func(storage *storage.MockStorage) {
+			storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+				return emptyHeader, nil
+			})
+		}
+
+		blockchain, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+			StorageCallback: storageCallback,
+		})
+		if err != nil {
+			t.Fatalf("unable to instantiate new blockchain, %v", err)
+		}
+
+		// Create a dummy block with a base fee before the london fork
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     1,
+				ParentHash: emptyHeader.Hash,
+				BaseFee:    chain.GenesisBaseFee,
+			},
+		}
+
+		assert.ErrorIs(t, blockchain.verifyBlockParent(block), ErrInvalidBaseFee)
+	})
 }
 
 // TestBlockchain_VerifyBlockBody makes sure that the block body is verified correctly
@@ -0,0 +1,188 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// logIndexBackfillLogInterval is the number of backfilled blocks between progress logs
+const logIndexBackfillLogInterval = 10000
+
+// initLogIndex sets the tail of the log index, if missing.
+// Fresh storages are indexed from the genesis, while storages written
+// before the log index are indexed from the next block until they are backfilled
+func (b *Blockchain) initLogIndex() error {
+	if _, ok := b.db.ReadLogIndexTail(); ok {
+		return nil
+	}
+
+	tail := b.Header().Number + 1
+	if tail == 1 {
+		// the genesis has no logs
+		tail = 0
+	}
+
+	return b.db.WriteLogIndexTail(tail)
+}
+
+// LogIndexTail returns the first block covered by the log index.
+// The logs of the blocks below the tail are not indexed yet
+func (b *Blockchain) LogIndexTail() uint64 {
+	tail, ok := b.db.ReadLogIndexTail()
+	if !ok {
+		return b.Header().Number + 1
+	}
+
+	return tail
+}
+
+// MatchLogBlocks returns the numbers of the blocks in the range which could have logs
+// matching the addresses and the topics, in ascending order.
+// A block matches if it has logs emitted by any of the addresses and, for each position,
+// logs with any of the topics at that position. The blocks of forks dropped by a reorg
+// may be returned as well, so the logs of the matched blocks should still be filtered
+func (b *Blockchain) MatchLogBlocks(
+	from, to uint64,
+	addresses []types.Address,
+	topics [][]types.Hash,
+) ([]uint64, error) {
+	constraints := [][][]byte{}
+
+	if len(addresses) > 0 {
+		terms := make([][]byte, len(addresses))
+		for i, addr := range addresses {
+			terms[i] = storage.LogIndexAddressTerm(addr)
+		}
+
+		constraints = append(constraints, terms)
+	}
+
+	for position, sub := range topics {
+		if len(sub) == 0 {
+			// any topic
+			continue
+		}
+
+		terms := make([][]byte, len(sub))
+		for i, topic := range sub {
+			terms[i] = storage.LogIndexTopicTerm(position, topic)
+		}
+
+		constraints = append(constraints, terms)
+	}
+
+	if len(constraints) == 0 {
+		constraints = append(constraints, [][]byte{storage.LogIndexAnyTerm()})
+	}
+
+	matches := []uint64{}
+
+	for section := from / storage.LogIndexSectionSize; section <= to/storage.LogIndexSectionSize; section++ {
+		numbers, err := b.matchLogIndexSection(section, constraints)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, num := range numbers {
+			if num >= from && num <= to {
+				matches = append(matches, num)
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// matchLogIndexSection returns the blocks of the section matching all the constraints
+func (b *Blockchain) matchLogIndexSection(section uint64, constraints [][][]byte) ([]uint64, error) {
+	var matches map[uint64]bool
+
+	for _, terms := range constraints {
+		union := map[uint64]bool{}
+
+		for _, term := range terms {
+			numbers, err := b.db.ReadLogIndex(term, section)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, num := range numbers {
+				if matches == nil || matches[num] {
+					union[num] = true
+				}
+			}
+		}
+
+		matches = union
+
+		if len(matches) == 0 {
+			return nil, nil
+		}
+	}
+
+	numbers := make([]uint64, 0, len(matches))
+	for num := range matches {
+		numbers = append(numbers, num)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+
+	return numbers, nil
+}
+
+// BackfillLogIndex indexes the logs of the canonical blocks below the tail of the log index,
+// moving the tail down to the genesis. It returns the number of backfilled blocks
+func BackfillLogIndex(db storage.Storage, logger hclog.Logger) (uint64, error) {
+	tail, ok := db.ReadLogIndexTail()
+	if !ok {
+		head, ok := db.ReadHeadNumber()
+		if !ok {
+			// empty storage, blocks are indexed as they are written
+			return 0, db.WriteLogIndexTail(0)
+		}
+
+		tail = head + 1
+	}
+
+	backfilled := uint64(0)
+
+	for tail > 0 {
+		num := tail - 1
+
+		hash, ok := db.ReadCanonicalHash(num)
+		if !ok {
+			return backfilled, fmt.Errorf("canonical hash of block %d not found", num)
+		}
+
+		// blocks without receipts, like the genesis, have no logs
+		receipts, err := db.ReadReceipts(hash)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return backfilled, fmt.Errorf("unable to read receipts of block %d, %w", num, err)
+		}
+
+		if err := db.WriteLogIndex(num, receipts); err != nil {
+			return backfilled, err
+		}
+
+		// the tail only moves once the block is indexed, so the backfill can be resumed
+		if err := db.WriteLogIndexTail(num); err != nil {
+			return backfilled, err
+		}
+
+		tail = num
+		backfilled++
+
+		if backfilled%logIndexBackfillLogInterval == 0 {
+			logger.Info("backfilling log index", "block", num)
+		}
+	}
+
+	return backfilled, nil
+}
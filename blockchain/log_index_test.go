@@ -0,0 +1,180 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	logAddr1 = types.StringToAddress("1")
+	logAddr2 = types.StringToAddress("2")
+
+	logTopic1 = types.StringToHash("1")
+	logTopic2 = types.StringToHash("2")
+)
+
+// receiptsWithLog returns the receipts of a block with a single log
+func receiptsWithLog(addr types.Address, topics ...types.Hash) []*types.Receipt {
+	return []*types.Receipt{
+		{
+			Logs: []*types.Log{
+				{
+					Address: addr,
+					Topics:  topics,
+				},
+			},
+		},
+	}
+}
+
+func TestBlockchain_MatchLogBlocks(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(nil)
+	assert.NoError(t, err)
+
+	b := &Blockchain{
+		db: db,
+	}
+
+	blocks := map[uint64][]*types.Receipt{
+		1:                                 receiptsWithLog(logAddr1, logTopic1),
+		2:                                 receiptsWithLog(logAddr2, logTopic1, logTopic2),
+		3:                                 {{}},
+		storage.LogIndexSectionSize + 5:   receiptsWithLog(logAddr1, logTopic2),
+		2*storage.LogIndexSectionSize + 1: receiptsWithLog(logAddr2),
+	}
+
+	for num, receipts := range blocks {
+		assert.NoError(t, db.WriteLogIndex(num, receipts))
+	}
+
+	testTable := []struct {
+		name      string
+		from      uint64
+		to        uint64
+		addresses []types.Address
+		topics    [][]types.Hash
+		expected  []uint64
+	}{
+		{
+			"any log",
+			0,
+			3 * storage.LogIndexSectionSize,
+			nil,
+			nil,
+			[]uint64{1, 2, storage.LogIndexSectionSize + 5, 2*storage.LogIndexSectionSize + 1},
+		},
+		{
+			"range within a section",
+			2,
+			storage.LogIndexSectionSize + 5,
+			nil,
+			nil,
+			[]uint64{2, storage.LogIndexSectionSize + 5},
+		},
+		{
+			"single address",
+			0,
+			3 * storage.LogIndexSectionSize,
+			[]types.Address{logAddr1},
+			nil,
+			[]uint64{1, storage.LogIndexSectionSize + 5},
+		},
+		{
+			"any of the addresses",
+			0,
+			storage.LogIndexSectionSize,
+			[]types.Address{logAddr1, logAddr2},
+			nil,
+			[]uint64{1, 2},
+		},
+		{
+			"topic at the position",
+			0,
+			3 * storage.LogIndexSectionSize,
+			nil,
+			[][]types.Hash{{}, {logTopic2}},
+			[]uint64{2},
+		},
+		{
+			"address and topic",
+			0,
+			3 * storage.LogIndexSectionSize,
+			[]types.Address{logAddr1},
+			[][]types.Hash{{logTopic1, logTopic2}},
+			[]uint64{1, storage.LogIndexSectionSize + 5},
+		},
+		{
+			"no match",
+			0,
+			3 * storage.LogIndexSectionSize,
+			[]types.Address{logAddr2},
+			[][]types.Hash{{logTopic2}},
+			[]uint64{},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			matches, err := b.MatchLogBlocks(testCase.from, testCase.to, testCase.addresses, testCase.topics)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, matches)
+		})
+	}
+}
+
+func TestBackfillLogIndex(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(nil)
+	assert.NoError(t, err)
+
+	// a chain written before the log index, the genesis has no receipts
+	blocks := [][]*types.Receipt{
+		nil,
+		receiptsWithLog(logAddr1),
+		{},
+		receiptsWithLog(logAddr2),
+	}
+
+	for num, receipts := range blocks {
+		hash := types.BytesToHash([]byte{byte(num + 1)})
+
+		assert.NoError(t, db.WriteCanonicalHash(uint64(num), hash))
+
+		if receipts != nil {
+			assert.NoError(t, db.WriteReceipts(hash, receipts))
+		}
+	}
+
+	assert.NoError(t, db.WriteHeadNumber(uint64(len(blocks)-1)))
+
+	backfilled, err := BackfillLogIndex(db, hclog.NewNullLogger())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(len(blocks)), backfilled)
+
+	b := &Blockchain{
+		db: db,
+	}
+
+	assert.Equal(t, uint64(0), b.LogIndexTail())
+
+	matches, err := b.MatchLogBlocks(0, uint64(len(blocks)-1), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3}, matches)
+
+	// nothing left to backfill
+	backfilled, err = BackfillLogIndex(db, hclog.NewNullLogger())
+	assert.NoError(t, err)
+	assert.Zero(t, backfilled)
+}
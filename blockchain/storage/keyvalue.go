@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -39,6 +40,9 @@ var (
 
 	// TX_LOOKUP_PREFIX is the prefix for transaction lookups
 	TX_LOOKUP_PREFIX = []byte("l")
+
+	// LOG_INDEX is the prefix for the log index entries
+	LOG_INDEX = []byte("i")
 )
 
 // Sub-prefixes
@@ -46,6 +50,8 @@ var (
 	HASH   = []byte("hash")
 	NUMBER = []byte("number")
 	EMPTY  = []byte("empty")
+	TAIL   = []byte("tail")
+	META   = []byte("meta")
 )
 
 // KV is a key value storage interface.
@@ -55,6 +61,7 @@ type KV interface {
 	Close() error
 	Set(p []byte, v []byte) error
 	Get(p []byte) ([]byte, bool, error)
+	Delete(p []byte) error
 }
 
 // KeyValueStorage is a generic storage for kv databases
@@ -238,6 +245,31 @@ func (s *KeyValueStorage) ReadSnapshot(hash types.Hash) ([]byte, bool) {
 	return data, true
 }
 
+// WriteSnapshotByNumber writes the consensus snapshot taken at the block number to the DB
+func (s *KeyValueStorage) WriteSnapshotByNumber(n uint64, blob []byte) error {
+	return s.set(SNAPSHOTS, append(NUMBER, s.encodeUint(n)...), blob)
+}
+
+// ReadSnapshotByNumber reads the consensus snapshot taken at the block number from the DB
+func (s *KeyValueStorage) ReadSnapshotByNumber(n uint64) ([]byte, bool) {
+	return s.get(SNAPSHOTS, append(NUMBER, s.encodeUint(n)...))
+}
+
+// DeleteSnapshotByNumber deletes the consensus snapshot taken at the block number from the DB
+func (s *KeyValueStorage) DeleteSnapshotByNumber(n uint64) error {
+	return s.delete(SNAPSHOTS, append(NUMBER, s.encodeUint(n)...))
+}
+
+// WriteSnapshotMetadata writes the metadata of the consensus snapshots to the DB
+func (s *KeyValueStorage) WriteSnapshotMetadata(blob []byte) error {
+	return s.set(SNAPSHOTS, META, blob)
+}
+
+// ReadSnapshotMetadata reads the metadata of the consensus snapshots from the DB
+func (s *KeyValueStorage) ReadSnapshotMetadata() ([]byte, bool) {
+	return s.get(SNAPSHOTS, META)
+}
+
 // RECEIPTS //
 
 // WriteReceipts writes the receipts
@@ -284,6 +316,78 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// LOG INDEX //
+
+// WriteLogIndex adds the block to the log index entries of the terms of its logs.
+// Blocks replaced by a reorg are left in the index, readers should double check the matches
+func (s *KeyValueStorage) WriteLogIndex(number uint64, receipts []*types.Receipt) error {
+	section := number / LogIndexSectionSize
+	offset := uint16(number % LogIndexSectionSize)
+
+	for _, term := range logIndexTerms(receipts) {
+		key := logIndexKey(term, section)
+
+		data, _ := s.get(LOG_INDEX, key)
+		offsets := decodeLogIndexEntry(data)
+
+		// keep the offsets sorted, blocks are not always written in order
+		i := sort.Search(len(offsets), func(i int) bool {
+			return offsets[i] >= offset
+		})
+
+		if i < len(offsets) && offsets[i] == offset {
+			continue
+		}
+
+		offsets = append(offsets, 0)
+		copy(offsets[i+1:], offsets[i:])
+		offsets[i] = offset
+
+		if err := s.set(LOG_INDEX, key, encodeLogIndexEntry(offsets)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadLogIndex returns the sorted numbers of the blocks in the section with logs matching the term
+func (s *KeyValueStorage) ReadLogIndex(term []byte, section uint64) ([]uint64, error) {
+	p := append(LOG_INDEX, logIndexKey(term, section)...)
+	data, ok, err := s.db.Get(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return []uint64{}, nil
+	}
+
+	offsets := decodeLogIndexEntry(data)
+	numbers := make([]uint64, len(offsets))
+
+	for i, offset := range offsets {
+		numbers[i] = section*LogIndexSectionSize + uint64(offset)
+	}
+
+	return numbers, nil
+}
+
+// WriteLogIndexTail writes the first block covered by the log index
+func (s *KeyValueStorage) WriteLogIndexTail(n uint64) error {
+	return s.set(LOG_INDEX, TAIL, s.encodeUint(n))
+}
+
+// ReadLogIndexTail reads the first block covered by the log index
+func (s *KeyValueStorage) ReadLogIndexTail() (uint64, bool) {
+	data, ok := s.get(LOG_INDEX, TAIL)
+	if !ok || len(data) != 8 {
+		return 0, false
+	}
+
+	return s.decodeUint(data), true
+}
+
 // WRITE OPERATIONS //
 
 func (s *KeyValueStorage) writeRLP(p, k []byte, raw types.RLPMarshaler) error {
@@ -352,6 +456,12 @@ func (s *KeyValueStorage) set(p []byte, k []byte, v []byte) error {
 	return s.db.Set(p, v)
 }
 
+func (s *KeyValueStorage) delete(p []byte, k []byte) error {
+	p = append(p, k...)
+
+	return s.db.Delete(p)
+}
+
 func (s *KeyValueStorage) get(p []byte, k []byte) ([]byte, bool) {
 	p = append(p, k...)
 	data, ok, err := s.db.Get(p)
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"encoding/binary"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// LogIndexSectionSize is the number of blocks in a section of the log index.
+// The blocks of a section with logs matching a term are kept in a single entry
+const LogIndexSectionSize = 4096
+
+// kinds of the log index terms
+const (
+	logIndexAny byte = iota
+	logIndexAddress
+	logIndexTopic
+)
+
+// LogIndexAnyTerm is the term of the blocks with any log
+func LogIndexAnyTerm() []byte {
+	return []byte{logIndexAny}
+}
+
+// LogIndexAddressTerm is the term of the blocks with logs emitted by the address
+func LogIndexAddressTerm(addr types.Address) []byte {
+	return append([]byte{logIndexAddress}, addr.Bytes()...)
+}
+
+// LogIndexTopicTerm is the term of the blocks with logs having the topic at the position
+func LogIndexTopicTerm(position int, topic types.Hash) []byte {
+	return append([]byte{logIndexTopic, byte(position)}, topic.Bytes()...)
+}
+
+// logIndexTerms returns the distinct terms of the logs in the receipts
+func logIndexTerms(receipts []*types.Receipt) [][]byte {
+	seen := map[string]struct{}{}
+	terms := [][]byte{}
+
+	add := func(term []byte) {
+		if _, ok := seen[string(term)]; !ok {
+			seen[string(term)] = struct{}{}
+			terms = append(terms, term)
+		}
+	}
+
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			add(LogIndexAnyTerm())
+			add(LogIndexAddressTerm(log.Address))
+
+			for i, topic := range log.Topics {
+				add(LogIndexTopicTerm(i, topic))
+			}
+		}
+	}
+
+	return terms
+}
+
+// logIndexKey is the key of the entry of the term in the section
+func logIndexKey(term []byte, section uint64) []byte {
+	key := make([]byte, len(term)+8)
+	copy(key, term)
+	binary.BigEndian.PutUint64(key[len(term):], section)
+
+	return key
+}
+
+// decodeLogIndexEntry decodes the offsets of the blocks in the section
+func decodeLogIndexEntry(data []byte) []uint16 {
+	offsets := make([]uint16, len(data)/2)
+	for i := range offsets {
+		offsets[i] = binary.BigEndian.Uint16(data[2*i:])
+	}
+
+	return offsets
+}
+
+// encodeLogIndexEntry encodes the offsets of the blocks in the section
+func encodeLogIndexEntry(offsets []uint16) []byte {
+	data := make([]byte, 2*len(offsets))
+	for i, offset := range offsets {
+		binary.BigEndian.PutUint16(data[2*i:], offset)
+	}
+
+	return data
+}
@@ -34,12 +34,23 @@ type Storage interface {
 	WriteSnapshot(hash types.Hash, blob []byte) error
 	ReadSnapshot(hash types.Hash) ([]byte, bool)
 
+	WriteSnapshotByNumber(n uint64, blob []byte) error
+	ReadSnapshotByNumber(n uint64) ([]byte, bool)
+	DeleteSnapshotByNumber(n uint64) error
+	WriteSnapshotMetadata(blob []byte) error
+	ReadSnapshotMetadata() ([]byte, bool)
+
 	WriteReceipts(hash types.Hash, receipts []*types.Receipt) error
 	ReadReceipts(hash types.Hash) ([]*types.Receipt, error)
 
 	WriteTxLookup(hash types.Hash, blockHash types.Hash) error
 	ReadTxLookup(hash types.Hash) (types.Hash, bool)
 
+	WriteLogIndex(number uint64, receipts []*types.Receipt) error
+	ReadLogIndex(term []byte, section uint64) ([]uint64, error)
+	WriteLogIndexTail(n uint64) error
+	ReadLogIndexTail() (uint64, bool)
+
 	Close() error
 }
 
@@ -49,6 +49,12 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("", func(t *testing.T) {
+		testLogIndex(t, m)
+	})
+	t.Run("", func(t *testing.T) {
+		testSnapshotByNumber(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -394,6 +400,94 @@ func testReceipts(t *testing.T, m PlaceholderStorage) {
 	assert.True(t, reflect.DeepEqual(receipts, found))
 }
 
+func testLogIndex(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	_, ok := s.ReadLogIndexTail()
+	assert.False(t, ok)
+
+	receipts := func(addr types.Address, topics ...types.Hash) []*types.Receipt {
+		return []*types.Receipt{
+			{
+				Logs: []*types.Log{
+					{
+						Address: addr,
+						Topics:  topics,
+					},
+				},
+			},
+		}
+	}
+
+	// blocks are written out of order and more than once
+	assert.NoError(t, s.WriteLogIndex(5, receipts(addr1, hash1, hash2)))
+	assert.NoError(t, s.WriteLogIndex(2, receipts(addr2, hash1)))
+	assert.NoError(t, s.WriteLogIndex(5, receipts(addr1, hash1, hash2)))
+	assert.NoError(t, s.WriteLogIndex(3, []*types.Receipt{{}}))
+	assert.NoError(t, s.WriteLogIndex(LogIndexSectionSize+1, receipts(addr1)))
+
+	var cases = []struct {
+		term    []byte
+		section uint64
+		numbers []uint64
+	}{
+		{LogIndexAnyTerm(), 0, []uint64{2, 5}},
+		{LogIndexAnyTerm(), 1, []uint64{LogIndexSectionSize + 1}},
+		{LogIndexAddressTerm(addr1), 0, []uint64{5}},
+		{LogIndexAddressTerm(addr2), 0, []uint64{2}},
+		{LogIndexTopicTerm(0, hash1), 0, []uint64{2, 5}},
+		{LogIndexTopicTerm(1, hash2), 0, []uint64{5}},
+		{LogIndexTopicTerm(0, hash2), 0, []uint64{}},
+		{LogIndexAddressTerm(addr2), 1, []uint64{}},
+	}
+
+	for _, cc := range cases {
+		numbers, err := s.ReadLogIndex(cc.term, cc.section)
+		assert.NoError(t, err)
+		assert.Equal(t, cc.numbers, numbers)
+	}
+
+	assert.NoError(t, s.WriteLogIndexTail(10))
+
+	tail, ok := s.ReadLogIndexTail()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), tail)
+}
+
+func testSnapshotByNumber(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	_, ok := s.ReadSnapshotMetadata()
+	assert.False(t, ok)
+
+	assert.NoError(t, s.WriteSnapshotByNumber(1, []byte{0x1}))
+	assert.NoError(t, s.WriteSnapshotByNumber(2, []byte{0x2}))
+	assert.NoError(t, s.WriteSnapshotMetadata([]byte{0x3}))
+
+	blob, ok := s.ReadSnapshotByNumber(2)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x2}, blob)
+
+	blob, ok = s.ReadSnapshotMetadata()
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x3}, blob)
+
+	// the deleted snapshots aren't found
+	assert.NoError(t, s.DeleteSnapshotByNumber(1))
+
+	_, ok = s.ReadSnapshotByNumber(1)
+	assert.False(t, ok)
+
+	_, ok = s.ReadSnapshotByNumber(2)
+	assert.True(t, ok)
+}
+
 func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	t.Helper()
 
@@ -471,35 +565,53 @@ type writeBodyDelegate func(types.Hash, *types.Body) error
 type readBodyDelegate func(types.Hash) (*types.Body, error)
 type writeSnapshotDelegate func(types.Hash, []byte) error
 type readSnapshotDelegate func(types.Hash) ([]byte, bool)
+type writeSnapshotByNumberDelegate func(uint64, []byte) error
+type readSnapshotByNumberDelegate func(uint64) ([]byte, bool)
+type deleteSnapshotByNumberDelegate func(uint64) error
+type writeSnapshotMetadataDelegate func([]byte) error
+type readSnapshotMetadataDelegate func() ([]byte, bool)
 type writeReceiptsDelegate func(types.Hash, []*types.Receipt) error
 type readReceiptsDelegate func(types.Hash) ([]*types.Receipt, error)
 type writeTxLookupDelegate func(types.Hash, types.Hash) error
 type readTxLookupDelegate func(types.Hash) (types.Hash, bool)
+type writeLogIndexDelegate func(uint64, []*types.Receipt) error
+type readLogIndexDelegate func([]byte, uint64) ([]uint64, error)
+type writeLogIndexTailDelegate func(uint64) error
+type readLogIndexTailDelegate func() (uint64, bool)
 type closeDelegate func() error
 
 type MockStorage struct {
-	readCanonicalHashFn    readCanonicalHashDelegate
-	writeCanonicalHashFn   writeCanonicalHashDelegate
-	readHeadHashFn         readHeadHashDelegate
-	readHeadNumberFn       readHeadNumberDelegate
-	writeHeadHashFn        writeHeadHashDelegate
-	writeHeadNumberFn      writeHeadNumberDelegate
-	writeForksFn           writeForksDelegate
-	readForksFn            readForksDelegate
-	writeTotalDifficultyFn writeTotalDifficultyDelegate
-	readTotalDifficultyFn  readTotalDifficultyDelegate
-	writeHeaderFn          writeHeaderDelegate
-	readHeaderFn           readHeaderDelegate
-	writeCanonicalHeaderFn writeCanonicalHeaderDelegate
-	writeBodyFn            writeBodyDelegate
-	readBodyFn             readBodyDelegate
-	writeSnapshotFn        writeSnapshotDelegate
-	readSnapshotFn         readSnapshotDelegate
-	writeReceiptsFn        writeReceiptsDelegate
-	readReceiptsFn         readReceiptsDelegate
-	writeTxLookupFn        writeTxLookupDelegate
-	readTxLookupFn         readTxLookupDelegate
-	closeFn                closeDelegate
+	readCanonicalHashFn      readCanonicalHashDelegate
+	writeCanonicalHashFn     writeCanonicalHashDelegate
+	readHeadHashFn           readHeadHashDelegate
+	readHeadNumberFn         readHeadNumberDelegate
+	writeHeadHashFn          writeHeadHashDelegate
+	writeHeadNumberFn        writeHeadNumberDelegate
+	writeForksFn             writeForksDelegate
+	readForksFn              readForksDelegate
+	writeTotalDifficultyFn   writeTotalDifficultyDelegate
+	readTotalDifficultyFn    readTotalDifficultyDelegate
+	writeHeaderFn            writeHeaderDelegate
+	readHeaderFn             readHeaderDelegate
+	writeCanonicalHeaderFn   writeCanonicalHeaderDelegate
+	writeBodyFn              writeBodyDelegate
+	readBodyFn               readBodyDelegate
+	writeSnapshotFn          writeSnapshotDelegate
+	readSnapshotFn           readSnapshotDelegate
+	writeSnapshotByNumberFn  writeSnapshotByNumberDelegate
+	readSnapshotByNumberFn   readSnapshotByNumberDelegate
+	deleteSnapshotByNumberFn deleteSnapshotByNumberDelegate
+	writeSnapshotMetadataFn  writeSnapshotMetadataDelegate
+	readSnapshotMetadataFn   readSnapshotMetadataDelegate
+	writeReceiptsFn          writeReceiptsDelegate
+	readReceiptsFn           readReceiptsDelegate
+	writeTxLookupFn          writeTxLookupDelegate
+	readTxLookupFn           readTxLookupDelegate
+	writeLogIndexFn          writeLogIndexDelegate
+	readLogIndexFn           readLogIndexDelegate
+	writeLogIndexTailFn      writeLogIndexTailDelegate
+	readLogIndexTailFn       readLogIndexTailDelegate
+	closeFn                  closeDelegate
 }
 
 func NewMockStorage() *MockStorage {
@@ -710,6 +822,66 @@ func (m *MockStorage) HookReadSnapshot(fn readSnapshotDelegate) {
 	m.readSnapshotFn = fn
 }
 
+func (m *MockStorage) WriteSnapshotByNumber(n uint64, blob []byte) error {
+	if m.writeSnapshotByNumberFn != nil {
+		return m.writeSnapshotByNumberFn(n, blob)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteSnapshotByNumber(fn writeSnapshotByNumberDelegate) {
+	m.writeSnapshotByNumberFn = fn
+}
+
+func (m *MockStorage) ReadSnapshotByNumber(n uint64) ([]byte, bool) {
+	if m.readSnapshotByNumberFn != nil {
+		return m.readSnapshotByNumberFn(n)
+	}
+
+	return []byte{}, true
+}
+
+func (m *MockStorage) HookReadSnapshotByNumber(fn readSnapshotByNumberDelegate) {
+	m.readSnapshotByNumberFn = fn
+}
+
+func (m *MockStorage) DeleteSnapshotByNumber(n uint64) error {
+	if m.deleteSnapshotByNumberFn != nil {
+		return m.deleteSnapshotByNumberFn(n)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookDeleteSnapshotByNumber(fn deleteSnapshotByNumberDelegate) {
+	m.deleteSnapshotByNumberFn = fn
+}
+
+func (m *MockStorage) WriteSnapshotMetadata(blob []byte) error {
+	if m.writeSnapshotMetadataFn != nil {
+		return m.writeSnapshotMetadataFn(blob)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteSnapshotMetadata(fn writeSnapshotMetadataDelegate) {
+	m.writeSnapshotMetadataFn = fn
+}
+
+func (m *MockStorage) ReadSnapshotMetadata() ([]byte, bool) {
+	if m.readSnapshotMetadataFn != nil {
+		return m.readSnapshotMetadataFn()
+	}
+
+	return []byte{}, true
+}
+
+func (m *MockStorage) HookReadSnapshotMetadata(fn readSnapshotMetadataDelegate) {
+	m.readSnapshotMetadataFn = fn
+}
+
 func (m *MockStorage) WriteReceipts(hash types.Hash, receipts []*types.Receipt) error {
 	if m.writeReceiptsFn != nil {
 		return m.writeReceiptsFn(hash, receipts)
@@ -758,6 +930,54 @@ func (m *MockStorage) HookReadTxLookup(fn readTxLookupDelegate) {
 	m.readTxLookupFn = fn
 }
 
+func (m *MockStorage) WriteLogIndex(number uint64, receipts []*types.Receipt) error {
+	if m.writeLogIndexFn != nil {
+		return m.writeLogIndexFn(number, receipts)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteLogIndex(fn writeLogIndexDelegate) {
+	m.writeLogIndexFn = fn
+}
+
+func (m *MockStorage) ReadLogIndex(term []byte, section uint64) ([]uint64, error) {
+	if m.readLogIndexFn != nil {
+		return m.readLogIndexFn(term, section)
+	}
+
+	return []uint64{}, nil
+}
+
+func (m *MockStorage) HookReadLogIndex(fn readLogIndexDelegate) {
+	m.readLogIndexFn = fn
+}
+
+func (m *MockStorage) WriteLogIndexTail(n uint64) error {
+	if m.writeLogIndexTailFn != nil {
+		return m.writeLogIndexTailFn(n)
+	}
+
+	return nil
+}
+
+func (m *MockStorage) HookWriteLogIndexTail(fn writeLogIndexTailDelegate) {
+	m.writeLogIndexTailFn = fn
+}
+
+func (m *MockStorage) ReadLogIndexTail() (uint64, bool) {
+	if m.readLogIndexTailFn != nil {
+		return m.readLogIndexTailFn()
+	}
+
+	return 0, true
+}
+
+func (m *MockStorage) HookReadLogIndexTail(fn readLogIndexTailDelegate) {
+	m.readLogIndexTailFn = fn
+}
+
 func (m *MockStorage) Close() error {
 	if m.closeFn != nil {
 		return m.closeFn()
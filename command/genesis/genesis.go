@@ -98,6 +98,14 @@ func setFlags(cmd *cobra.Command) {
 			"Proof of Authority if flag is not provided or false",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.isPoAGovernance,
+		poaGovernanceFlag,
+		false,
+		"the flag indicating that the Proof of Authority validator set is managed by the governance contract, "+
+			"predeployed with the validators, instead of the votes in the block headers",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.chainID,
 		chainIDFlag,
@@ -139,6 +147,78 @@ func setFlags(cmd *cobra.Command) {
 		common.MaxSafeJSInt,
 		"the maximum number of validators in the validator set for PoS",
 	)
+	cmd.Flags().Uint64Var(
+		&params.slashPercentage,
+		slashPercentageFlag,
+		ibft.DefaultSlashPercentage,
+		"the percentage of the stake burned when a PoS validator signs two different blocks at the same height",
+	)
+	cmd.Flags().Uint64Var(
+		&params.jailEpochs,
+		jailEpochsFlag,
+		ibft.DefaultJailEpochs,
+		"the number of epochs during which a penalized PoS validator is left out of the validator set",
+	)
+	cmd.Flags().Uint64Var(
+		&params.downtimeThreshold,
+		downtimeThresholdFlag,
+		0,
+		"the number of missed proposals in an epoch for which a PoS validator is jailed. "+
+			"Offline validators aren't jailed if the flag is not provided or 0",
+	)
+	cmd.Flags().BoolVar(
+		&params.isLondon,
+		londonFlag,
+		false,
+		"the flag indicating that the london fork (EIP-1559 fee market) should be enabled from genesis",
+	)
+	cmd.Flags().StringVar(
+		&params.baseFeeCollectorRaw,
+		baseFeeCollectorFlag,
+		"",
+		"the address receiving the base fee paid by the transactions (london fork only). "+
+			"The base fee is burned if the flag is not provided",
+	)
+	cmd.Flags().DurationVar(
+		&params.baseTimeout,
+		ibftBaseTimeoutFlag,
+		0,
+		"the IBFT timeout of the first round (e.g. 500ms). The timeout of the round r is "+
+			"the base timeout times the timeout growth to the power r. "+
+			"Defaults to the exponential timeout of 10s + 2^r seconds if not set",
+	)
+	cmd.Flags().Float64Var(
+		&params.timeoutGrowth,
+		ibftTimeoutGrowthFlag,
+		0,
+		"the growth of the IBFT round timeouts (at least 1, default 2)",
+	)
+	cmd.Flags().DurationVar(
+		&params.maxTimeout,
+		ibftMaxTimeoutFlag,
+		0,
+		"the maximum IBFT round timeout (default 300s)",
+	)
+	cmd.Flags().BoolVar(
+		&params.isBLS,
+		ibftBLSFlag,
+		false,
+		"the flag indicating that the IBFT committed seals are aggregated BLS signatures "+
+			"from the block set by ibft-bls-from",
+	)
+	cmd.Flags().Uint64Var(
+		&params.blsFrom,
+		ibftBLSFromFlag,
+		0,
+		"the block from which the IBFT committed seals are aggregated BLS signatures",
+	)
+	cmd.Flags().StringArrayVar(
+		&params.blsValidatorsRaw,
+		ibftValidatorBLSFlag,
+		[]string{},
+		"the BLS public keys of the IBFT validators (format: <address>:<public key>:<proof of possession>), "+
+			"can be used multiple times. The keys are read from the validator folders with ibft-validators-prefix-path",
+	)
 }
 
 // setLegacyFlags sets the legacy flags to preserve backwards compatibility
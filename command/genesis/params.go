@@ -3,12 +3,18 @@ package genesis
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/0xPolygon/polygon-edge/contracts/governance"
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	governanceHelper "github.com/0xPolygon/polygon-edge/helper/governance"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -24,8 +30,20 @@ const (
 	epochSizeFlag           = "epoch-size"
 	blockGasLimitFlag       = "block-gas-limit"
 	posFlag                 = "pos"
+	poaGovernanceFlag       = "poa-governance"
 	minValidatorCount       = "min-validator-count"
 	maxValidatorCount       = "max-validator-count"
+	londonFlag              = "london"
+	baseFeeCollectorFlag    = "base-fee-collector"
+	ibftBaseTimeoutFlag     = "ibft-base-timeout"
+	ibftTimeoutGrowthFlag   = "ibft-timeout-growth"
+	ibftMaxTimeoutFlag      = "ibft-max-timeout"
+	ibftBLSFlag             = "ibft-bls"
+	ibftBLSFromFlag         = "ibft-bls-from"
+	ibftValidatorBLSFlag    = "ibft-validator-bls"
+	slashPercentageFlag     = "pos-slash-percentage"
+	jailEpochsFlag          = "pos-jail-epochs"
+	downtimeThresholdFlag   = "pos-downtime-threshold"
 )
 
 // Legacy flags that need to be preserved for running clients
@@ -44,6 +62,11 @@ var (
 	errUnsupportedConsensus           = errors.New("specified consensusRaw not supported")
 	errMissingBootnode                = errors.New("at least 1 bootnode is required")
 	errInvalidEpochSize               = errors.New("epoch size must be greater than 1")
+	errBaseFeeCollectorWithoutLondon  = errors.New("base fee collector requires the london fork")
+	errBLSWithoutIBFT                 = errors.New("BLS committed seals require the IBFT consensus")
+	errBLSValidatorsWithoutBLS        = errors.New("BLS public keys of the validators require the ibft-bls flag")
+	errGovernanceWithoutIBFT          = errors.New("the governance contract requires the IBFT consensus")
+	errGovernanceWithPoS              = errors.New("the governance contract can't be used with Proof of Stake")
 )
 
 type genesisParams struct {
@@ -62,9 +85,29 @@ type genesisParams struct {
 	blockGasLimit uint64
 	isPos         bool
 
+	isPoAGovernance bool
+
 	minNumValidators uint64
 	maxNumValidators uint64
 
+	slashPercentage   uint64
+	jailEpochs        uint64
+	downtimeThreshold uint64
+
+	isLondon            bool
+	baseFeeCollectorRaw string
+	baseFeeCollector    *types.Address
+
+	baseTimeout   time.Duration
+	timeoutGrowth float64
+	maxTimeout    time.Duration
+	roundTimeouts []*ibft.RoundTimeouts
+
+	isBLS            bool
+	blsFrom          uint64
+	blsValidatorsRaw []string
+	blsValidators    map[types.Address]*ibft.BLSValidator
+
 	extraData []byte
 	consensus server.ConsensusType
 
@@ -116,6 +159,43 @@ func (p *genesisParams) validateFlags() error {
 		return err
 	}
 
+	// Validate the base fee collector, which is only used from the london fork on
+	if p.baseFeeCollectorRaw != "" {
+		if !p.isLondon {
+			return errBaseFeeCollectorWithoutLondon
+		}
+
+		collector := types.Address{}
+		if err := collector.UnmarshalText([]byte(p.baseFeeCollectorRaw)); err != nil {
+			return fmt.Errorf("invalid base fee collector address %s: %w", p.baseFeeCollectorRaw, err)
+		}
+
+		p.baseFeeCollector = &collector
+	}
+
+	// Validate the BLS mode, which is only available with IBFT
+	if p.isBLS && !p.isIBFTConsensus() {
+		return errBLSWithoutIBFT
+	}
+
+	if len(p.blsValidatorsRaw) != 0 && !p.isBLS {
+		return errBLSValidatorsWithoutBLS
+	}
+
+	// Validate the PoA governance, which replaces the votes of the validators
+	if p.isPoAGovernance && !p.isIBFTConsensus() {
+		return errGovernanceWithoutIBFT
+	}
+
+	if p.isPoAGovernance && p.isPos {
+		return errGovernanceWithPoS
+	}
+
+	// Validate the penalties of the PoS validators
+	if p.slashPercentage > 100 {
+		return ibft.ErrInvalidSlashPercentage
+	}
+
 	return nil
 }
 
@@ -144,12 +224,95 @@ func (p *genesisParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initBLSValidators(); err != nil {
+		return err
+	}
+
+	if err := p.initRoundTimeouts(); err != nil {
+		return err
+	}
+
 	p.initIBFTExtraData()
 	p.initConsensusEngineConfig()
 
 	return nil
 }
 
+// initRoundTimeouts sets the round timeouts from the genesis, if any timeout flag is set
+func (p *genesisParams) initRoundTimeouts() error {
+	if !p.isIBFTConsensus() {
+		return nil
+	}
+
+	timeouts, err := ibft.GetRoundTimeouts(map[string]interface{}{}, &consensus.RoundTimeouts{
+		Base:   p.baseTimeout,
+		Growth: p.timeoutGrowth,
+		Max:    p.maxTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(timeouts) != 0 {
+		p.roundTimeouts = timeouts
+	}
+
+	return nil
+}
+
+// initBLSValidators sets the BLS public keys of the validators,
+// from the validator folders of the prefix path and then from the cli command
+func (p *genesisParams) initBLSValidators() error {
+	if !p.isBLS {
+		return nil
+	}
+
+	p.blsValidators = map[types.Address]*ibft.BLSValidator{}
+
+	if p.areValidatorsSetByPrefix() {
+		validators, err := getBLSValidatorsFromPrefixPath(p.validatorPrefixPath)
+		if err != nil {
+			return fmt.Errorf("failed to read BLS keys from prefix: %w", err)
+		}
+
+		p.blsValidators = validators
+	}
+
+	for _, raw := range p.blsValidatorsRaw {
+		parts := strings.Split(raw, ":")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid BLS public key of validator %s, expected <address>:<public key>:<proof>", raw)
+		}
+
+		addr := types.Address{}
+		if err := addr.UnmarshalText([]byte(parts[0])); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", parts[0], err)
+		}
+
+		p.blsValidators[addr] = &ibft.BLSValidator{
+			PublicKey: parts[1],
+			Proof:     parts[2],
+		}
+	}
+
+	// check the keys and their proofs of possession
+	if _, err := ibft.GetBLSFork(map[string]interface{}{
+		"bls": p.getBLSFork(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getBLSFork returns the BLS fork of the IBFT config
+func (p *genesisParams) getBLSFork() *ibft.BLSFork {
+	return &ibft.BLSFork{
+		From:       common.JSONNumber{Value: p.blsFrom},
+		Validators: p.blsValidators,
+	}
+}
+
 // setValidatorSetFromCli sets validator set from cli command
 func (p *genesisParams) setValidatorSetFromCli() {
 	if len(p.ibftValidatorsRaw) != 0 {
@@ -230,15 +393,41 @@ func (p *genesisParams) initConsensusEngineConfig() {
 		return
 	}
 
+	if p.isPoAGovernance {
+		p.initIBFTEngineMap(ibft.PoAGovernance)
+
+		return
+	}
+
 	p.initIBFTEngineMap(ibft.PoA)
 }
 
 func (p *genesisParams) initIBFTEngineMap(mechanism ibft.MechanismType) {
+	engineConfig := map[string]interface{}{
+		"type":      mechanism,
+		"epochSize": p.epochSize,
+		// the validators of a new chain all run the justified consensus messages
+		"justificationBlockNum": 0,
+	}
+
+	if p.roundTimeouts != nil {
+		engineConfig["timeouts"] = p.roundTimeouts
+	}
+
+	if p.isBLS {
+		engineConfig["bls"] = p.getBLSFork()
+	}
+
+	if mechanism == ibft.PoS {
+		engineConfig["slashing"] = &ibft.SlashingConfig{
+			SlashPercentage:   common.JSONNumber{Value: p.slashPercentage},
+			JailEpochs:        common.JSONNumber{Value: p.jailEpochs},
+			DowntimeThreshold: common.JSONNumber{Value: p.downtimeThreshold},
+		}
+	}
+
 	p.consensusEngineConfig = map[string]interface{}{
-		string(server.IBFTConsensus): map[string]interface{}{
-			"type":      mechanism,
-			"epochSize": p.epochSize,
-		},
+		string(server.IBFTConsensus): engineConfig,
 	}
 }
 
@@ -275,6 +464,16 @@ func (p *genesisParams) initGenesisConfig() error {
 		Bootnodes: p.bootnodes,
 	}
 
+	// Enable the london fork (EIP-1559 fee market) from genesis
+	if p.isLondon {
+		forks := *chain.AllForksEnabled
+		forks.London = chain.NewFork(0)
+
+		chainConfig.Params.Forks = &forks
+		chainConfig.Params.BaseFeeCollector = p.baseFeeCollector
+		chainConfig.Genesis.BaseFee = chain.GenesisBaseFee
+	}
+
 	// Predeploy staking smart contract if needed
 	if p.shouldPredeployStakingSC() {
 		stakingAccount, err := p.predeployStakingSC()
@@ -285,6 +484,16 @@ func (p *genesisParams) initGenesisConfig() error {
 		chainConfig.Genesis.Alloc[staking.AddrStakingContract] = stakingAccount
 	}
 
+	// Predeploy governance smart contract if needed
+	if p.shouldPredeployGovernanceSC() {
+		governanceAccount, err := governanceHelper.PredeployGovernanceSC(p.ibftValidators)
+		if err != nil {
+			return err
+		}
+
+		chainConfig.Genesis.Alloc[governance.AddrGovernanceContract] = governanceAccount
+	}
+
 	// Premine accounts
 	if err := fillPremineMap(chainConfig.Genesis.Alloc, p.premine); err != nil {
 		return err
@@ -301,6 +510,12 @@ func (p *genesisParams) shouldPredeployStakingSC() bool {
 	return p.isPos && (p.consensus == server.IBFTConsensus || p.consensus == server.DevConsensus)
 }
 
+func (p *genesisParams) shouldPredeployGovernanceSC() bool {
+	// If the consensus selected is IBFT and the validator set is managed by the governance contract,
+	// deploy the Governance SC
+	return p.isPoAGovernance && p.consensus == server.IBFTConsensus
+}
+
 func (p *genesisParams) predeployStakingSC() (*chain.GenesisAccount, error) {
 	stakingAccount, predeployErr := stakingHelper.PredeployStakingSC(p.ibftValidators,
 		stakingHelper.PredeployParams{
@@ -6,6 +6,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/types"
 	"io/ioutil"
 	"os"
@@ -124,3 +126,56 @@ func getValidatorsFromPrefixPath(prefix string) ([]types.Address, error) {
 
 	return validators, nil
 }
+
+// getBLSValidatorsFromPrefixPath reads the BLS public keys of the validators
+// from the validator folders with the prefix, which have a BLS key
+func getBLSValidatorsFromPrefixPath(prefix string) (map[types.Address]*ibft.BLSValidator, error) {
+	validators := map[types.Address]*ibft.BLSValidator{}
+
+	files, err := ioutil.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		path := file.Name()
+
+		if !file.IsDir() || !strings.HasPrefix(path, prefix) {
+			continue
+		}
+
+		consensusPath := filepath.Join(path, "consensus", ibft.IbftKeyName)
+		blsKeyPath := filepath.Join(path, "consensus", secrets.ValidatorBLSKeyLocal)
+
+		// check if both keys exist
+		if _, err := os.Stat(consensusPath); os.IsNotExist(err) {
+			continue
+		}
+
+		if _, err := os.Stat(blsKeyPath); os.IsNotExist(err) {
+			continue
+		}
+
+		priv, err := crypto.GenerateOrReadPrivateKey(consensusPath)
+		if err != nil {
+			return nil, err
+		}
+
+		rawBLSKey, err := ioutil.ReadFile(blsKeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		blsKey, err := crypto.BytesToBLSSecretKey(rawBLSKey)
+		if err != nil {
+			return nil, err
+		}
+
+		validators[crypto.PubKeyToAddress(&priv.PublicKey)] = &ibft.BLSValidator{
+			PublicKey: hex.EncodeToHex(blsKey.PublicKey().Marshal()),
+			Proof:     hex.EncodeToHex(blsKey.ProvePossession().Marshal()),
+		}
+	}
+
+	return validators, nil
+}
@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"context"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+)
+
+const (
+	fromFlag = "from"
+	toFlag   = "to"
+)
+
+var (
+	params = &statsParams{}
+)
+
+type statsParams struct {
+	from uint64
+	to   uint64
+
+	stats *ibftOp.StatsResp
+}
+
+func (p *statsParams) initStats(grpcAddress string) error {
+	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	stats, err := ibftClient.Stats(
+		context.Background(),
+		&ibftOp.StatsReq{
+			From: p.from,
+			To:   p.to,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.stats = stats
+
+	return nil
+}
+
+func (p *statsParams) getResult() command.CommandResult {
+	return newIBFTStatsResult(p.stats)
+}
@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+)
+
+type IBFTValidatorStats struct {
+	Address         string `json:"address"`
+	Proposals       uint64 `json:"proposals"`
+	MissedProposals uint64 `json:"missed_proposals"`
+	CommittedSeals  uint64 `json:"committed_seals"`
+	MissedSeals     uint64 `json:"missed_seals"`
+}
+
+type IBFTStatsResult struct {
+	From       uint64               `json:"from"`
+	To         uint64               `json:"to"`
+	Validators []IBFTValidatorStats `json:"validators"`
+}
+
+func newIBFTStatsResult(resp *ibftOp.StatsResp) *IBFTStatsResult {
+	res := &IBFTStatsResult{
+		From:       resp.From,
+		To:         resp.To,
+		Validators: make([]IBFTValidatorStats, len(resp.Validators)),
+	}
+
+	for i, v := range resp.Validators {
+		res.Validators[i] = IBFTValidatorStats{
+			Address:         v.Address,
+			Proposals:       v.Proposals,
+			MissedProposals: v.MissedProposals,
+			CommittedSeals:  v.CommittedSeals,
+			MissedSeals:     v.MissedSeals,
+		}
+	}
+
+	return res
+}
+
+func (r *IBFTStatsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[IBFT STATS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("From block|%d", r.From),
+		fmt.Sprintf("To block|%d", r.To),
+	}))
+	buffer.WriteString("\n")
+
+	numValidators := len(r.Validators)
+	validators := make([]string, numValidators+1)
+	validators[0] = "No validators found"
+
+	if numValidators > 0 {
+		validators[0] = "ADDRESS|PROPOSALS|MISSED PROPOSALS|COMMITTED SEALS|MISSED SEALS"
+		for i, v := range r.Validators {
+			validators[i+1] = fmt.Sprintf(
+				"%s|%d|%d|%d|%d",
+				v.Address,
+				v.Proposals,
+				v.MissedProposals,
+				v.CommittedSeals,
+				v.MissedSeals,
+			)
+		}
+	}
+
+	buffer.WriteString("\n[VALIDATORS]\n")
+	buffer.WriteString(helper.FormatList(validators))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -35,14 +35,14 @@ func setFlags(cmd *cobra.Command) {
 		&params.typeRaw,
 		typeFlag,
 		"",
-		"the new IBFT type [PoA, PoS]",
+		"the new IBFT type [PoA, PoS, PoAGovernance]",
 	)
 
 	cmd.Flags().StringVar(
 		&params.deploymentRaw,
 		deploymentFlag,
 		"",
-		"the height to deploy the contract in PoS",
+		"the height to deploy the contract in PoS and PoAGovernance",
 	)
 
 	cmd.Flags().StringVar(
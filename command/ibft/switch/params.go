@@ -86,7 +86,7 @@ func (p *switchParams) initMechanismType() error {
 
 func (p *switchParams) initDeployment() error {
 	if p.deploymentRaw != "" {
-		if p.mechanismType != ibft.PoS {
+		if p.mechanismType != ibft.PoS && p.mechanismType != ibft.PoAGovernance {
 			return fmt.Errorf(
 				"doesn't support contract deployment in %s",
 				string(p.mechanismType),
@@ -284,9 +284,16 @@ func appendIBFTForks(
 
 	lastFork.To = &common.JSONNumber{Value: from - 1}
 
+	// the block pacing is kept across the switch
 	newFork := ibft.IBFTFork{
-		Type: mechanismType,
-		From: common.JSONNumber{Value: from},
+		Type:                  mechanismType,
+		From:                  common.JSONNumber{Value: from},
+		MaxEmptyBlockInterval: lastFork.MaxEmptyBlockInterval,
+		AdaptiveBlockTime:     lastFork.AdaptiveBlockTime,
+	}
+
+	if mechanismType == ibft.PoAGovernance && deployment != nil {
+		newFork.Deployment = &common.JSONNumber{Value: *deployment}
 	}
 
 	if mechanismType == ibft.PoS {
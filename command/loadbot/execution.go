@@ -56,6 +56,7 @@ type Configuration struct {
 	ChainID          uint64
 	GasPrice         *big.Int
 	GasLimit         *big.Int
+	TxType           types.TxType
 	ContractArtifact *generator.ContractArtifact
 	ConstructorArgs  []byte // smart contract constructor args
 	MaxWait          uint64 // max wait time for receipts in minutes
@@ -206,6 +207,7 @@ func (l *Loadbot) Run() error {
 		RecieverAddress:  l.cfg.Receiver,
 		SenderKey:        sender.PrivateKey,
 		GasPrice:         gasPrice,
+		TxType:           l.cfg.TxType,
 		Value:            l.cfg.Value,
 		ContractArtifact: l.cfg.ContractArtifact,
 		ConstructorArgs:  l.cfg.ConstructorArgs,
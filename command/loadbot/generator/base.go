@@ -1,9 +1,11 @@
 package generator
 
 import (
+	"math/big"
 	"sync"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 type BaseGenerator struct {
@@ -32,3 +34,21 @@ func (bg *BaseGenerator) MarkFailedTxn(failedTxn *FailedTxnInfo) {
 func (bg *BaseGenerator) SetGasEstimate(gasEstimate uint64) {
 	bg.estimatedGas = gasEstimate
 }
+
+// signTx sets the configured transaction type and signs the transaction with the sender key
+func (bg *BaseGenerator) signTx(txn *types.Transaction) (*types.Transaction, error) {
+	switch bg.params.TxType {
+	case types.AccessListTx:
+		txn.Type = types.AccessListTx
+		txn.ChainID = new(big.Int).SetUint64(bg.params.ChainID)
+		txn.AccessList = types.TxAccessList{}
+	case types.DynamicFeeTx:
+		// the gas price is used as the fee cap, and the whole of it is offered as the tip
+		txn.Type = types.DynamicFeeTx
+		txn.ChainID = new(big.Int).SetUint64(bg.params.ChainID)
+		txn.AccessList = types.TxAccessList{}
+		txn.GasTipCap = new(big.Int).Set(txn.GasPrice)
+	}
+
+	return bg.signer.SignTx(txn, bg.params.SenderKey)
+}
@@ -25,24 +25,24 @@ func (gen *ContractTxnsGenerator) GetExampleTransaction() (*types.Transaction, e
 	if gen.contractAddress == nil {
 		//	contract not deployed yet
 		//	generate contract deployment tx
-		return gen.signer.SignTx(&types.Transaction{
+		return gen.signTx(&types.Transaction{
 			From:     gen.params.SenderAddress,
 			Value:    big.NewInt(0),
 			GasPrice: gen.params.GasPrice,
 			Input:    gen.contractBytecode,
 			V:        big.NewInt(1), // it is necessary to encode in rlp
-		}, gen.params.SenderKey)
+		})
 	}
 
 	//	return token transfer tx
-	return gen.signer.SignTx(&types.Transaction{
+	return gen.signTx(&types.Transaction{
 		From:     gen.params.SenderAddress,
 		To:       gen.contractAddress,
 		Value:    big.NewInt(0),
 		GasPrice: gen.params.GasPrice,
 		Input:    gen.encodedParams,
 		V:        big.NewInt(1), // it is necessary to encode in rlp
-	}, gen.params.SenderKey)
+	})
 }
 
 func (gen *ContractTxnsGenerator) GenerateTransaction() (*types.Transaction, error) {
@@ -51,7 +51,7 @@ func (gen *ContractTxnsGenerator) GenerateTransaction() (*types.Transaction, err
 	if gen.contractAddress == nil {
 		//	contract not deployed yet
 		//	generate contract deployment tx
-		return gen.signer.SignTx(&types.Transaction{
+		return gen.signTx(&types.Transaction{
 			From:     gen.params.SenderAddress,
 			Value:    big.NewInt(0),
 			Gas:      gen.estimatedGas,
@@ -59,11 +59,11 @@ func (gen *ContractTxnsGenerator) GenerateTransaction() (*types.Transaction, err
 			Nonce:    newNextNonce - 1,
 			Input:    gen.contractBytecode,
 			V:        big.NewInt(1), // it is necessary to encode in rlp
-		}, gen.params.SenderKey)
+		})
 	}
 
 	//	return token transfer tx
-	return gen.signer.SignTx(&types.Transaction{
+	return gen.signTx(&types.Transaction{
 		From:     gen.params.SenderAddress,
 		To:       gen.contractAddress,
 		Value:    big.NewInt(0),
@@ -72,7 +72,7 @@ func (gen *ContractTxnsGenerator) GenerateTransaction() (*types.Transaction, err
 		Nonce:    newNextNonce - 1,
 		Input:    gen.encodedParams,
 		V:        big.NewInt(1), // it is necessary to encode in rlp
-	}, gen.params.SenderKey)
+	})
 }
 
 func (gen *ContractTxnsGenerator) MarkFailedContractTxn(failedContractTxn *FailedContractTxnInfo) {
@@ -67,6 +67,7 @@ type GeneratorParams struct {
 	SenderKey        *ecdsa.PrivateKey
 	Value            *big.Int
 	GasPrice         *big.Int
+	TxType           types.TxType
 	ContractArtifact *ContractArtifact
 	ConstructorArgs  []byte // smart contract constructor arguments
 	ContractAddress  ethgo.Address
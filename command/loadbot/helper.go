@@ -1,14 +1,18 @@
 package loadbot
 
 import (
+	"errors"
 	"fmt"
 	"github.com/umbracle/ethgo"
 	"math/big"
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/jsonrpc/codec"
 )
 
 // getInitialSenderNonce queries the sender account nonce before starting the loadbot run.
@@ -45,7 +49,7 @@ func estimateGas(client *jsonrpc.Client, txn *types.Transaction) (uint64, error)
 	})
 
 	if err != nil {
-		return 0, fmt.Errorf("failed to query gas estimate: %w", err)
+		return 0, fmt.Errorf("failed to query gas estimate: %w", decodeRevertError(err))
 	}
 
 	if gasEstimate == 0 {
@@ -55,6 +59,31 @@ func estimateGas(client *jsonrpc.Client, txn *types.Transaction) (uint64, error)
 	return gasEstimate, nil
 }
 
+// decodeRevertError returns the reverted execution error of a JSON-RPC request
+// with the reason decoded from the revert data of the response, if any
+func decodeRevertError(err error) error {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+
+	data, ok := rpcErr.Data.(string)
+	if !ok {
+		return err
+	}
+
+	revertData, decodeErr := hex.DecodeHex(data)
+	if decodeErr != nil {
+		return err
+	}
+
+	if reason, ok := runtime.DecodeRevert(revertData); ok {
+		return fmt.Errorf("%s, reason: %s", runtime.ErrExecutionReverted, reason)
+	}
+
+	return fmt.Errorf("%s, revert data: %s", rpcErr.Message, data)
+}
+
 // calculateBlockUtilization calculates block utilization in percents
 func calculateBlockUtilization(gasUsed, gasLimit uint64) float64 {
 	return float64(gasUsed) / float64(gasLimit) * 100
@@ -164,7 +193,7 @@ func (l *Loadbot) updateGasEstimate(jsonClient *jsonrpc.Client) error {
 	// No gas limit specified, query the network for an estimation
 	gasEstimate, estimateErr := estimateGas(jsonClient, exampleTxn)
 	if estimateErr != nil {
-		return fmt.Errorf("unable to get gas estimate, %w", err)
+		return fmt.Errorf("unable to get gas estimate, %w", estimateErr)
 	}
 
 	gasLimit = new(big.Int).SetUint64(gasEstimate)
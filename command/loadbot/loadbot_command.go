@@ -101,6 +101,13 @@ func setFlags(cmd *cobra.Command) {
 			"contract is used",
 	)
 
+	cmd.Flags().StringVar(
+		&params.txTypeRaw,
+		txTypeFlag,
+		legacyTxType,
+		"the type of the transactions [legacy, access-list, dynamic-fee].",
+	)
+
 	cmd.Flags().BoolVar(
 		&params.detailed,
 		detailedFlag,
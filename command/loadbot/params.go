@@ -19,6 +19,7 @@ var (
 	errInvalidMode   = errors.New("invalid loadbot mode")
 	errInvalidValues = errors.New("invalid values")
 	errContractPath  = errors.New("contract path not specified")
+	errInvalidTxType = errors.New("invalid transaction type")
 )
 
 const (
@@ -47,6 +48,13 @@ const (
 	gasLimitFlag = "gas-limit"
 	contractFlag = "contract"
 	maxWaitFlag  = "max-wait"
+	txTypeFlag   = "tx-type"
+)
+
+const (
+	legacyTxType     = "legacy"
+	accessListTxType = "access-list"
+	dynamicFeeTxType = "dynamic-fee"
 )
 
 type loadbotParams struct {
@@ -66,6 +74,7 @@ type loadbotParams struct {
 	valueRaw    string
 	gasPriceRaw string
 	gasLimitRaw string
+	txTypeRaw   string
 
 	mode             Mode
 	sender           types.Address
@@ -73,6 +82,7 @@ type loadbotParams struct {
 	value            *big.Int
 	gasPrice         *big.Int
 	gasLimit         *big.Int
+	txType           types.TxType
 	contractArtifact *generator.ContractArtifact
 	constructorArgs  []byte
 }
@@ -88,6 +98,11 @@ func (p *loadbotParams) validateFlags() error {
 		return err
 	}
 
+	// check if valid transaction type is selected
+	if err := p.isValidTxType(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -214,6 +229,7 @@ func (p *loadbotParams) generateConfig(
 		ChainID:          p.chainID,
 		GasPrice:         p.gasPrice,
 		GasLimit:         p.gasLimit,
+		TxType:           p.txType,
 		ContractArtifact: p.contractArtifact,
 		ConstructorArgs:  p.constructorArgs,
 		MaxWait:          p.maxWait,
@@ -233,6 +249,29 @@ func (p *loadbotParams) isValidMode() error {
 	}
 }
 
+func (p *loadbotParams) isValidTxType() error {
+	// Set and validate the transaction type
+	switch strings.ToLower(p.txTypeRaw) {
+	case legacyTxType:
+		p.txType = types.LegacyTx
+
+		return nil
+
+	case accessListTxType:
+		p.txType = types.AccessListTx
+
+		return nil
+
+	case dynamicFeeTxType:
+		p.txType = types.DynamicFeeTx
+
+		return nil
+
+	default:
+		return errInvalidTxType
+	}
+}
+
 func (p *loadbotParams) hasValidDeployParams() error {
 	// fail if mode is deploy but we have no contract
 	if p.mode == deploy && p.contractPath == "" {
@@ -0,0 +1,46 @@
+package backfill
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	backfillCmd := &cobra.Command{
+		Use: "backfill",
+		Short: "Indexes the logs of the blocks written before the log index was introduced. " +
+			"The client should be stopped while the data directory is backfilled",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(backfillCmd)
+
+	return backfillCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the Polygon Edge client",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.backfillLogIndex(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,70 @@
+package backfill
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	dataDirFlag = "data-dir"
+)
+
+var (
+	params = &backfillParams{}
+)
+
+var (
+	errInvalidParams = errors.New("no data directory passed in")
+)
+
+type backfillParams struct {
+	dataDir string
+
+	backfilled uint64
+}
+
+func (p *backfillParams) validateFlags() error {
+	if p.dataDir == "" {
+		return errInvalidParams
+	}
+
+	return nil
+}
+
+func (p *backfillParams) backfillLogIndex() error {
+	dbPath := filepath.Join(p.dataDir, "blockchain")
+
+	// don't create an empty chain on a wrong path
+	if _, err := os.Stat(dbPath); err != nil {
+		return fmt.Errorf("unable to find the blockchain data, %w", err)
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "logindex",
+		Level: hclog.Info,
+	})
+
+	db, err := leveldb.NewLevelDBStorage(dbPath, logger)
+	if err != nil {
+		return fmt.Errorf("unable to open the blockchain data, %w", err)
+	}
+
+	defer db.Close()
+
+	p.backfilled, err = blockchain.BackfillLogIndex(db, logger)
+
+	return err
+}
+
+func (p *backfillParams) getResult() command.CommandResult {
+	return &BackfillResult{
+		Backfilled: p.backfilled,
+	}
+}
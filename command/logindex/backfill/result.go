@@ -0,0 +1,24 @@
+package backfill
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type BackfillResult struct {
+	Backfilled uint64 `json:"backfilled"`
+}
+
+func (r *BackfillResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[LOG INDEX BACKFILL]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Backfilled blocks|%d", r.Backfilled),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,24 @@
+package logindex
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/logindex/backfill"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	logIndexCmd := &cobra.Command{
+		Use:   "logindex",
+		Short: "Top level command for maintaining the log index of a data directory. Only accepts subcommands.",
+	}
+
+	registerSubcommands(logIndexCmd)
+
+	return logIndexCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// logindex backfill
+		backfill.GetCommand(),
+	)
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/helper"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
@@ -14,6 +16,7 @@ import (
 const (
 	dataDirFlag = "data-dir"
 	configFlag  = "config"
+	blsFlag     = "bls"
 )
 
 var (
@@ -27,13 +30,15 @@ var (
 )
 
 type initParams struct {
-	dataDir    string
-	configPath string
+	dataDir     string
+	configPath  string
+	generateBLS bool
 
 	secretsManager secrets.SecretsManager
 	secretsConfig  *secrets.SecretsManagerConfig
 
 	validatorPrivateKey  *ecdsa.PrivateKey
+	validatorBLSKey      *bls.SecretKey
 	networkingPrivateKey libp2pCrypto.PrivKey
 
 	nodeID peer.ID
@@ -56,6 +61,10 @@ func (ip *initParams) initSecrets() error {
 		return err
 	}
 
+	if err := ip.initValidatorBLSKey(); err != nil {
+		return err
+	}
+
 	return ip.initNetworkingKey()
 }
 
@@ -146,6 +155,21 @@ func (ip *initParams) initValidatorKey() error {
 	return nil
 }
 
+func (ip *initParams) initValidatorBLSKey() error {
+	if !ip.generateBLS {
+		return nil
+	}
+
+	blsKey, err := helper.InitValidatorBLSKey(ip.secretsManager)
+	if err != nil {
+		return err
+	}
+
+	ip.validatorBLSKey = blsKey
+
+	return nil
+}
+
 func (ip *initParams) initNetworkingKey() error {
 	networkingKey, err := helper.InitNetworkingPrivateKey(ip.secretsManager)
 	if err != nil {
@@ -169,8 +193,15 @@ func (ip *initParams) initNodeID() error {
 }
 
 func (ip *initParams) getResult() command.CommandResult {
-	return &SecretsInitResult{
+	result := &SecretsInitResult{
 		Address: crypto.PubKeyToAddress(&ip.validatorPrivateKey.PublicKey),
 		NodeID:  ip.nodeID.String(),
 	}
+
+	if ip.validatorBLSKey != nil {
+		result.BLSPublicKey = hex.EncodeToHex(ip.validatorBLSKey.PublicKey().Marshal())
+		result.BLSProof = hex.EncodeToHex(ip.validatorBLSKey.ProvePossession().Marshal())
+	}
+
+	return result
 }
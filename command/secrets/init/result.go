@@ -8,18 +8,31 @@ import (
 )
 
 type SecretsInitResult struct {
-	Address types.Address `json:"address"`
-	NodeID  string        `json:"node_id"`
+	Address      types.Address `json:"address"`
+	BLSPublicKey string        `json:"bls_public_key,omitempty"`
+	BLSProof     string        `json:"bls_proof_of_possession,omitempty"`
+	NodeID       string        `json:"node_id"`
 }
 
 func (r *SecretsInitResult) GetOutput() string {
 	var buffer bytes.Buffer
 
 	buffer.WriteString("\n[SECRETS INIT]\n")
-	buffer.WriteString(helper.FormatKV([]string{
+	vals := []string{
 		fmt.Sprintf("Public key (address)|%s", r.Address),
-		fmt.Sprintf("Node ID|%s", r.NodeID),
-	}))
+	}
+
+	if r.BLSPublicKey != "" {
+		vals = append(
+			vals,
+			fmt.Sprintf("BLS public key|%s", r.BLSPublicKey),
+			fmt.Sprintf("BLS proof of possession|%s", r.BLSProof),
+		)
+	}
+
+	vals = append(vals, fmt.Sprintf("Node ID|%s", r.NodeID))
+
+	buffer.WriteString(helper.FormatKV(vals))
 	buffer.WriteString("\n")
 
 	return buffer.String()
@@ -8,7 +8,7 @@ import (
 func GetCommand() *cobra.Command {
 	secretsInitCmd := &cobra.Command{
 		Use: "init",
-		Short: "Initializes private keys for the Polygon Edge (Validator + Networking) " +
+		Short: "Initializes private keys for the Polygon Edge (Validator + Validator BLS + Networking) " +
 			"to the specified Secrets Manager",
 		PreRunE: runPreRun,
 		Run:     runCommand,
@@ -34,6 +34,13 @@ func setFlags(cmd *cobra.Command) {
 		"the path to the SecretsManager config file, "+
 			"if omitted, the local FS secrets manager is used",
 	)
+
+	cmd.Flags().BoolVar(
+		&params.generateBLS,
+		blsFlag,
+		true,
+		"generate the BLS key of the validator, used for the aggregated committed seals",
+	)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
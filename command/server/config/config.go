@@ -6,7 +6,9 @@ import (
 	"io/ioutil"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"gopkg.in/yaml.v3"
 
 	"github.com/hashicorp/hcl"
@@ -20,6 +22,7 @@ type Config struct {
 	BlockGasTarget    string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr          string     `json:"grpc_addr" yaml:"grpc_addr"`
 	JSONRPCAddr       string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
+	JSONRPC           *JSONRPC   `json:"jsonrpc" yaml:"jsonrpc"`
 	Telemetry         *Telemetry `json:"telemetry" yaml:"telemetry"`
 	Network           *Network   `json:"network" yaml:"network"`
 	ShouldSeal        bool       `json:"seal" yaml:"seal"`
@@ -29,6 +32,7 @@ type Config struct {
 	BlockTime         uint64     `json:"block_time_s" yaml:"block_time_s"`
 	Headers           *Headers   `json:"headers" yaml:"headers"`
 	LogFilePath       string     `json:"log_to" yaml:"log_to"`
+	IBFT              *IBFT      `json:"ibft" yaml:"ibft"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -49,8 +53,54 @@ type Network struct {
 
 // TxPool defines the TxPool configuration params
 type TxPool struct {
-	PriceLimit uint64 `json:"price_limit" yaml:"price_limit"`
-	MaxSlots   uint64 `json:"max_slots" yaml:"max_slots"`
+	PriceLimit      uint64 `json:"price_limit" yaml:"price_limit"`
+	MaxSlots        uint64 `json:"max_slots" yaml:"max_slots"`
+	GossipRateLimit uint64 `json:"gossip_rate_limit" yaml:"gossip_rate_limit"`
+}
+
+// IBFT defines the local overrides of the IBFT round timeouts of the chain config
+type IBFT struct {
+	BaseTimeout   string  `json:"base_timeout" yaml:"base_timeout"`
+	TimeoutGrowth float64 `json:"timeout_growth" yaml:"timeout_growth"`
+	MaxTimeout    string  `json:"max_timeout" yaml:"max_timeout"`
+}
+
+// JSONRPC defines the limits of the JSON-RPC server
+type JSONRPC struct {
+	MaxRequestBodySize  uint64                   `json:"max_request_body_size" yaml:"max_request_body_size"`
+	BatchLengthLimit    uint64                   `json:"batch_length_limit" yaml:"batch_length_limit"`
+	RateLimit           uint64                   `json:"rate_limit" yaml:"rate_limit"`
+	APIKeys             map[string]uint64        `json:"api_keys" yaml:"api_keys"`
+	MethodFilters       map[string]*MethodFilter `json:"method_filters" yaml:"method_filters"`
+	LogsBlockRangeLimit uint64                   `json:"logs_block_range_limit" yaml:"logs_block_range_limit"`
+	LogsResultLimit     uint64                   `json:"logs_result_limit" yaml:"logs_result_limit"`
+	SubscriptionQueue   uint64                   `json:"subscription_queue_size" yaml:"subscription_queue_size"`
+	SubscriptionPolicy  string                   `json:"subscription_overflow_policy" yaml:"subscription_overflow_policy"`
+	TLSCertFile         string                   `json:"tls_cert_file" yaml:"tls_cert_file"`
+	TLSKeyFile          string                   `json:"tls_key_file" yaml:"tls_key_file"`
+	IPCPath             string                   `json:"ipc_path" yaml:"ipc_path"`
+	IPCDisable          bool                     `json:"ipc_disable" yaml:"ipc_disable"`
+	GraphQL             bool                     `json:"graphql" yaml:"graphql"`
+	GasCap              uint64                   `json:"gas_cap" yaml:"gas_cap"`
+	Debug               bool                     `json:"debug" yaml:"debug"`
+	Admin               *JSONRPCAdmin            `json:"admin" yaml:"admin"`
+}
+
+// JSONRPCAdmin defines the authenticated JSON-RPC listener of the privileged namespaces.
+// It is enabled when the address is set
+type JSONRPCAdmin struct {
+	Addr            string   `json:"addr" yaml:"addr"`
+	Namespaces      []string `json:"namespaces" yaml:"namespaces"`
+	JWTSecretFile   string   `json:"jwt_secret_file" yaml:"jwt_secret_file"`
+	BearerTokenFile string   `json:"bearer_token_file" yaml:"bearer_token_file"`
+	TLSCertFile     string   `json:"tls_cert_file" yaml:"tls_cert_file"`
+	TLSKeyFile      string   `json:"tls_key_file" yaml:"tls_key_file"`
+}
+
+// MethodFilter defines the methods of a JSON-RPC namespace that can be called
+type MethodFilter struct {
+	Allow []string `json:"allow" yaml:"allow"`
+	Deny  []string `json:"deny" yaml:"deny"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -79,11 +129,24 @@ func DefaultConfig() *Config {
 				defaultNetworkConfig.Addr.Port,
 			),
 		},
+		JSONRPC: &JSONRPC{
+			MaxRequestBodySize:  jsonrpc.DefaultMaxRequestBodySize,
+			BatchLengthLimit:    jsonrpc.DefaultBatchLengthLimit,
+			LogsBlockRangeLimit: jsonrpc.DefaultBlockRangeLimit,
+			LogsResultLimit:     jsonrpc.DefaultLogResultLimit,
+			SubscriptionQueue:   jsonrpc.DefaultSubscriptionQueueSize,
+			SubscriptionPolicy:  string(jsonrpc.OverflowDrop),
+			GasCap:              jsonrpc.DefaultGasCap,
+			Admin: &JSONRPCAdmin{
+				Namespaces: jsonrpc.DefaultPrivilegedNamespaces,
+			},
+		},
 		Telemetry:  &Telemetry{},
 		ShouldSeal: true,
 		TxPool: &TxPool{
-			PriceLimit: 0,
-			MaxSlots:   4096,
+			PriceLimit:      0,
+			MaxSlots:        4096,
+			GossipRateLimit: txpool.DefaultGossipRateLimit,
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
@@ -92,6 +155,7 @@ func DefaultConfig() *Config {
 			AccessControlAllowOrigins: []string{"*"},
 		},
 		LogFilePath: "",
+		IBFT:        &IBFT{},
 	}
 }
 
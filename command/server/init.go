@@ -4,13 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"io/ioutil"
 	"math"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/network/common"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -18,8 +23,9 @@ import (
 )
 
 var (
-	errInvalidBlockTime       = errors.New("invalid block time specified")
-	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errInvalidBlockTime        = errors.New("invalid block time specified")
+	errDataDirectoryUndefined  = errors.New("data directory not defined")
+	errMissingJSONRPCAdminAuth = errors.New("the admin JSON-RPC listener requires a JWT secret or a bearer token file")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -53,6 +59,14 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initSubscriptionPolicy(); err != nil {
+		return err
+	}
+
+	if err := p.initRoundTimeouts(); err != nil {
+		return err
+	}
+
 	if p.isDevMode {
 		p.initDevMode()
 	}
@@ -63,6 +77,44 @@ func (p *serverParams) initRawParams() error {
 	return p.initAddresses()
 }
 
+func (p *serverParams) initSubscriptionPolicy() error {
+	policy, err := jsonrpc.ParseOverflowPolicy(p.rawConfig.JSONRPC.SubscriptionPolicy)
+	if err != nil {
+		return err
+	}
+
+	p.subscriptionPolicy = policy
+
+	return nil
+}
+
+func (p *serverParams) initRoundTimeouts() error {
+	var (
+		timeouts = &consensus.RoundTimeouts{
+			Growth: p.rawConfig.IBFT.TimeoutGrowth,
+		}
+		parseErr error
+	)
+
+	if p.rawConfig.IBFT.BaseTimeout != "" {
+		if timeouts.Base, parseErr = time.ParseDuration(p.rawConfig.IBFT.BaseTimeout); parseErr != nil {
+			return fmt.Errorf("invalid IBFT base timeout, %w", parseErr)
+		}
+	}
+
+	if p.rawConfig.IBFT.MaxTimeout != "" {
+		if timeouts.Max, parseErr = time.ParseDuration(p.rawConfig.IBFT.MaxTimeout); parseErr != nil {
+			return fmt.Errorf("invalid IBFT max timeout, %w", parseErr)
+		}
+	}
+
+	if timeouts.IsSet() {
+		p.roundTimeouts = timeouts
+	}
+
+	return nil
+}
+
 func (p *serverParams) initBlockTime() error {
 	if p.rawConfig.BlockTime < 1 {
 		return errInvalidBlockTime
@@ -223,6 +275,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initJSONRPCAdmin(); err != nil {
+		return err
+	}
+
 	return p.initGRPCAddress()
 }
 
@@ -299,6 +355,51 @@ func (p *serverParams) initJSONRPCAddress() error {
 	return nil
 }
 
+func (p *serverParams) initJSONRPCAdmin() error {
+	if !p.isJSONRPCAdminSet() {
+		return nil
+	}
+
+	rawAdmin := p.rawConfig.JSONRPC.Admin
+
+	addr, err := helper.ResolveAddr(rawAdmin.Addr, helper.LocalHostBinding)
+	if err != nil {
+		return err
+	}
+
+	p.jsonRPCAdmin = &server.JSONRPCAdmin{
+		Addr:        addr,
+		Namespaces:  rawAdmin.Namespaces,
+		TLSCertFile: rawAdmin.TLSCertFile,
+		TLSKeyFile:  rawAdmin.TLSKeyFile,
+	}
+
+	if len(p.jsonRPCAdmin.Namespaces) == 0 {
+		p.jsonRPCAdmin.Namespaces = jsonrpc.DefaultPrivilegedNamespaces
+	}
+
+	if rawAdmin.JWTSecretFile != "" {
+		if p.jsonRPCAdmin.JWTSecret, err = jsonrpc.ReadJWTSecret(rawAdmin.JWTSecretFile); err != nil {
+			return fmt.Errorf("unable to read the JWT secret, %w", err)
+		}
+	}
+
+	if rawAdmin.BearerTokenFile != "" {
+		token, err := ioutil.ReadFile(rawAdmin.BearerTokenFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the bearer token, %w", err)
+		}
+
+		p.jsonRPCAdmin.BearerToken = strings.TrimSpace(string(token))
+	}
+
+	if len(p.jsonRPCAdmin.JWTSecret) == 0 && p.jsonRPCAdmin.BearerToken == "" {
+		return errMissingJSONRPCAdminAuth
+	}
+
+	return nil
+}
+
 func (p *serverParams) initGRPCAddress() error {
 	var parseErr error
 
@@ -6,6 +6,9 @@ import (
 	"net"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -27,6 +30,7 @@ const (
 	maxOutboundPeersFlag  = "max-outbound-peers"
 	priceLimitFlag        = "price-limit"
 	maxSlotsFlag          = "max-slots"
+	gossipRateLimitFlag   = "gossip-rate-limit"
 	blockGasTargetFlag    = "block-gas-target"
 	secretsConfigFlag     = "secrets-config"
 	restoreFlag           = "restore"
@@ -35,18 +39,45 @@ const (
 	devFlag               = "dev"
 	corsOriginFlag        = "access-control-allow-origins"
 	logFileLocationFlag   = "log-to"
+	ibftBaseTimeoutFlag   = "ibft-base-timeout"
+	ibftTimeoutGrowthFlag = "ibft-timeout-growth"
+	ibftMaxTimeoutFlag    = "ibft-max-timeout"
+
+	jsonRPCMaxRequestBodySizeFlag = "jsonrpc-max-request-body-size"
+	jsonRPCBatchLengthLimitFlag   = "jsonrpc-batch-request-limit"
+	jsonRPCRateLimitFlag          = "jsonrpc-rate-limit"
+	jsonRPCBlockRangeLimitFlag    = "jsonrpc-block-range-limit"
+	jsonRPCLogsResultLimitFlag    = "jsonrpc-logs-result-limit"
+	jsonRPCSubscriptionQueueFlag  = "jsonrpc-subscription-queue-size"
+	jsonRPCSubscriptionPolicyFlag = "jsonrpc-subscription-overflow-policy"
+	jsonRPCTLSCertFlag            = "jsonrpc-tls-cert"
+	jsonRPCTLSKeyFlag             = "jsonrpc-tls-key"
+	jsonRPCIPCPathFlag            = "jsonrpc-ipc-path"
+	jsonRPCIPCDisableFlag         = "jsonrpc-ipc-disable"
+	jsonRPCGraphQLFlag            = "jsonrpc-graphql"
+	jsonRPCDebugFlag              = "jsonrpc-debug"
+	jsonRPCGasCapFlag             = "jsonrpc-gas-cap"
+	jsonRPCAdminFlag              = "jsonrpc-admin"
+	jsonRPCAdminJWTSecretFlag     = "jsonrpc-admin-jwt-secret"
 )
 
 const (
 	unsetPeersValue = -1
+
+	// ipcFileName is the name of the IPC endpoint in the data directory
+	ipcFileName = "edge.ipc"
 )
 
 var (
 	params = &serverParams{
 		rawConfig: &config.Config{
+			JSONRPC: &config.JSONRPC{
+				Admin: &config.JSONRPCAdmin{},
+			},
 			Telemetry: &config.Telemetry{},
 			Network:   &config.Network{},
 			TxPool:    &config.TxPool{},
+			IBFT:      &config.IBFT{},
 		},
 	}
 )
@@ -67,6 +98,12 @@ type serverParams struct {
 	grpcAddress       *net.TCPAddr
 	jsonRPCAddress    *net.TCPAddr
 
+	jsonRPCAdmin *server.JSONRPCAdmin
+
+	subscriptionPolicy jsonrpc.OverflowPolicy
+
+	roundTimeouts *consensus.RoundTimeouts
+
 	blockGasTarget uint64
 	devInterval    uint64
 	isDevMode      bool
@@ -113,6 +150,10 @@ func (p *serverParams) isDNSAddressSet() bool {
 	return p.rawConfig.Network.DNSAddr != ""
 }
 
+func (p *serverParams) isJSONRPCAdminSet() bool {
+	return p.rawConfig.JSONRPC.Admin != nil && p.rawConfig.JSONRPC.Admin.Addr != ""
+}
+
 func (p *serverParams) isLogFileLocationSet() bool {
 	return p.rawConfig.LogFilePath != ""
 }
@@ -137,12 +178,62 @@ func (p *serverParams) setRawJSONRPCAddress(jsonRPCAddress string) {
 	p.rawConfig.JSONRPCAddr = jsonRPCAddress
 }
 
+// getIPCPath returns the path of the IPC endpoint, the data directory is used by default
+func (p *serverParams) getIPCPath() string {
+	if p.rawConfig.JSONRPC.IPCDisable {
+		return ""
+	}
+
+	if p.rawConfig.JSONRPC.IPCPath != "" {
+		return p.rawConfig.JSONRPC.IPCPath
+	}
+
+	return ipc.DefaultPath(p.rawConfig.DataDir, ipcFileName)
+}
+
+func (p *serverParams) getJSONRPCMethodFilters() map[string]*jsonrpc.MethodFilter {
+	filters := make(map[string]*jsonrpc.MethodFilter, len(p.rawConfig.JSONRPC.MethodFilters))
+
+	for namespace, filter := range p.rawConfig.JSONRPC.MethodFilters {
+		if filter == nil {
+			continue
+		}
+
+		filters[namespace] = &jsonrpc.MethodFilter{
+			Allow: filter.Allow,
+			Deny:  filter.Deny,
+		}
+	}
+
+	return filters
+}
+
 func (p *serverParams) generateConfig() *server.Config {
 	return &server.Config{
 		Chain: p.genesisConfig,
 		JSONRPC: &server.JSONRPC{
 			JSONRPCAddr:              p.jsonRPCAddress,
 			AccessControlAllowOrigin: p.corsAllowedOrigins,
+			MaxRequestBodySize:       p.rawConfig.JSONRPC.MaxRequestBodySize,
+			BatchLengthLimit:         p.rawConfig.JSONRPC.BatchLengthLimit,
+			RateLimit:                p.rawConfig.JSONRPC.RateLimit,
+			APIKeys:                  p.rawConfig.JSONRPC.APIKeys,
+			MethodFilters:            p.getJSONRPCMethodFilters(),
+			LogQueryLimits: jsonrpc.LogQueryLimits{
+				BlockRange: p.rawConfig.JSONRPC.LogsBlockRangeLimit,
+				Results:    p.rawConfig.JSONRPC.LogsResultLimit,
+			},
+			SubscriptionQueue: jsonrpc.SubscriptionQueueConfig{
+				Size:   p.rawConfig.JSONRPC.SubscriptionQueue,
+				Policy: p.subscriptionPolicy,
+			},
+			TLSCertFile: p.rawConfig.JSONRPC.TLSCertFile,
+			TLSKeyFile:  p.rawConfig.JSONRPC.TLSKeyFile,
+			IPCPath:     p.getIPCPath(),
+			GraphQL:     p.rawConfig.JSONRPC.GraphQL,
+			GasCap:      p.rawConfig.JSONRPC.GasCap,
+			Debug:       p.rawConfig.JSONRPC.Debug,
+			Admin:       p.jsonRPCAdmin,
 		},
 		GRPCAddr:   p.grpcAddress,
 		LibP2PAddr: p.libp2pAddress,
@@ -160,14 +251,16 @@ func (p *serverParams) generateConfig() *server.Config {
 			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
 			Chain:            p.genesisConfig,
 		},
-		DataDir:        p.rawConfig.DataDir,
-		Seal:           p.rawConfig.ShouldSeal,
-		PriceLimit:     p.rawConfig.TxPool.PriceLimit,
-		MaxSlots:       p.rawConfig.TxPool.MaxSlots,
-		SecretsManager: p.secretsConfig,
-		RestoreFile:    p.getRestoreFilePath(),
-		BlockTime:      p.rawConfig.BlockTime,
-		LogLevel:       hclog.LevelFromString(p.rawConfig.LogLevel),
-		LogFilePath:    p.logFileLocation,
+		DataDir:         p.rawConfig.DataDir,
+		Seal:            p.rawConfig.ShouldSeal,
+		PriceLimit:      p.rawConfig.TxPool.PriceLimit,
+		MaxSlots:        p.rawConfig.TxPool.MaxSlots,
+		GossipRateLimit: p.rawConfig.TxPool.GossipRateLimit,
+		SecretsManager:  p.secretsConfig,
+		RestoreFile:     p.getRestoreFilePath(),
+		BlockTime:       p.rawConfig.BlockTime,
+		RoundTimeouts:   p.roundTimeouts,
+		LogLevel:        hclog.LevelFromString(p.rawConfig.LogLevel),
+		LogFilePath:     p.logFileLocation,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/server"
 )
 
@@ -176,6 +177,134 @@ func setFlags(cmd *cobra.Command) {
 		"maximum slots in the pool",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.GossipRateLimit,
+		gossipRateLimitFlag,
+		defaultConfig.TxPool.GossipRateLimit,
+		"maximum number of gossiped transactions accepted from a single peer per second (0 means unlimited)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.MaxRequestBodySize,
+		jsonRPCMaxRequestBodySizeFlag,
+		defaultConfig.JSONRPC.MaxRequestBodySize,
+		"maximum size of a JSON-RPC request in bytes (0 means unlimited)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.BatchLengthLimit,
+		jsonRPCBatchLengthLimitFlag,
+		defaultConfig.JSONRPC.BatchLengthLimit,
+		fmt.Sprintf(
+			"maximum number of requests in a JSON-RPC batch (0 means unlimited, %d recommended for public nodes)",
+			jsonrpc.RecommendedBatchLengthLimit,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.RateLimit,
+		jsonRPCRateLimitFlag,
+		defaultConfig.JSONRPC.RateLimit,
+		"maximum number of JSON-RPC requests accepted from a single IP per second (0 means unlimited)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.LogsBlockRangeLimit,
+		jsonRPCBlockRangeLimitFlag,
+		defaultConfig.JSONRPC.LogsBlockRangeLimit,
+		fmt.Sprintf(
+			"maximum number of blocks a log query can span (0 means unlimited, %d recommended for public nodes)",
+			jsonrpc.RecommendedBlockRangeLimit,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.LogsResultLimit,
+		jsonRPCLogsResultLimitFlag,
+		defaultConfig.JSONRPC.LogsResultLimit,
+		fmt.Sprintf(
+			"maximum number of logs a log query can return (0 means unlimited, %d recommended for public nodes)",
+			jsonrpc.RecommendedLogResultLimit,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.SubscriptionQueue,
+		jsonRPCSubscriptionQueueFlag,
+		defaultConfig.JSONRPC.SubscriptionQueue,
+		"maximum number of subscription notifications queued for a WebSocket or IPC connection",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.SubscriptionPolicy,
+		jsonRPCSubscriptionPolicyFlag,
+		defaultConfig.JSONRPC.SubscriptionPolicy,
+		"what happens to the notifications of a connection once its queue is full (drop, disconnect)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.TLSCertFile,
+		jsonRPCTLSCertFlag,
+		defaultConfig.JSONRPC.TLSCertFile,
+		"the TLS certificate of the JSON-RPC listener, served over plain HTTP if not set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.TLSKeyFile,
+		jsonRPCTLSKeyFlag,
+		defaultConfig.JSONRPC.TLSKeyFile,
+		"the TLS key of the JSON-RPC listener",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.IPCPath,
+		jsonRPCIPCPathFlag,
+		defaultConfig.JSONRPC.IPCPath,
+		"the path of the JSON-RPC IPC endpoint (default edge.ipc in the data directory)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPC.IPCDisable,
+		jsonRPCIPCDisableFlag,
+		defaultConfig.JSONRPC.IPCDisable,
+		"disable the JSON-RPC IPC endpoint",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPC.GraphQL,
+		jsonRPCGraphQLFlag,
+		defaultConfig.JSONRPC.GraphQL,
+		"serve the GraphQL queries on the /graphql path of the JSON-RPC listener",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPC.Debug,
+		jsonRPCDebugFlag,
+		defaultConfig.JSONRPC.Debug,
+		"serve the debug namespace, which re-executes transactions, on the public JSON-RPC listener",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPC.GasCap,
+		jsonRPCGasCapFlag,
+		defaultConfig.JSONRPC.GasCap,
+		"the maximum gas of the eth_call and eth_estimateGas requests (0 means unlimited)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.Admin.Addr,
+		jsonRPCAdminFlag,
+		defaultConfig.JSONRPC.Admin.Addr,
+		"the address of the authenticated JSON-RPC listener serving the privileged namespaces (disabled if not set)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPC.Admin.JWTSecretFile,
+		jsonRPCAdminJWTSecretFlag,
+		defaultConfig.JSONRPC.Admin.JWTSecretFile,
+		"the file with the hex encoded JWT secret of the authenticated JSON-RPC listener",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.BlockTime,
 		blockTimeFlag,
@@ -183,6 +312,28 @@ func setFlags(cmd *cobra.Command) {
 		"minimum block time in seconds (at least 1s)",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.IBFT.BaseTimeout,
+		ibftBaseTimeoutFlag,
+		defaultConfig.IBFT.BaseTimeout,
+		"the IBFT timeout of the first round, overriding the chain config (e.g. 500ms). "+
+			"The timeout of the round r is the base timeout times the timeout growth to the power r",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.IBFT.TimeoutGrowth,
+		ibftTimeoutGrowthFlag,
+		defaultConfig.IBFT.TimeoutGrowth,
+		"the growth of the IBFT round timeouts, overriding the chain config (at least 1)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.IBFT.MaxTimeout,
+		ibftMaxTimeoutFlag,
+		defaultConfig.IBFT.MaxTimeout,
+		"the maximum IBFT round timeout, overriding the chain config (e.g. 30s)",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.corsAllowedOrigins,
 		corsOriginFlag,
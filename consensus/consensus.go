@@ -3,6 +3,7 @@ package consensus
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
@@ -72,6 +73,20 @@ type ConsensusParams struct {
 	Metrics        *Metrics
 	SecretsManager secrets.SecretsManager
 	BlockTime      uint64
+	RoundTimeouts  *RoundTimeouts
+}
+
+// RoundTimeouts are the local overrides of the round timeouts of the chain config,
+// the fields that are not set are not overridden
+type RoundTimeouts struct {
+	Base   time.Duration
+	Growth float64
+	Max    time.Duration
+}
+
+// IsSet checks if any round timeout is overridden
+func (t *RoundTimeouts) IsSet() bool {
+	return t.Base != 0 || t.Growth != 0 || t.Max != 0
 }
 
 // Factory is the factory function to create a discovery backend
@@ -0,0 +1,462 @@
+package ibft
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrBLSFieldsBeforeFork     = errors.New("BLS fields found before the BLS fork")
+	ErrCommittedSealsAfterFork = errors.New("committed seals found after the BLS fork")
+	ErrMissingAggregatedSeal   = errors.New("missing aggregated seal")
+	ErrInvalidSignerBitmap     = errors.New("invalid signer bitmap")
+	ErrMissingBLSKey           = errors.New("BLS public key of the validator not found")
+	ErrInvalidAggregatedSeal   = errors.New("invalid aggregated seal")
+	ErrUnexpectedRegistration  = errors.New("unexpected BLS registration")
+	ErrInvalidRegistration     = errors.New("invalid BLS registration")
+	ErrInvalidValidatorBLSKeys = errors.New("invalid validator BLS keys")
+)
+
+// BLSValidator is the BLS public key of a validator in the chain config
+type BLSValidator struct {
+	PublicKey string `json:"publicKey"`
+	Proof     string `json:"proof"`
+}
+
+// BLSFork is the configuration of the BLS mode, in which the committed seals
+// are BLS signatures aggregated in a single seal of the extra data.
+//
+// The public keys of the genesis validators are set in the config,
+// the other validators register their public key in the first block they propose
+type BLSFork struct {
+	From       common.JSONNumber               `json:"from"`
+	Validators map[types.Address]*BLSValidator `json:"validators,omitempty"`
+}
+
+// GetBLSFork returns the BLS fork from the IBFT config, or nil if it isn't set
+func GetBLSFork(ibftConfig map[string]interface{}) (*BLSFork, error) {
+	rawFork, ok := ibftConfig["bls"]
+	if !ok {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(rawFork)
+	if err != nil {
+		return nil, err
+	}
+
+	fork := &BLSFork{}
+	if err := json.Unmarshal(raw, fork); err != nil {
+		return nil, fmt.Errorf("invalid BLS fork, %w", err)
+	}
+
+	// the keys of the config are aggregated as is, their proofs of possession are checked once
+	for addr, validator := range fork.Validators {
+		if _, err := validator.decode(); err != nil {
+			return nil, fmt.Errorf("invalid BLS public key of %s, %w", addr, err)
+		}
+	}
+
+	return fork, nil
+}
+
+// decode decodes the public key, and checks its proof of possession
+func (v *BLSValidator) decode() (*bls.PublicKey, error) {
+	rawKey, err := hex.DecodeHex(v.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rawProof, err := hex.DecodeHex(v.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeBLSRegistration(&BLSRegistration{PublicKey: rawKey, Proof: rawProof})
+}
+
+// decodeBLSRegistration decodes the public key of the registration, and checks its proof of possession
+func decodeBLSRegistration(registration *BLSRegistration) (*bls.PublicKey, error) {
+	key, err := bls.UnmarshalPublicKey(registration.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := bls.UnmarshalSignature(registration.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	if !proof.VerifyPossession(key) {
+		return nil, ErrInvalidRegistration
+	}
+
+	return key, nil
+}
+
+// blsKeyCache caches the decoded public keys, since decoding checks the points are in G2
+type blsKeyCache struct {
+	lock sync.Mutex
+	keys map[string]*bls.PublicKey
+}
+
+// get decodes the public key, or returns it from the cache
+func (c *blsKeyCache) get(raw []byte) (*bls.PublicKey, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if key, ok := c.keys[string(raw)]; ok {
+		return key, nil
+	}
+
+	key, err := bls.UnmarshalPublicKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.keys == nil {
+		c.keys = map[string]*bls.PublicKey{}
+	}
+
+	c.keys[string(raw)] = key
+
+	return key, nil
+}
+
+// isBLSActive checks if the committed seals of the block are aggregated BLS signatures
+func (i *Ibft) isBLSActive(number uint64) bool {
+	return i.blsFork != nil && number >= i.blsFork.From.Value
+}
+
+// rawBLSKey returns the encoded BLS public key of the validator,
+// registered in the snapshot or set in the config, or nil if it isn't known
+func (i *Ibft) rawBLSKey(snap *Snapshot, addr types.Address) []byte {
+	if rawKey, ok := snap.BLSKeys[addr]; ok {
+		if key, err := hex.DecodeHex(rawKey); err == nil {
+			return key
+		}
+	}
+
+	if validator, ok := i.blsFork.Validators[addr]; ok {
+		if key, err := hex.DecodeHex(validator.PublicKey); err == nil {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// blsKey returns the BLS public key of the validator, or nil if it isn't known
+func (i *Ibft) blsKey(snap *Snapshot, addr types.Address) *bls.PublicKey {
+	rawKey := i.rawBLSKey(snap, addr)
+	if rawKey == nil {
+		return nil
+	}
+
+	key, err := i.blsKeys.get(rawKey)
+	if err != nil {
+		return nil
+	}
+
+	return key
+}
+
+// expectedValidatorBLSKeys returns the BLS public keys of the validators, with an empty key for the unknown ones,
+// including the key registered by the proposer of the block
+func (i *Ibft) expectedValidatorBLSKeys(
+	snap *Snapshot,
+	validators []types.Address,
+	proposer types.Address,
+	registration *BLSRegistration,
+) [][]byte {
+	keys := make([][]byte, len(validators))
+
+	for indx, addr := range validators {
+		if registration != nil && addr == proposer {
+			keys[indx] = registration.PublicKey
+		} else if key := i.rawBLSKey(snap, addr); key != nil {
+			keys[indx] = key
+		} else {
+			keys[indx] = []byte{}
+		}
+	}
+
+	return keys
+}
+
+// sealerBLSKeys returns the lookup of the BLS public keys of the sealers of the header,
+// where the proposer registering its key can sign the seal of its block
+func (i *Ibft) sealerBLSKeys(
+	snap *Snapshot,
+	header *types.Header,
+	extra *IstanbulExtra,
+) (func(types.Address) *bls.PublicKey, error) {
+	var (
+		proposer      types.Address
+		registeredKey *bls.PublicKey
+	)
+
+	if extra.BLSRegistration != nil {
+		var err error
+
+		if proposer, err = ecrecoverFromHeader(header); err != nil {
+			return nil, err
+		}
+
+		if registeredKey, err = i.blsKeys.get(extra.BLSRegistration.PublicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(addr types.Address) *bls.PublicKey {
+		if registeredKey != nil && addr == proposer {
+			return registeredKey
+		}
+
+		return i.blsKey(snap, addr)
+	}, nil
+}
+
+// verifyBLSFields checks the BLS fields of the extra data, except the aggregated seal
+func (i *Ibft) verifyBLSFields(snap *Snapshot, header *types.Header, extra *IstanbulExtra) error {
+	if !i.isBLSActive(header.Number) {
+		if extra.hasBLSFields() {
+			return ErrBLSFieldsBeforeFork
+		}
+
+		return nil
+	}
+
+	if len(extra.CommittedSeal) != 0 {
+		return ErrCommittedSealsAfterFork
+	}
+
+	proposer, err := ecrecoverFromHeader(header)
+	if err != nil {
+		return err
+	}
+
+	// a validator can only register its key once
+	if extra.BLSRegistration != nil {
+		if i.rawBLSKey(snap, proposer) != nil {
+			return ErrUnexpectedRegistration
+		}
+
+		if _, err := decodeBLSRegistration(extra.BLSRegistration); err != nil {
+			return fmt.Errorf("%w, %v", ErrInvalidRegistration, err)
+		}
+	}
+
+	// the validator keys are only checkpointed in the epoch blocks
+	if header.Number%i.epochSize != 0 {
+		if extra.ValidatorBLSKeys != nil {
+			return ErrInvalidValidatorBLSKeys
+		}
+
+		return nil
+	}
+
+	expected := i.expectedValidatorBLSKeys(snap, extra.Validators, proposer, extra.BLSRegistration)
+	if len(expected) != len(extra.ValidatorBLSKeys) {
+		return ErrInvalidValidatorBLSKeys
+	}
+
+	for indx, key := range expected {
+		if !bytes.Equal(key, extra.ValidatorBLSKeys[indx]) {
+			return ErrInvalidValidatorBLSKeys
+		}
+	}
+
+	return nil
+}
+
+// verifyAggregatedSeal checks the aggregated seal is signed by a quorum of the validators
+func (i *Ibft) verifyAggregatedSeal(
+	snap *Snapshot,
+	header *types.Header,
+	quorumSizeFn QuorumImplementation,
+) error {
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return err
+	}
+
+	if extra.AggregatedSeal == nil {
+		return ErrMissingAggregatedSeal
+	}
+
+	bitmap := extra.AggregatedSeal.Bitmap
+	if len(bitmap) != signerBitmapSize(len(snap.Set)) {
+		return ErrInvalidSignerBitmap
+	}
+
+	sealerKey, err := i.sealerBLSKeys(snap, header, extra)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]*bls.PublicKey, 0, len(snap.Set))
+
+	for indx := 0; indx < len(bitmap)*8; indx++ {
+		if bitmap[indx/8]&(1<<(indx%8)) == 0 {
+			continue
+		}
+
+		if indx >= len(snap.Set) {
+			return ErrInvalidSignerBitmap
+		}
+
+		key := sealerKey(snap.Set[indx])
+		if key == nil {
+			return fmt.Errorf("%w: %s", ErrMissingBLSKey, snap.Set[indx])
+		}
+
+		keys = append(keys, key)
+	}
+
+	// Valid committed seals must be at least 2F+1, as for the ECDSA seals
+	if len(keys) < quorumSizeFn(snap.Set) {
+		return fmt.Errorf("not enough seals to seal block")
+	}
+
+	signature, err := bls.UnmarshalSignature(extra.AggregatedSeal.Signature)
+	if err != nil {
+		return err
+	}
+
+	aggregatedKey, err := bls.AggregatePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	hash, err := calculateHeaderHash(header)
+	if err != nil {
+		return err
+	}
+
+	if !signature.Verify(aggregatedKey, finality.CommitMsg(hash)) {
+		return ErrInvalidAggregatedSeal
+	}
+
+	return nil
+}
+
+// aggregateCommittedSeals aggregates the BLS committed seals of the validators,
+// dropping the invalid seals if the aggregation doesn't verify
+func (i *Ibft) aggregateCommittedSeals(
+	snap *Snapshot,
+	header *types.Header,
+	seals map[types.Address][]byte,
+) (*AggregatedSeal, error) {
+	hash, err := calculateHeaderHash(header)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := finality.CommitMsg(hash)
+
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	sealerKey, err := i.sealerBLSKeys(snap, header, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	type signer struct {
+		index     int
+		key       *bls.PublicKey
+		signature *bls.Signature
+	}
+
+	signers := make([]*signer, 0, len(seals))
+
+	for indx, addr := range snap.Set {
+		seal, ok := seals[addr]
+		if !ok {
+			continue
+		}
+
+		key := sealerKey(addr)
+		signature, err := bls.UnmarshalSignature(seal)
+		if key == nil || err != nil {
+			i.logger.Error("invalid committed seal", "from", addr)
+
+			continue
+		}
+
+		signers = append(signers, &signer{index: indx, key: key, signature: signature})
+	}
+
+	aggregate := func() (*bls.Signature, *bls.PublicKey, error) {
+		signatures := make([]*bls.Signature, len(signers))
+		keys := make([]*bls.PublicKey, len(signers))
+
+		for indx, s := range signers {
+			signatures[indx] = s.signature
+			keys[indx] = s.key
+		}
+
+		signature, err := bls.AggregateSignatures(signatures)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, err := bls.AggregatePublicKeys(keys)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return signature, key, nil
+	}
+
+	signature, key, err := aggregate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !signature.Verify(key, msg) {
+		// some seal is invalid, check them one by one
+		valid := signers[:0]
+
+		for _, s := range signers {
+			if s.signature.Verify(s.key, msg) {
+				valid = append(valid, s)
+			} else {
+				i.logger.Error("invalid committed seal", "from", snap.Set[s.index])
+			}
+		}
+
+		signers = valid
+
+		if signature, _, err = aggregate(); err != nil {
+			return nil, err
+		}
+	}
+
+	bitmap := make([]byte, signerBitmapSize(len(snap.Set)))
+	for _, s := range signers {
+		bitmap[s.index/8] |= 1 << (s.index % 8)
+	}
+
+	return &AggregatedSeal{
+		Bitmap:    bitmap,
+		Signature: signature.Marshal(),
+	}, nil
+}
+
+// signerBitmapSize returns the size of the bitmap of the signers of a validator set
+func signerBitmapSize(validators int) int {
+	return (validators + 7) / 8
+}
@@ -0,0 +1,284 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBLSTester returns an IBFT with a BLS fork from the block 1,
+// with the BLS keys of the configured accounts in the config
+func newBLSTester(
+	t *testing.T,
+	pool *testerAccountPool,
+	configured ...string,
+) (*Ibft, map[string]*bls.SecretKey) {
+	t.Helper()
+
+	keys := map[string]*bls.SecretKey{}
+	validators := map[types.Address]*BLSValidator{}
+
+	for _, account := range pool.accounts {
+		key, err := bls.GenerateSecretKey()
+		assert.NoError(t, err)
+
+		keys[account.alias] = key
+	}
+
+	for _, account := range configured {
+		validators[pool.get(account).Address()] = &BLSValidator{
+			PublicKey: hex.EncodeToHex(keys[account].PublicKey().Marshal()),
+			Proof:     hex.EncodeToHex(keys[account].ProvePossession().Marshal()),
+		}
+	}
+
+	return &Ibft{
+		logger:    hclog.NewNullLogger(),
+		epochSize: 10,
+		blsFork: &BLSFork{
+			From:       common.JSONNumber{Value: 1},
+			Validators: validators,
+		},
+	}, keys
+}
+
+// buildBLSHeader returns the header of the block sealed by the proposer
+func buildBLSHeader(pool *testerAccountPool, number uint64, proposer string, extra *IstanbulExtra) *types.Header {
+	h := &types.Header{Number: number}
+
+	extra.Validators = pool.ValidatorSet()
+	extra.Seal = []byte{}
+	extra.CommittedSeal = [][]byte{}
+
+	_ = PutIbftExtra(h, extra)
+
+	return pool.get(proposer).sign(h)
+}
+
+func TestGetBLSFork(t *testing.T) {
+	key, err := bls.GenerateSecretKey()
+	assert.NoError(t, err)
+
+	otherKey, err := bls.GenerateSecretKey()
+	assert.NoError(t, err)
+
+	// no fork
+	fork, err := GetBLSFork(map[string]interface{}{"type": PoA})
+	assert.NoError(t, err)
+	assert.Nil(t, fork)
+
+	config := func(proof *bls.Signature) map[string]interface{} {
+		return map[string]interface{}{
+			"bls": map[string]interface{}{
+				"from": "0x10",
+				"validators": map[string]interface{}{
+					types.StringToAddress("1").String(): map[string]interface{}{
+						"publicKey": hex.EncodeToHex(key.PublicKey().Marshal()),
+						"proof":     hex.EncodeToHex(proof.Marshal()),
+					},
+				},
+			},
+		}
+	}
+
+	fork, err = GetBLSFork(config(key.ProvePossession()))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(16), fork.From.Value)
+	assert.Len(t, fork.Validators, 1)
+
+	// the proof of possession of another key is rejected
+	_, err = GetBLSFork(config(otherKey.ProvePossession()))
+	assert.ErrorIs(t, err, ErrInvalidRegistration)
+}
+
+func TestBLS_AggregatedSeal(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D", "E")
+
+	i, keys := newBLSTester(t, pool, "A", "B", "C", "D")
+	snap := &Snapshot{Set: pool.ValidatorSet()}
+
+	header := buildBLSHeader(pool, 1, "A", &IstanbulExtra{})
+
+	sealWith := func(seals map[string]*bls.SecretKey) (*types.Header, error) {
+		rawSeals := map[types.Address][]byte{}
+
+		for account, key := range seals {
+			seal, err := writeBLSCommittedSeal(key, header)
+			assert.NoError(t, err)
+
+			rawSeals[pool.get(account).Address()] = seal
+		}
+
+		aggregatedSeal, err := i.aggregateCommittedSeals(snap, header, rawSeals)
+		if err != nil {
+			return nil, err
+		}
+
+		return writeAggregatedSeal(header, aggregatedSeal)
+	}
+
+	// Correct
+	sealed, err := sealWith(map[string]*bls.SecretKey{
+		"A": keys["A"], "B": keys["B"], "C": keys["C"], "D": keys["D"],
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, i.verifyAggregatedSeal(snap, sealed, OptimalQuorumSize))
+
+	extra, err := getIbftExtra(sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x0f}, extra.AggregatedSeal.Bitmap)
+
+	// the seals don't change the hash of the block
+	assert.Equal(t, istanbulHeaderHash(header), istanbulHeaderHash(sealed))
+
+	// Failed - the invalid seal of D is dropped, leaving too few seals
+	sealed, err = sealWith(map[string]*bls.SecretKey{
+		"A": keys["A"], "B": keys["B"], "C": keys["C"], "D": keys["E"],
+	})
+	assert.NoError(t, err)
+	assert.Error(t, i.verifyAggregatedSeal(snap, sealed, OptimalQuorumSize))
+
+	extra, err = getIbftExtra(sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x07}, extra.AggregatedSeal.Bitmap)
+
+	// Failed - the bitmap claims a signer which didn't sign
+	extra.AggregatedSeal.Bitmap = []byte{0x0f}
+	tampered, err := writeAggregatedSeal(sealed, extra.AggregatedSeal)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyAggregatedSeal(snap, tampered, OptimalQuorumSize), ErrInvalidAggregatedSeal)
+
+	// Failed - the bitmap is out of the validator set
+	extra.AggregatedSeal.Bitmap = []byte{0x0f, 0x01}
+	tampered, err = writeAggregatedSeal(sealed, extra.AggregatedSeal)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyAggregatedSeal(snap, tampered, OptimalQuorumSize), ErrInvalidSignerBitmap)
+
+	// Failed - the BLS key of E isn't known
+	extra.AggregatedSeal.Bitmap = []byte{0x17}
+	tampered, err = writeAggregatedSeal(sealed, extra.AggregatedSeal)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyAggregatedSeal(snap, tampered, OptimalQuorumSize), ErrMissingBLSKey)
+
+	// Failed - missing aggregated seal
+	assert.ErrorIs(t, i.verifyAggregatedSeal(snap, header, OptimalQuorumSize), ErrMissingAggregatedSeal)
+}
+
+func TestBLS_Registration(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D", "E")
+
+	i, keys := newBLSTester(t, pool, "A", "B", "C")
+	snap := &Snapshot{Set: pool.ValidatorSet()}
+
+	registration := func(account string) *BLSRegistration {
+		return &BLSRegistration{
+			PublicKey: keys[account].PublicKey().Marshal(),
+			Proof:     keys[account].ProvePossession().Marshal(),
+		}
+	}
+
+	// D registers its key, and can seal its block
+	header := buildBLSHeader(pool, 1, "D", &IstanbulExtra{BLSRegistration: registration("D")})
+
+	extra, err := getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.NoError(t, i.verifyBLSFields(snap, header, extra))
+
+	rawSeals := map[types.Address][]byte{}
+
+	for _, account := range []string{"A", "B", "C", "D"} {
+		seal, err := writeBLSCommittedSeal(keys[account], header)
+		assert.NoError(t, err)
+
+		rawSeals[pool.get(account).Address()] = seal
+	}
+
+	aggregatedSeal, err := i.aggregateCommittedSeals(snap, header, rawSeals)
+	assert.NoError(t, err)
+
+	sealed, err := writeAggregatedSeal(header, aggregatedSeal)
+	assert.NoError(t, err)
+	assert.NoError(t, i.verifyAggregatedSeal(snap, sealed, OptimalQuorumSize))
+
+	// the key is registered in the snapshot
+	next := snap.Copy()
+	assert.NoError(t, registerBLSKey(next, sealed, pool.get("D").Address()))
+	assert.False(t, next.Equal(snap))
+	assert.True(t, next.Equal(next.Copy()))
+	assert.True(t, i.blsKey(next, pool.get("D").Address()).Equal(keys["D"].PublicKey()))
+
+	// Failed - D can't register a key twice
+	header = buildBLSHeader(pool, 2, "D", &IstanbulExtra{BLSRegistration: registration("D")})
+	extra, err = getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyBLSFields(next, header, extra), ErrUnexpectedRegistration)
+
+	// Failed - E registers with the proof of possession of another key
+	invalid := registration("E")
+	invalid.Proof = keys["A"].ProvePossession().Marshal()
+	header = buildBLSHeader(pool, 2, "E", &IstanbulExtra{BLSRegistration: invalid})
+	extra, err = getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyBLSFields(next, header, extra), ErrInvalidRegistration)
+
+	// Failed - BLS fields before the fork
+	header = buildBLSHeader(pool, 0, "E", &IstanbulExtra{BLSRegistration: registration("E")})
+	extra, err = getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyBLSFields(next, header, extra), ErrBLSFieldsBeforeFork)
+}
+
+func TestBLS_ValidatorKeysCheckpoint(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	i, keys := newBLSTester(t, pool, "A", "B")
+	snap := &Snapshot{
+		Set: pool.ValidatorSet(),
+		BLSKeys: map[types.Address]string{
+			pool.get("C").Address(): hex.EncodeToHex(keys["C"].PublicKey().Marshal()),
+		},
+	}
+
+	expected := i.expectedValidatorBLSKeys(snap, snap.Set, pool.get("A").Address(), nil)
+	assert.Equal(t, [][]byte{
+		keys["A"].PublicKey().Marshal(),
+		keys["B"].PublicKey().Marshal(),
+		keys["C"].PublicKey().Marshal(),
+		{},
+	}, expected)
+
+	// the keys are checkpointed in the epoch blocks only
+	header := buildBLSHeader(pool, 10, "A", &IstanbulExtra{ValidatorBLSKeys: expected})
+	extra, err := getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.NoError(t, i.verifyBLSFields(snap, header, extra))
+
+	header = buildBLSHeader(pool, 11, "A", &IstanbulExtra{ValidatorBLSKeys: expected})
+	extra, err = getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyBLSFields(snap, header, extra), ErrInvalidValidatorBLSKeys)
+
+	header = buildBLSHeader(pool, 10, "A", &IstanbulExtra{ValidatorBLSKeys: expected[:3]})
+	extra, err = getIbftExtra(header)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, i.verifyBLSFields(snap, header, extra), ErrInvalidValidatorBLSKeys)
+
+	// the snapshot restored from the epoch block has the registered keys
+	i.store = newSnapshotStore()
+	header = buildBLSHeader(pool, 10, "A", &IstanbulExtra{ValidatorBLSKeys: expected})
+	assert.NoError(t, i.addHeaderSnap(header))
+
+	restored, err := i.getSnapshot(10)
+	assert.NoError(t, err)
+	assert.Len(t, restored.BLSKeys, 3)
+	assert.True(t, i.blsKey(restored, pool.get("C").Address()).Equal(keys["C"].PublicKey()))
+	assert.Nil(t, i.blsKey(restored, pool.get("D").Address()))
+}
@@ -0,0 +1,50 @@
+package ibft
+
+import "time"
+
+// WaitResult is the reason a Clock wait has ended
+type WaitResult int
+
+const (
+	// WaitExpired means the deadline has passed
+	WaitExpired WaitResult = iota
+
+	// WaitWoken means the wake channel has fired before the deadline
+	WaitWoken
+
+	// WaitDone means the done channel has been closed before the deadline
+	WaitDone
+)
+
+// Clock is the source of time for the IBFT state machine.
+// Every timer of the consensus goes through it, which lets tests
+// and the simulator drive the engine on a virtual timeline
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+
+	// Wait blocks until the deadline passes, or until the wake or done channel fires.
+	// Nil channels are never selected
+	Wait(deadline time.Time, wake, done <-chan struct{}) WaitResult
+}
+
+// systemClock is the Clock backed by the wall clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Wait(deadline time.Time, wake, done <-chan struct{}) WaitResult {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return WaitExpired
+	case <-wake:
+		return WaitWoken
+	case <-done:
+		return WaitDone
+	}
+}
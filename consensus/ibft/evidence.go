@@ -0,0 +1,293 @@
+package ibft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/abi"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// maxEvidenceAge is the number of blocks during which an equivocation can be reported
+const maxEvidenceAge = 1000
+
+var (
+	errInvalidEvidence = errors.New("invalid equivocation evidence")
+)
+
+// slashMethod is the system transaction reporting an equivocation to the staking SC
+var slashMethod = mustNewMethod("function slash(bytes first, bytes second)")
+
+func mustNewMethod(signature string) *abi.Method {
+	method, err := abi.NewMethod(signature)
+	if err != nil {
+		panic(err)
+	}
+
+	return method
+}
+
+// equivocationTypes are the messages that a validator signs once per view.
+// Preprepare messages aren't reported since they carry the whole block,
+// and the proposer also prepares its proposal
+var equivocationTypes = map[proto.MessageReq_Type]bool{
+	proto.MessageReq_Prepare: true,
+	proto.MessageReq_Commit:  true,
+}
+
+// equivocation is the evidence that a validator signed two different digests in the same view
+type equivocation struct {
+	first  *proto.MessageReq
+	second *proto.MessageReq
+}
+
+// offender returns the validator that signed the messages
+func (e *equivocation) offender() types.Address {
+	return e.first.FromAddr()
+}
+
+// sequence returns the height of the messages
+func (e *equivocation) sequence() uint64 {
+	return e.first.View.Sequence
+}
+
+// id returns the identifier of the evidence. A validator is slashed once per height
+func (e *equivocation) id() types.Hash {
+	sequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequence, e.sequence())
+
+	return types.BytesToHash(keccak.Keccak256(nil, append(e.offender().Bytes(), sequence...)))
+}
+
+// encode returns the input of the system transaction reporting the equivocation
+func (e *equivocation) encode() ([]byte, error) {
+	first, err := encodeEvidenceMsg(e.first)
+	if err != nil {
+		return nil, err
+	}
+
+	second, err := encodeEvidenceMsg(e.second)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashMethod.Encode([]interface{}{first, second})
+}
+
+// encodeEvidenceMsg encodes the signed part of the message, the sender is recovered from the signature
+func encodeEvidenceMsg(msg *proto.MessageReq) ([]byte, error) {
+	msg = msg.Copy()
+	msg.From = ""
+
+	return protobuf.Marshal(msg)
+}
+
+// decodeEquivocation decodes the input of the system transaction reporting an equivocation
+func decodeEquivocation(input []byte) (*equivocation, error) {
+	if len(input) < 4 || !bytes.Equal(input[:4], slashMethod.ID()) {
+		return nil, fmt.Errorf("%w: unknown method", errInvalidEvidence)
+	}
+
+	args, err := slashMethod.Inputs.Decode(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEvidence, err)
+	}
+
+	rawArgs, ok := args.(map[string]interface{})
+	if !ok {
+		return nil, errInvalidEvidence
+	}
+
+	e := &equivocation{}
+
+	for name, msg := range map[string]**proto.MessageReq{"first": &e.first, "second": &e.second} {
+		raw, ok := rawArgs[name].([]byte)
+		if !ok {
+			return nil, fmt.Errorf("%w: missing %s message", errInvalidEvidence, name)
+		}
+
+		*msg = &proto.MessageReq{}
+		if err := protobuf.Unmarshal(raw, *msg); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidEvidence, err)
+		}
+	}
+
+	return e, nil
+}
+
+// verify checks that the messages are signed by the same sender,
+// for different digests in the same view
+func (e *equivocation) verify() error {
+	first, second := e.first, e.second
+
+	if !equivocationTypes[first.Type] || first.Type != second.Type {
+		return fmt.Errorf("%w: unexpected message types", errInvalidEvidence)
+	}
+
+	if first.View == nil || second.View == nil ||
+		first.View.Sequence != second.View.Sequence || first.View.Round != second.View.Round {
+		return fmt.Errorf("%w: different views", errInvalidEvidence)
+	}
+
+	if first.Digest == second.Digest {
+		return fmt.Errorf("%w: same digest", errInvalidEvidence)
+	}
+
+	for _, msg := range []*proto.MessageReq{first, second} {
+		if err := validateMsg(msg); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidEvidence, err)
+		}
+	}
+
+	if first.From != second.From {
+		return fmt.Errorf("%w: different senders", errInvalidEvidence)
+	}
+
+	return nil
+}
+
+// evidenceKey identifies the messages that a validator signs once
+type evidenceKey struct {
+	from     string
+	sequence uint64
+	round    uint64
+	typ      proto.MessageReq_Type
+}
+
+// evidencePool keeps the signed messages received from the validators,
+// and the equivocations found among them until they are reported in a block
+type evidencePool struct {
+	lock sync.Mutex
+
+	seen    map[evidenceKey]*proto.MessageReq
+	pending map[types.Hash]*equivocation
+}
+
+func newEvidencePool() *evidencePool {
+	return &evidencePool{
+		seen:    map[evidenceKey]*proto.MessageReq{},
+		pending: map[types.Hash]*equivocation{},
+	}
+}
+
+// observe records the validated message, and returns the equivocation
+// if its sender already signed a different digest in the same view
+func (p *evidencePool) observe(msg *proto.MessageReq) *equivocation {
+	if !equivocationTypes[msg.Type] || msg.View == nil {
+		return nil
+	}
+
+	// the certificates aren't signed by the sender
+	msg = msg.Copy()
+	msg.PreparedCertificate = nil
+	msg.RoundChangeCertificate = nil
+
+	key := evidenceKey{
+		from:     msg.From,
+		sequence: msg.View.Sequence,
+		round:    msg.View.Round,
+		typ:      msg.Type,
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	first, ok := p.seen[key]
+	if !ok {
+		p.seen[key] = msg
+
+		return nil
+	}
+
+	if first.Digest == msg.Digest {
+		return nil
+	}
+
+	e := &equivocation{
+		first:  first,
+		second: msg,
+	}
+
+	if _, ok := p.pending[e.id()]; ok {
+		return nil
+	}
+
+	p.pending[e.id()] = e
+
+	return e
+}
+
+// add adds the reported equivocation to the pending ones,
+// and returns false if it's already pending
+func (p *evidencePool) add(e *equivocation) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.pending[e.id()]; ok {
+		return false
+	}
+
+	p.pending[e.id()] = e
+
+	return true
+}
+
+// list returns the pending equivocations, sorted by height
+func (p *evidencePool) list() []*equivocation {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	list := make([]*equivocation, 0, len(p.pending))
+	for _, e := range p.pending {
+		list = append(list, e)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].sequence() != list[j].sequence() {
+			return list[i].sequence() < list[j].sequence()
+		}
+
+		return list[i].first.From < list[j].first.From
+	})
+
+	return list
+}
+
+// drop removes the equivocation from the pending ones
+func (p *evidencePool) drop(e *equivocation) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.pending, e.id())
+}
+
+// prune removes the messages and the equivocations which are too old to be reported in the block
+func (p *evidencePool) prune(number uint64) {
+	if number <= maxEvidenceAge {
+		return
+	}
+
+	oldest := number - maxEvidenceAge
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for key := range p.seen {
+		if key.sequence < oldest {
+			delete(p.seen, key)
+		}
+	}
+
+	for id, e := range p.pending {
+		if e.sequence() < oldest {
+			delete(p.pending, id)
+		}
+	}
+}
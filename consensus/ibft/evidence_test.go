@@ -0,0 +1,199 @@
+package ibft
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// signedMsg returns a message of the view signed by the key, with the sender recovered like the received ones
+func signedMsg(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	typ proto.MessageReq_Type,
+	sequence, round uint64,
+	digest string,
+) *proto.MessageReq {
+	t.Helper()
+
+	msg := &proto.MessageReq{
+		Type:   typ,
+		View:   proto.ViewMsg(sequence, round),
+		Digest: digest,
+	}
+
+	assert.NoError(t, signMsg(key, msg))
+	assert.NoError(t, validateMsg(msg))
+
+	return msg
+}
+
+func TestEvidencePool_Observe(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B")
+
+	keyA, keyB := pool.get("A").priv, pool.get("B").priv
+	evidence := newEvidencePool()
+
+	// the first message of the view is recorded
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Prepare, 1, 0, "digest1")))
+
+	// the same message again isn't an equivocation
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Prepare, 1, 0, "digest1")))
+
+	// neither are the messages of other views, types or senders
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Prepare, 1, 1, "digest2")))
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Commit, 1, 0, "digest2")))
+	assert.Nil(t, evidence.observe(signedMsg(t, keyB, proto.MessageReq_Prepare, 1, 0, "digest2")))
+
+	// preprepare messages aren't recorded
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Preprepare, 1, 0, "digest1")))
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Preprepare, 1, 0, "digest2")))
+
+	// a different digest in the same view is an equivocation
+	e := evidence.observe(signedMsg(t, keyA, proto.MessageReq_Prepare, 1, 0, "digest2"))
+	if assert.NotNil(t, e) {
+		assert.Equal(t, pool.get("A").Address(), e.offender())
+		assert.Equal(t, uint64(1), e.sequence())
+		assert.NoError(t, e.verify())
+	}
+
+	// the validator is reported once per height
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Commit, 1, 0, "digest3")))
+	assert.Len(t, evidence.list(), 1)
+
+	// the equivocations are listed by height
+	assert.NotNil(t, evidence.observe(signedMsg(t, keyB, proto.MessageReq_Prepare, 1, 0, "digest3")))
+	assert.Nil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Commit, 2000, 0, "digest1")))
+	assert.NotNil(t, evidence.observe(signedMsg(t, keyA, proto.MessageReq_Commit, 2000, 0, "digest2")))
+
+	list := evidence.list()
+	if assert.Len(t, list, 3) {
+		assert.Equal(t, []uint64{1, 1, 2000}, []uint64{list[0].sequence(), list[1].sequence(), list[2].sequence()})
+	}
+
+	evidence.drop(list[0])
+	assert.Len(t, evidence.list(), 2)
+
+	// the old messages and equivocations are pruned
+	evidence.prune(2000)
+
+	assert.Len(t, evidence.list(), 1)
+	assert.Len(t, evidence.seen, 1)
+}
+
+func TestEquivocation_EncodeDecode(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B")
+
+	keyA, keyB := pool.get("A").priv, pool.get("B").priv
+
+	newEquivocation := func(first, second *proto.MessageReq) *equivocation {
+		// the messages are decoded from the system transaction
+		e, err := decodeEquivocation(mustEncode(t, &equivocation{first: first, second: second}))
+		assert.NoError(t, err)
+
+		return e
+	}
+
+	cases := []struct {
+		name   string
+		first  *proto.MessageReq
+		second *proto.MessageReq
+		valid  bool
+	}{
+		{
+			name:   "different digests in the same view",
+			first:  signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1"),
+			second: signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest2"),
+			valid:  true,
+		},
+		{
+			name:   "same digest",
+			first:  signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1"),
+			second: signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1"),
+		},
+		{
+			name:   "different rounds",
+			first:  signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1"),
+			second: signedMsg(t, keyA, proto.MessageReq_Commit, 5, 2, "digest2"),
+		},
+		{
+			name:   "different types",
+			first:  signedMsg(t, keyA, proto.MessageReq_Prepare, 5, 1, "digest1"),
+			second: signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest2"),
+		},
+		{
+			name:   "different senders",
+			first:  signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1"),
+			second: signedMsg(t, keyB, proto.MessageReq_Commit, 5, 1, "digest2"),
+		},
+		{
+			name:   "round change messages",
+			first:  signedMsg(t, keyA, proto.MessageReq_RoundChange, 5, 1, "digest1"),
+			second: signedMsg(t, keyA, proto.MessageReq_RoundChange, 5, 1, "digest2"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := newEquivocation(c.first, c.second)
+
+			if !c.valid {
+				assert.ErrorIs(t, e.verify(), errInvalidEvidence)
+
+				return
+			}
+
+			assert.NoError(t, e.verify())
+			assert.Equal(t, pool.get("A").Address(), e.offender())
+			assert.Equal(t, c.first.Digest, e.first.Digest)
+			assert.Equal(t, c.second.Digest, e.second.Digest)
+		})
+	}
+
+	// the messages are checked against their signatures
+	first := signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest1")
+	second := signedMsg(t, keyA, proto.MessageReq_Commit, 5, 1, "digest2")
+	second.Digest = "digest3"
+
+	assert.ErrorIs(t, newEquivocation(first, second).verify(), errInvalidEvidence)
+
+	// unknown inputs are rejected
+	_, err := decodeEquivocation([]byte{1, 2, 3, 4, 5})
+	assert.ErrorIs(t, err, errInvalidEvidence)
+
+	_, err = decodeEquivocation(append(slashMethod.ID(), 1, 2))
+	assert.ErrorIs(t, err, errInvalidEvidence)
+}
+
+func mustEncode(t *testing.T, e *equivocation) []byte {
+	t.Helper()
+
+	input, err := e.encode()
+	assert.NoError(t, err)
+
+	return input
+}
+
+func TestEquivocation_ID(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B")
+
+	keyA, keyB := pool.get("A").priv, pool.get("B").priv
+
+	idOf := func(key *ecdsa.PrivateKey, sequence, round uint64) types.Hash {
+		return (&equivocation{
+			first:  signedMsg(t, key, proto.MessageReq_Commit, sequence, round, "digest1"),
+			second: signedMsg(t, key, proto.MessageReq_Commit, sequence, round, "digest2"),
+		}).id()
+	}
+
+	// a validator is slashed once per height
+	assert.Equal(t, idOf(keyA, 1, 0), idOf(keyA, 1, 3))
+	assert.NotEqual(t, idOf(keyA, 1, 0), idOf(keyA, 2, 0))
+	assert.NotEqual(t, idOf(keyA, 1, 0), idOf(keyB, 1, 0))
+}
@@ -3,6 +3,7 @@ package ibft
 import (
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/fastrlp"
 )
@@ -13,7 +14,7 @@ var (
 	IstanbulDigest = types.StringToHash("0x63746963616c2062797a616e74696e65206661756c7420746f6c6572616e6365")
 
 	// IstanbulExtraVanity represents a fixed number of extra-data bytes reserved for proposer vanity
-	IstanbulExtraVanity = 32
+	IstanbulExtraVanity = finality.ExtraVanity
 
 	// IstanbulExtraSeal represents the fixed number of extra-data bytes reserved for proposer seal
 	IstanbulExtraSeal = 65
@@ -74,11 +75,57 @@ func getIbftExtra(h *types.Header) (*IstanbulExtra, error) {
 	return extra, nil
 }
 
+// putIbftExtraWithoutSeals sets the extra data of the header without the seals,
+// which is the extra data covered by the header hash
+func putIbftExtraWithoutSeals(h *types.Header, extra *IstanbulExtra) {
+	putIbftExtraValidators(h, extra.Validators)
+
+	if extra.ValidatorBLSKeys == nil && extra.BLSRegistration == nil {
+		return
+	}
+
+	_ = PutIbftExtra(h, &IstanbulExtra{
+		Validators:       extra.Validators,
+		Seal:             []byte{},
+		CommittedSeal:    [][]byte{},
+		ValidatorBLSKeys: extra.ValidatorBLSKeys,
+		BLSRegistration:  extra.BLSRegistration,
+	})
+}
+
 // IstanbulExtra defines the structure of the extra field for Istanbul
 type IstanbulExtra struct {
 	Validators    []types.Address
 	Seal          []byte
 	CommittedSeal [][]byte
+
+	// The fields of the BLS mode, they are only encoded when one of them is set
+
+	// AggregatedSeal replaces the committed seals once the BLS fork is active
+	AggregatedSeal *AggregatedSeal
+	// ValidatorBLSKeys are the BLS public keys of the validators (empty if unknown), in the epoch blocks
+	ValidatorBLSKeys [][]byte
+	// BLSRegistration is the BLS public key of the proposer, when it isn't known yet
+	BLSRegistration *BLSRegistration
+}
+
+// AggregatedSeal is the aggregation of the BLS committed seals
+type AggregatedSeal struct {
+	// Bitmap has the bit i set if the validator i of the parent snapshot signed the seal
+	Bitmap []byte
+	// Signature is the aggregated BLS signature of the committed seals
+	Signature []byte
+}
+
+// BLSRegistration is a BLS public key along with its proof of possession
+type BLSRegistration struct {
+	PublicKey []byte
+	Proof     []byte
+}
+
+// hasBLSFields checks if any field of the BLS mode is set
+func (i *IstanbulExtra) hasBLSFields() bool {
+	return i.AggregatedSeal != nil || i.ValidatorBLSKeys != nil || i.BLSRegistration != nil
 }
 
 // MarshalRLPTo defines the marshal function wrapper for IstanbulExtra
@@ -120,6 +167,38 @@ func (i *IstanbulExtra) MarshalRLPWith(ar *fastrlp.Arena) *fastrlp.Value {
 		vv.Set(committed)
 	}
 
+	if !i.hasBLSFields() {
+		return vv
+	}
+
+	// AggregatedSeal
+	if i.AggregatedSeal == nil {
+		vv.Set(ar.NewNullArray())
+	} else {
+		aggregated := ar.NewArray()
+		aggregated.Set(ar.NewCopyBytes(i.AggregatedSeal.Bitmap))
+		aggregated.Set(ar.NewCopyBytes(i.AggregatedSeal.Signature))
+		vv.Set(aggregated)
+	}
+
+	// ValidatorBLSKeys
+	keys := ar.NewArray()
+	for _, key := range i.ValidatorBLSKeys {
+		keys.Set(ar.NewCopyBytes(key))
+	}
+
+	vv.Set(keys)
+
+	// BLSRegistration
+	if i.BLSRegistration == nil {
+		vv.Set(ar.NewNullArray())
+	} else {
+		registration := ar.NewArray()
+		registration.Set(ar.NewCopyBytes(i.BLSRegistration.PublicKey))
+		registration.Set(ar.NewCopyBytes(i.BLSRegistration.Proof))
+		vv.Set(registration)
+	}
+
 	return vv
 }
 
@@ -140,17 +219,8 @@ func (i *IstanbulExtra) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) er
 	}
 
 	// Validators
-	{
-		vals, err := elems[0].GetElems()
-		if err != nil {
-			return fmt.Errorf("list expected for validators")
-		}
-		i.Validators = make([]types.Address, len(vals))
-		for indx, val := range vals {
-			if err = val.GetAddr(i.Validators[indx][:]); err != nil {
-				return err
-			}
-		}
+	if i.Validators, err = finality.UnmarshalValidators(elems[0]); err != nil {
+		return err
 	}
 
 	// Seal
@@ -174,5 +244,77 @@ func (i *IstanbulExtra) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) er
 		}
 	}
 
+	if len(elems) == 3 {
+		return nil
+	}
+
+	if len(elems) != 6 {
+		return fmt.Errorf("incorrect number of elements to decode istanbul extra with BLS fields, expected 6 but found %d", len(elems))
+	}
+
+	return i.unmarshalBLSFields(elems[3:])
+}
+
+// unmarshalBLSFields decodes the aggregated seal, the validator keys and the registration
+func (i *IstanbulExtra) unmarshalBLSFields(elems []*fastrlp.Value) error {
+	// AggregatedSeal
+	{
+		vals, err := elems[0].GetElems()
+		if err != nil {
+			return fmt.Errorf("list expected for aggregated seal")
+		}
+
+		if len(vals) == 2 {
+			i.AggregatedSeal = &AggregatedSeal{}
+			if i.AggregatedSeal.Bitmap, err = vals[0].GetBytes(nil); err != nil {
+				return err
+			}
+
+			if i.AggregatedSeal.Signature, err = vals[1].GetBytes(nil); err != nil {
+				return err
+			}
+		} else if len(vals) != 0 {
+			return fmt.Errorf("incorrect number of elements to decode aggregated seal, expected 2 but found %d", len(vals))
+		}
+	}
+
+	// ValidatorBLSKeys
+	{
+		vals, err := elems[1].GetElems()
+		if err != nil {
+			return fmt.Errorf("list expected for validator BLS keys")
+		}
+
+		if len(vals) > 0 {
+			i.ValidatorBLSKeys = make([][]byte, len(vals))
+			for indx, val := range vals {
+				if i.ValidatorBLSKeys[indx], err = val.GetBytes([]byte{}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// BLSRegistration
+	{
+		vals, err := elems[2].GetElems()
+		if err != nil {
+			return fmt.Errorf("list expected for BLS registration")
+		}
+
+		if len(vals) == 2 {
+			i.BLSRegistration = &BLSRegistration{}
+			if i.BLSRegistration.PublicKey, err = vals[0].GetBytes(nil); err != nil {
+				return err
+			}
+
+			if i.BLSRegistration.Proof, err = vals[1].GetBytes(nil); err != nil {
+				return err
+			}
+		} else if len(vals) != 0 {
+			return fmt.Errorf("incorrect number of elements to decode BLS registration, expected 2 but found %d", len(vals))
+		}
+	}
+
 	return nil
 }
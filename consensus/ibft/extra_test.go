@@ -25,6 +25,41 @@ func TestExtraEncoding(t *testing.T) {
 				},
 			},
 		},
+		{
+			data: &IstanbulExtra{
+				Validators: []types.Address{
+					types.StringToAddress("1"),
+					types.StringToAddress("2"),
+				},
+				Seal:          seal1,
+				CommittedSeal: [][]byte{},
+				AggregatedSeal: &AggregatedSeal{
+					Bitmap:    []byte{0x03},
+					Signature: seal1,
+				},
+				ValidatorBLSKeys: [][]byte{
+					seal1,
+					{},
+				},
+				BLSRegistration: &BLSRegistration{
+					PublicKey: seal1,
+					Proof:     seal1,
+				},
+			},
+		},
+		{
+			data: &IstanbulExtra{
+				Validators: []types.Address{
+					types.StringToAddress("1"),
+				},
+				Seal:          seal1,
+				CommittedSeal: [][]byte{},
+				BLSRegistration: &BLSRegistration{
+					PublicKey: seal1,
+					Proof:     seal1,
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
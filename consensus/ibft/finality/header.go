@@ -0,0 +1,95 @@
+package finality
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// ExtraVanity is the number of bytes of the proposer vanity, before the IBFT fields of the extra data
+const ExtraVanity = 32
+
+// CommitMsg returns the message signed by the committed seals of the header hash.
+// The hash is signed along with the code of the commit messages, the same value used in quorum
+func CommitMsg(hash []byte) []byte {
+	return crypto.Keccak256(hash, []byte{byte(proto.MessageReq_Commit)})
+}
+
+// HeaderHash returns the IBFT hash of the header, whose extra data must have no seals.
+// The seals sign the hash without the base fee, which is only part of the block hash
+func HeaderHash(h *types.Header, withBaseFee bool) types.Hash {
+	arena := fastrlp.DefaultArenaPool.Get()
+	defer fastrlp.DefaultArenaPool.Put(arena)
+
+	vv := arena.NewArray()
+	vv.Set(arena.NewBytes(h.ParentHash.Bytes()))
+	vv.Set(arena.NewBytes(h.Sha3Uncles.Bytes()))
+	vv.Set(arena.NewBytes(h.Miner.Bytes()))
+	vv.Set(arena.NewBytes(h.StateRoot.Bytes()))
+	vv.Set(arena.NewBytes(h.TxRoot.Bytes()))
+	vv.Set(arena.NewBytes(h.ReceiptsRoot.Bytes()))
+	vv.Set(arena.NewBytes(h.LogsBloom[:]))
+	vv.Set(arena.NewUint(h.Difficulty))
+	vv.Set(arena.NewUint(h.Number))
+	vv.Set(arena.NewUint(h.GasLimit))
+	vv.Set(arena.NewUint(h.GasUsed))
+	vv.Set(arena.NewUint(h.Timestamp))
+	vv.Set(arena.NewCopyBytes(h.ExtraData))
+
+	if withBaseFee && h.BaseFee != 0 {
+		vv.Set(arena.NewUint(h.BaseFee))
+	}
+
+	return types.BytesToHash(keccak.Keccak256Rlp(nil, vv))
+}
+
+// UnmarshalValidators decodes the validators of the IBFT extra data
+func UnmarshalValidators(v *fastrlp.Value) ([]types.Address, error) {
+	vals, err := v.GetElems()
+	if err != nil {
+		return nil, fmt.Errorf("list expected for validators")
+	}
+
+	validators := make([]types.Address, len(vals))
+
+	for indx, val := range vals {
+		if err := val.GetAddr(validators[indx][:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return validators, nil
+}
+
+// ExtraValidators decodes the validators from the IBFT fields of the extra data, which are their first element
+func ExtraValidators(h *types.Header) ([]types.Address, error) {
+	if len(h.ExtraData) < ExtraVanity {
+		return nil, ErrInvalidExtra
+	}
+
+	var validators []types.Address
+
+	err := types.UnmarshalRlp(func(p *fastrlp.Parser, v *fastrlp.Value) error {
+		elems, err := v.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(elems) == 0 {
+			return fmt.Errorf("validators not found")
+		}
+
+		validators, err = UnmarshalValidators(elems[0])
+
+		return err
+	}, h.ExtraData[ExtraVanity:])
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidExtra, err)
+	}
+
+	return validators, nil
+}
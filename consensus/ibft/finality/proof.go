@@ -0,0 +1,326 @@
+// Package finality verifies the committed-seal proofs of the IBFT blocks.
+//
+// A block sealed by IBFT is final once it is written, since a quorum of the validators
+// committed to it. The proof of a block holds its header and the committed seals of the validators,
+// so light clients and bridges can check the finality of the block without running a node.
+package finality
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrMissingHeader         = errors.New("missing header")
+	ErrInvalidHash           = errors.New("hash doesn't match the header")
+	ErrInvalidExtra          = errors.New("invalid IBFT extra data")
+	ErrValidatorsMismatch    = errors.New("validators don't match the header")
+	ErrInvalidQuorum         = errors.New("invalid quorum")
+	ErrMissingSeals          = errors.New("missing committed seals")
+	ErrRepeatedSeal          = errors.New("repeated committed seal")
+	ErrNonValidatorSeal      = errors.New("committed seal of a non validator")
+	ErrNotEnoughSeals        = errors.New("not enough committed seals")
+	ErrInvalidSignerBitmap   = errors.New("invalid signer bitmap")
+	ErrMissingBLSKey         = errors.New("BLS public key of the validator not found")
+	ErrInvalidAggregatedSeal = errors.New("invalid aggregated seal")
+)
+
+// AggregatedSeal is the aggregation of the BLS committed seals
+type AggregatedSeal struct {
+	// Bitmap has the bit i set if the validator i signed the seal
+	Bitmap []byte
+	// Signature is the aggregated BLS signature of the committed seals
+	Signature []byte
+}
+
+// Proof is the proof that a quorum of the validators committed to a block.
+//
+// The committed seals are ECDSA signatures, or a single aggregated BLS seal once the BLS fork is active.
+// Verify only checks the proof is consistent, the caller must check the validators
+// (and their BLS keys in the BLS mode) against a validator set it trusts
+type Proof struct {
+	// Header is the header of the block, with the seals removed from its extra data
+	Header *types.Header
+	// Hash is the hash of the block
+	Hash types.Hash
+	// Validators are the validators sealing the block, in the order of the signer bitmap
+	Validators []types.Address
+	// CommittedSeals are the ECDSA committed seals of the validators
+	CommittedSeals [][]byte
+	// AggregatedSeal replaces the committed seals in the BLS mode
+	AggregatedSeal *AggregatedSeal
+	// ValidatorBLSKeys are the BLS public keys of the validators (empty if unknown) in the BLS mode
+	ValidatorBLSKeys [][]byte
+	// Quorum is the number of committed seals required for the block
+	Quorum uint64
+}
+
+// Verify checks the committed seals of the proof are signed by a quorum of its validators
+func (p *Proof) Verify() error {
+	if p.Header == nil {
+		return ErrMissingHeader
+	}
+
+	if hash := HeaderHash(p.Header, true); hash != p.Hash {
+		return ErrInvalidHash
+	}
+
+	validators, err := ExtraValidators(p.Header)
+	if err != nil {
+		return err
+	}
+
+	if len(validators) != len(p.Validators) {
+		return ErrValidatorsMismatch
+	}
+
+	for indx, addr := range validators {
+		if addr != p.Validators[indx] {
+			return ErrValidatorsMismatch
+		}
+	}
+
+	// the quorum can't be lower than 2F+1, the quorum of the earliest blocks
+	if p.Quorum < minQuorum(len(p.Validators)) || p.Quorum > uint64(len(p.Validators)) {
+		return ErrInvalidQuorum
+	}
+
+	msg := CommitMsg(HeaderHash(p.Header, false).Bytes())
+
+	if p.AggregatedSeal != nil {
+		return p.verifyAggregatedSeal(msg)
+	}
+
+	return p.verifyCommittedSeals(msg)
+}
+
+// verifyCommittedSeals checks the ECDSA committed seals are signed by a quorum of distinct validators
+func (p *Proof) verifyCommittedSeals(msg []byte) error {
+	if len(p.CommittedSeals) == 0 {
+		return ErrMissingSeals
+	}
+
+	validators := make(map[types.Address]struct{}, len(p.Validators))
+	for _, addr := range p.Validators {
+		validators[addr] = struct{}{}
+	}
+
+	signers := make(map[types.Address]struct{}, len(p.CommittedSeals))
+
+	for _, seal := range p.CommittedSeals {
+		pub, err := crypto.RecoverPubkey(seal, crypto.Keccak256(msg))
+		if err != nil {
+			return err
+		}
+
+		signer := crypto.PubKeyToAddress(pub)
+
+		if _, ok := signers[signer]; ok {
+			return ErrRepeatedSeal
+		}
+
+		if _, ok := validators[signer]; !ok {
+			return fmt.Errorf("%w: %s", ErrNonValidatorSeal, signer)
+		}
+
+		signers[signer] = struct{}{}
+	}
+
+	if uint64(len(signers)) < p.Quorum {
+		return ErrNotEnoughSeals
+	}
+
+	return nil
+}
+
+// verifyAggregatedSeal checks the aggregated BLS seal is signed by a quorum of the validators of the bitmap
+func (p *Proof) verifyAggregatedSeal(msg []byte) error {
+	bitmap := p.AggregatedSeal.Bitmap
+	if len(bitmap) != (len(p.Validators)+7)/8 {
+		return ErrInvalidSignerBitmap
+	}
+
+	if len(p.ValidatorBLSKeys) != len(p.Validators) {
+		return ErrMissingBLSKey
+	}
+
+	keys := make([]*bls.PublicKey, 0, len(p.Validators))
+
+	for indx := 0; indx < len(bitmap)*8; indx++ {
+		if bitmap[indx/8]&(1<<(indx%8)) == 0 {
+			continue
+		}
+
+		if indx >= len(p.Validators) {
+			return ErrInvalidSignerBitmap
+		}
+
+		if len(p.ValidatorBLSKeys[indx]) == 0 {
+			return fmt.Errorf("%w: %s", ErrMissingBLSKey, p.Validators[indx])
+		}
+
+		key, err := bls.UnmarshalPublicKey(p.ValidatorBLSKeys[indx])
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, key)
+	}
+
+	if uint64(len(keys)) < p.Quorum {
+		return ErrNotEnoughSeals
+	}
+
+	signature, err := bls.UnmarshalSignature(p.AggregatedSeal.Signature)
+	if err != nil {
+		return err
+	}
+
+	aggregatedKey, err := bls.AggregatePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	if !signature.Verify(aggregatedKey, msg) {
+		return ErrInvalidAggregatedSeal
+	}
+
+	return nil
+}
+
+// minQuorum returns the quorum of 2F+1 validators, where F is the number of faulty validators tolerated
+func minQuorum(validators int) uint64 {
+	if validators == 0 {
+		return 1
+	}
+
+	return uint64(2*((validators-1)/3) + 1)
+}
+
+// proofJSON is the JSON encoding of the proof, with the header encoded in RLP
+type proofJSON struct {
+	Header           string              `json:"header"`
+	Hash             types.Hash          `json:"hash"`
+	Validators       []types.Address     `json:"validators"`
+	CommittedSeals   []string            `json:"committedSeals,omitempty"`
+	AggregatedSeal   *aggregatedSealJSON `json:"aggregatedSeal,omitempty"`
+	ValidatorBLSKeys []string            `json:"validatorBLSKeys,omitempty"`
+	Quorum           common.JSONNumber   `json:"quorum"`
+}
+
+type aggregatedSealJSON struct {
+	Bitmap    string `json:"bitmap"`
+	Signature string `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *Proof) MarshalJSON() ([]byte, error) {
+	res := &proofJSON{
+		Hash:             p.Hash,
+		Validators:       p.Validators,
+		CommittedSeals:   encodeHexList(p.CommittedSeals),
+		ValidatorBLSKeys: encodeHexList(p.ValidatorBLSKeys),
+		Quorum:           common.JSONNumber{Value: p.Quorum},
+	}
+
+	if p.Header != nil {
+		res.Header = hex.EncodeToHex(p.Header.MarshalRLP())
+	}
+
+	if p.AggregatedSeal != nil {
+		res.AggregatedSeal = &aggregatedSealJSON{
+			Bitmap:    hex.EncodeToHex(p.AggregatedSeal.Bitmap),
+			Signature: hex.EncodeToHex(p.AggregatedSeal.Signature),
+		}
+	}
+
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *Proof) UnmarshalJSON(data []byte) error {
+	raw := &proofJSON{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+
+	rawHeader, err := hex.DecodeHex(raw.Header)
+	if err != nil {
+		return err
+	}
+
+	header := &types.Header{}
+	if err := header.UnmarshalRLP(rawHeader); err != nil {
+		return err
+	}
+
+	proof := Proof{
+		Header:     header,
+		Hash:       raw.Hash,
+		Validators: raw.Validators,
+		Quorum:     raw.Quorum.Value,
+	}
+
+	if proof.CommittedSeals, err = decodeHexList(raw.CommittedSeals); err != nil {
+		return err
+	}
+
+	if proof.ValidatorBLSKeys, err = decodeHexList(raw.ValidatorBLSKeys); err != nil {
+		return err
+	}
+
+	if raw.AggregatedSeal != nil {
+		proof.AggregatedSeal = &AggregatedSeal{}
+
+		if proof.AggregatedSeal.Bitmap, err = hex.DecodeHex(raw.AggregatedSeal.Bitmap); err != nil {
+			return err
+		}
+
+		if proof.AggregatedSeal.Signature, err = hex.DecodeHex(raw.AggregatedSeal.Signature); err != nil {
+			return err
+		}
+	}
+
+	*p = proof
+
+	return nil
+}
+
+func encodeHexList(list [][]byte) []string {
+	if list == nil {
+		return nil
+	}
+
+	res := make([]string, len(list))
+	for indx, b := range list {
+		res[indx] = hex.EncodeToHex(b)
+	}
+
+	return res
+}
+
+func decodeHexList(list []string) ([][]byte, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	res := make([][]byte, len(list))
+
+	for indx, s := range list {
+		b, err := hex.DecodeHex(s)
+		if err != nil {
+			return nil, err
+		}
+
+		res[indx] = b
+	}
+
+	return res, nil
+}
@@ -0,0 +1,282 @@
+package finality
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/fastrlp"
+)
+
+// newTestHeader returns a header with the validators in the IBFT fields of its extra data, without the seals
+func newTestHeader(validators []types.Address) *types.Header {
+	arena := &fastrlp.Arena{}
+
+	rawValidators := arena.NewArray()
+	for _, addr := range validators {
+		rawValidators.Set(arena.NewBytes(addr.Bytes()))
+	}
+
+	extra := arena.NewArray()
+	extra.Set(rawValidators)
+	extra.Set(arena.NewNull())
+	extra.Set(arena.NewNullArray())
+
+	return &types.Header{
+		Number:     5,
+		ParentHash: types.StringToHash("1"),
+		GasLimit:   5000000,
+		Timestamp:  1000,
+		BaseFee:    100,
+		ExtraData:  extra.MarshalTo(make([]byte, ExtraVanity)),
+	}
+}
+
+// newTestProof returns the proof of a header committed by the first signers of the validators
+func newTestProof(t *testing.T, validators int, signers int) (*Proof, []*ecdsa.PrivateKey) {
+	t.Helper()
+
+	keys := make([]*ecdsa.PrivateKey, validators)
+	addrs := make([]types.Address, validators)
+
+	for indx := range keys {
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+
+		keys[indx] = key
+		addrs[indx] = crypto.PubKeyToAddress(&key.PublicKey)
+	}
+
+	header := newTestHeader(addrs)
+	proof := &Proof{
+		Header:     header,
+		Hash:       HeaderHash(header, true),
+		Validators: addrs,
+		Quorum:     uint64(signers),
+	}
+
+	for _, key := range keys[:signers] {
+		proof.CommittedSeals = append(proof.CommittedSeals, signCommittedSeal(t, key, header))
+	}
+
+	return proof, keys
+}
+
+func signCommittedSeal(t *testing.T, key *ecdsa.PrivateKey, header *types.Header) []byte {
+	t.Helper()
+
+	seal, err := crypto.Sign(key, crypto.Keccak256(CommitMsg(HeaderHash(header, false).Bytes())))
+	assert.NoError(t, err)
+
+	return seal
+}
+
+func TestProof_Verify(t *testing.T) {
+	otherKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		tamper func(p *Proof)
+		err    error
+	}{
+		{
+			name:   "valid proof",
+			tamper: func(p *Proof) {},
+		},
+		{
+			name: "missing header",
+			tamper: func(p *Proof) {
+				p.Header = nil
+			},
+			err: ErrMissingHeader,
+		},
+		{
+			name: "header of another block",
+			tamper: func(p *Proof) {
+				p.Header.GasUsed = 1
+			},
+			err: ErrInvalidHash,
+		},
+		{
+			name: "validators of another set",
+			tamper: func(p *Proof) {
+				p.Validators = p.Validators[1:]
+			},
+			err: ErrValidatorsMismatch,
+		},
+		{
+			name: "quorum below 2F+1",
+			tamper: func(p *Proof) {
+				p.Quorum = 2
+			},
+			err: ErrInvalidQuorum,
+		},
+		{
+			name: "quorum above the validators",
+			tamper: func(p *Proof) {
+				p.Quorum = 5
+			},
+			err: ErrInvalidQuorum,
+		},
+		{
+			name: "missing committed seals",
+			tamper: func(p *Proof) {
+				p.CommittedSeals = nil
+			},
+			err: ErrMissingSeals,
+		},
+		{
+			name: "not enough committed seals",
+			tamper: func(p *Proof) {
+				p.CommittedSeals = p.CommittedSeals[:2]
+			},
+			err: ErrNotEnoughSeals,
+		},
+		{
+			name: "repeated committed seal",
+			tamper: func(p *Proof) {
+				p.CommittedSeals[2] = p.CommittedSeals[0]
+			},
+			err: ErrRepeatedSeal,
+		},
+		{
+			name: "committed seal of a non validator",
+			tamper: func(p *Proof) {
+				p.CommittedSeals[2] = signCommittedSeal(t, otherKey, p.Header)
+			},
+			err: ErrNonValidatorSeal,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			proof, _ := newTestProof(t, 4, 3)
+			c.tamper(proof)
+
+			assert.ErrorIs(t, proof.Verify(), c.err)
+		})
+	}
+}
+
+func TestProof_VerifyAggregatedSeal(t *testing.T) {
+	proof, _ := newTestProof(t, 4, 0)
+	proof.Quorum = 3
+
+	blsKeys := make([]*bls.SecretKey, len(proof.Validators))
+	proof.ValidatorBLSKeys = make([][]byte, len(proof.Validators))
+
+	for indx := range blsKeys {
+		key, err := bls.GenerateSecretKey()
+		assert.NoError(t, err)
+
+		blsKeys[indx] = key
+		proof.ValidatorBLSKeys[indx] = key.PublicKey().Marshal()
+	}
+
+	// the first three validators sign the seal
+	msg := CommitMsg(HeaderHash(proof.Header, false).Bytes())
+
+	signature, err := bls.AggregateSignatures([]*bls.Signature{
+		blsKeys[0].Sign(msg),
+		blsKeys[1].Sign(msg),
+		blsKeys[2].Sign(msg),
+	})
+	assert.NoError(t, err)
+
+	proof.AggregatedSeal = &AggregatedSeal{
+		Bitmap:    []byte{0x07},
+		Signature: signature.Marshal(),
+	}
+
+	assert.NoError(t, proof.Verify())
+
+	cases := []struct {
+		name   string
+		bitmap []byte
+		keys   func(keys [][]byte) [][]byte
+		err    error
+	}{
+		{
+			name:   "bitmap claiming a signer which didn't sign",
+			bitmap: []byte{0x0f},
+			err:    ErrInvalidAggregatedSeal,
+		},
+		{
+			name:   "bitmap out of the validator set",
+			bitmap: []byte{0x17},
+			err:    ErrInvalidSignerBitmap,
+		},
+		{
+			name:   "bitmap of another size",
+			bitmap: []byte{0x07, 0x00},
+			err:    ErrInvalidSignerBitmap,
+		},
+		{
+			name:   "not enough signers",
+			bitmap: []byte{0x03},
+			err:    ErrNotEnoughSeals,
+		},
+		{
+			name:   "unknown BLS key of a signer",
+			bitmap: []byte{0x07},
+			keys: func(keys [][]byte) [][]byte {
+				return [][]byte{keys[0], keys[1], {}, keys[3]}
+			},
+			err: ErrMissingBLSKey,
+		},
+		{
+			name:   "missing BLS keys",
+			bitmap: []byte{0x07},
+			keys: func(keys [][]byte) [][]byte {
+				return keys[:3]
+			},
+			err: ErrMissingBLSKey,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tampered := *proof
+			tampered.AggregatedSeal = &AggregatedSeal{
+				Bitmap:    c.bitmap,
+				Signature: proof.AggregatedSeal.Signature,
+			}
+
+			if c.keys != nil {
+				tampered.ValidatorBLSKeys = c.keys(proof.ValidatorBLSKeys)
+			}
+
+			assert.ErrorIs(t, tampered.Verify(), c.err)
+		})
+	}
+}
+
+func TestProof_JSON(t *testing.T) {
+	proof, _ := newTestProof(t, 4, 3)
+	proof.AggregatedSeal = &AggregatedSeal{
+		Bitmap:    []byte{0x07},
+		Signature: []byte{0x1, 0x2},
+	}
+
+	raw, err := json.Marshal(proof)
+	assert.NoError(t, err)
+
+	decoded := &Proof{}
+	assert.NoError(t, json.Unmarshal(raw, decoded))
+
+	assert.Equal(t, proof.Hash, decoded.Hash)
+	assert.Equal(t, proof.Header.MarshalRLP(), decoded.Header.MarshalRLP())
+	assert.Equal(t, proof.Validators, decoded.Validators)
+	assert.Equal(t, proof.CommittedSeals, decoded.CommittedSeals)
+	assert.Equal(t, proof.AggregatedSeal, decoded.AggregatedSeal)
+	assert.Equal(t, proof.Quorum, decoded.Quorum)
+
+	// the decoded header hashes the same
+	decoded.AggregatedSeal = nil
+	assert.NoError(t, decoded.Verify())
+}
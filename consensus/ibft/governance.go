@@ -0,0 +1,200 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/contracts/governance"
+	governanceHelper "github.com/0xPolygon/polygon-edge/helper/governance"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errGovernanceProposal = errors.New(
+		"the validators are managed by the governance contract, the candidates are proposed to the contract",
+	)
+)
+
+// GovernanceMechanism defines specific hooks for the PoA mechanism
+// with the validator set managed by the governance contract
+type GovernanceMechanism struct {
+	BaseConsensusMechanism
+	// Params
+	ContractDeployment uint64 // The height when deploying governance contract
+}
+
+// GovernanceFactory initializes the required data
+// for the PoA governance mechanism
+func GovernanceFactory(ibft *Ibft, params *IBFTFork) (ConsensusMechanism, error) {
+	gov := &GovernanceMechanism{
+		BaseConsensusMechanism: BaseConsensusMechanism{
+			mechanismType: PoAGovernance,
+			ibft:          ibft,
+		},
+	}
+
+	if err := gov.initializeParams(params); err != nil {
+		return nil, err
+	}
+
+	gov.initializeHookMap()
+
+	return gov, nil
+}
+
+// IsAvailable returns indicates if mechanism should be called at given height
+func (gov *GovernanceMechanism) IsAvailable(hookType HookType, height uint64) bool {
+	switch hookType {
+	case AcceptStateLogHook, CalculateProposerHook:
+		return gov.IsInRange(height)
+	case PreStateCommitHook:
+		// the contract is predeployed in genesis if the fork starts from genesis
+		return gov.From != 0 && height == gov.ContractDeployment
+	case InsertBlockHook:
+		// update validators when the one before the beginning or the end of epoch
+		return height+1 == gov.From || gov.IsInRange(height) && gov.ibft.IsLastOfEpoch(height)
+	default:
+		return false
+	}
+}
+
+// initializeParams initializes mechanism parameters from chain config
+func (gov *GovernanceMechanism) initializeParams(params *IBFTFork) error {
+	if err := gov.BaseConsensusMechanism.initializeParams(params); err != nil {
+		return err
+	}
+
+	if gov.From != 0 {
+		if params.Deployment == nil {
+			return errors.New(`"deployment" must be specified in PoAGovernance fork`)
+		}
+
+		if params.Deployment.Value > gov.From {
+			return fmt.Errorf(
+				`"deployment" must be less than or equal to "from": deployment=%d, from=%d`,
+				params.Deployment.Value,
+				gov.From,
+			)
+		}
+
+		gov.ContractDeployment = params.Deployment.Value
+	}
+
+	return nil
+}
+
+// calculateProposerHook calculates the next proposer based on the last
+func (gov *GovernanceMechanism) calculateProposerHook(lastProposerParam interface{}) error {
+	lastProposer, ok := lastProposerParam.(types.Address)
+	if !ok {
+		return ErrInvalidHookParam
+	}
+
+	gov.ibft.state.CalcProposer(lastProposer)
+
+	return nil
+}
+
+// acceptStateLogHook logs the current snapshot
+func (gov *GovernanceMechanism) acceptStateLogHook(snapParam interface{}) error {
+	// Cast the param to a *Snapshot
+	snap, ok := snapParam.(*Snapshot)
+	if !ok {
+		return ErrInvalidHookParam
+	}
+
+	// Log the info message
+	gov.ibft.logger.Info(
+		"current snapshot",
+		"validators",
+		len(snap.Set),
+	)
+
+	return nil
+}
+
+// insertBlockHook checks if the block is the last block of the epoch,
+// in order to update the validator set
+func (gov *GovernanceMechanism) insertBlockHook(numberParam interface{}) error {
+	headerNumber, ok := numberParam.(uint64)
+	if !ok {
+		return ErrInvalidHookParam
+	}
+
+	return gov.ibft.updateSnapshotValidators(headerNumber, gov.getNextValidators)
+}
+
+// preStateCommitHook deploys the governance contract with the current validators.
+// The validators voted in the headers after the deployment are replaced by the ones of the contract
+// at the beginning of the fork
+func (gov *GovernanceMechanism) preStateCommitHook(rawParams interface{}) error {
+	params, ok := rawParams.(*preStateCommitHookParams)
+	if !ok {
+		return ErrInvalidHookParam
+	}
+
+	snap, err := gov.ibft.getSnapshot(params.header.Number - 1)
+	if err != nil {
+		return err
+	}
+
+	if snap == nil {
+		return fmt.Errorf("cannot find snapshot at %d", params.header.Number-1)
+	}
+
+	contractState, err := governanceHelper.PredeployGovernanceSC(snap.Set)
+	if err != nil {
+		return err
+	}
+
+	return params.txn.SetAccountDirectly(governance.AddrGovernanceContract, contractState)
+}
+
+// initializeHookMap registers the hooks that the PoA governance mechanism
+// should have
+func (gov *GovernanceMechanism) initializeHookMap() {
+	// Create the hook map
+	gov.hookMap = make(map[HookType]func(interface{}) error)
+
+	// Register the AcceptStateLogHook
+	gov.hookMap[AcceptStateLogHook] = gov.acceptStateLogHook
+
+	// Register the InsertBlockHook
+	gov.hookMap[InsertBlockHook] = gov.insertBlockHook
+
+	// Register the PreStateCommitHook
+	gov.hookMap[PreStateCommitHook] = gov.preStateCommitHook
+
+	// Register the CalculateProposerHook
+	gov.hookMap[CalculateProposerHook] = gov.calculateProposerHook
+}
+
+// ShouldWriteTransactions indicates if transactions should be written to a block
+func (gov *GovernanceMechanism) ShouldWriteTransactions(blockNumber uint64) bool {
+	// The proposals are regular transactions to the governance contract,
+	// which are read at the end of the epoch
+	return gov.IsInRange(blockNumber)
+}
+
+// getNextValidators is a helper function for fetching the validator set
+// from the governance contract
+func (gov *GovernanceMechanism) getNextValidators(header *types.Header) (ValidatorSet, error) {
+	transition, err := gov.ibft.executor.BeginTxn(header.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return governance.QueryValidators(transition, gov.ibft.validatorKeyAddr)
+}
+
+// isGovernanceActive checks if the validators are managed by the governance contract at the height,
+// in which case the votes in the headers are ignored
+func (i *Ibft) isGovernanceActive(height uint64) bool {
+	for _, m := range i.mechanisms {
+		if gov, ok := m.(*GovernanceMechanism); ok && gov.IsInRange(height) {
+			return true
+		}
+	}
+
+	return false
+}
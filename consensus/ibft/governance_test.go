@@ -0,0 +1,242 @@
+package ibft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/contracts/governance"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	governanceHelper "github.com/0xPolygon/polygon-edge/helper/governance"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/ethgo"
+)
+
+// callGovernance calls the method of the governance contract from the address
+func callGovernance(
+	t *testing.T,
+	transition *state.Transition,
+	from types.Address,
+	method string,
+	args ...interface{},
+) *runtime.ExecutionResult {
+	t.Helper()
+
+	input, err := abis.GovernanceABI.Methods[method].Encode(args)
+	assert.NoError(t, err)
+
+	return transition.Call2(from, governance.AddrGovernanceContract, input, nil, 1000000)
+}
+
+// proposalVotes returns the votes of the proposal in the governance contract
+func proposalVotes(t *testing.T, transition *state.Transition, candidate types.Address, add bool) uint64 {
+	t.Helper()
+
+	res := callGovernance(t, transition, types.ZeroAddress, "proposalVotes", ethgo.Address(candidate), add)
+	assert.NoError(t, res.Err)
+
+	return new(big.Int).SetBytes(res.ReturnValue).Uint64()
+}
+
+func TestGovernanceFactory(t *testing.T) {
+	cases := []struct {
+		name       string
+		from       uint64
+		deployment *common.JSONNumber
+		err        bool
+	}{
+		{
+			name: "from genesis, predeployed",
+		},
+		{
+			name:       "switch from PoA",
+			from:       10,
+			deployment: &common.JSONNumber{Value: 5},
+		},
+		{
+			name: "switch without deployment",
+			from: 10,
+			err:  true,
+		},
+		{
+			name:       "deployment after the switch",
+			from:       10,
+			deployment: &common.JSONNumber{Value: 11},
+			err:        true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mechanism, err := GovernanceFactory(&Ibft{}, &IBFTFork{
+				Type:       PoAGovernance,
+				From:       common.JSONNumber{Value: c.from},
+				Deployment: c.deployment,
+			})
+
+			if c.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			gov, ok := mechanism.(*GovernanceMechanism)
+			if assert.True(t, ok) && c.deployment != nil {
+				assert.Equal(t, c.deployment.Value, gov.ContractDeployment)
+				assert.True(t, gov.IsAvailable(PreStateCommitHook, c.deployment.Value))
+			}
+		})
+	}
+}
+
+func TestGovernance_Proposals(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D", "E")
+
+	addr := func(name string) types.Address {
+		return pool.get(name).Address()
+	}
+
+	ibft := &Ibft{config: &consensus.Config{}}
+	setTestExecutor(ibft)
+
+	contract, err := governanceHelper.PredeployGovernanceSC(
+		[]types.Address{addr("A"), addr("B"), addr("C"), addr("D")},
+	)
+	assert.NoError(t, err)
+
+	root := ibft.executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		governance.AddrGovernanceContract: contract,
+	})
+
+	transition, err := ibft.executor.BeginTxn(root, &types.Header{Number: 1, GasLimit: 10000000}, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	validators := func() []types.Address {
+		validators, err := governance.QueryValidators(transition, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		return validators
+	}
+
+	propose := func(from, candidate string, add bool) error {
+		return callGovernance(t, transition, addr(from), "propose", ethgo.Address(addr(candidate)), add).Err
+	}
+
+	assert.Equal(t, []types.Address{addr("A"), addr("B"), addr("C"), addr("D")}, validators())
+
+	// the candidate is added once the majority of the validators voted for it
+	assert.NoError(t, propose("A", "E", true))
+	assert.NoError(t, propose("B", "E", true))
+	assert.Equal(t, uint64(2), proposalVotes(t, transition, addr("E"), true))
+	assert.Len(t, validators(), 4)
+
+	assert.NoError(t, propose("C", "E", true))
+	assert.Equal(t, []types.Address{addr("A"), addr("B"), addr("C"), addr("D"), addr("E")}, validators())
+
+	// the votes are discarded after the change
+	assert.Equal(t, uint64(0), proposalVotes(t, transition, addr("E"), true))
+
+	// the last validator takes the place of the removed one
+	assert.NoError(t, propose("A", "B", false))
+	assert.NoError(t, propose("C", "B", false))
+	assert.NoError(t, propose("B", "B", false))
+	assert.Equal(t, []types.Address{addr("A"), addr("E"), addr("C"), addr("D")}, validators())
+
+	res := callGovernance(t, transition, types.ZeroAddress, "isValidator", ethgo.Address(addr("B")))
+	assert.NoError(t, res.Err)
+	assert.Equal(t, types.ZeroHash, types.BytesToHash(res.ReturnValue))
+
+	// the invalid proposals are reverted
+	assert.ErrorIs(t, propose("B", "A", false), runtime.ErrExecutionReverted)
+	assert.ErrorIs(t, propose("A", "C", true), runtime.ErrExecutionReverted)
+	assert.ErrorIs(t, propose("A", "B", false), runtime.ErrExecutionReverted)
+
+	assert.NoError(t, propose("A", "B", true))
+	assert.ErrorIs(t, propose("A", "B", true), runtime.ErrExecutionReverted)
+	assert.Equal(t, uint64(1), proposalVotes(t, transition, addr("B"), true))
+
+	res = transition.Call2(
+		addr("A"),
+		governance.AddrGovernanceContract,
+		append(abis.GovernanceABI.Methods["propose"].ID(), make([]byte, 32)...),
+		nil,
+		1000000,
+	)
+	assert.ErrorIs(t, res.Err, runtime.ErrExecutionReverted)
+}
+
+func TestGovernance_LastValidator(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A")
+
+	ibft := &Ibft{config: &consensus.Config{}}
+	setTestExecutor(ibft)
+
+	contract, err := governanceHelper.PredeployGovernanceSC(pool.ValidatorSet())
+	assert.NoError(t, err)
+
+	root := ibft.executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		governance.AddrGovernanceContract: contract,
+	})
+
+	transition, err := ibft.executor.BeginTxn(root, &types.Header{Number: 1}, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	res := callGovernance(t, transition, pool.get("A").Address(), "propose", ethgo.Address(pool.get("A").Address()), false)
+	assert.ErrorIs(t, res.Err, runtime.ErrExecutionReverted)
+
+	_, err = governanceHelper.PredeployGovernanceSC(nil)
+	assert.ErrorIs(t, err, governanceHelper.ErrNoValidators)
+
+	_, err = governanceHelper.PredeployGovernanceSC(append(pool.ValidatorSet(), pool.get("A").Address()))
+	assert.ErrorIs(t, err, governanceHelper.ErrDuplicatedValidator)
+}
+
+func TestGovernance_Deployment(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, _ := newLivenessIbft(t, pool)
+	setTestExecutor(ibft)
+
+	// the validators voted with PoA are deployed in the contract, before switching
+	poa := ibft.mechanisms[0]
+	gov, err := GovernanceFactory(ibft, &IBFTFork{
+		Type:       PoAGovernance,
+		From:       common.JSONNumber{Value: 4},
+		Deployment: &common.JSONNumber{Value: 3},
+	})
+	assert.NoError(t, err)
+
+	ibft.mechanisms = []ConsensusMechanism{poa, gov}
+
+	root := ibft.executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{})
+	header := &types.Header{Number: 3, GasLimit: 10000000}
+
+	transition, err := ibft.executor.BeginTxn(root, header, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	assert.NoError(t, ibft.PreStateCommit(header, transition))
+
+	_, header.StateRoot = transition.Commit()
+
+	governanceMechanism, ok := gov.(*GovernanceMechanism)
+	assert.True(t, ok)
+
+	validators, err := governanceMechanism.getNextValidators(header)
+	assert.NoError(t, err)
+	assert.Equal(t, pool.ValidatorSet(), validators)
+
+	// the votes in the headers aren't used anymore
+	assert.False(t, gov.IsAvailable(ProcessHeadersHook, 4))
+	assert.False(t, gov.IsAvailable(CandidateVoteHook, 4))
+	assert.True(t, gov.IsAvailable(InsertBlockHook, 3))
+}
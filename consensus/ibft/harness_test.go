@@ -0,0 +1,442 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+// msgHarness drives the state machine of a mock IBFT node at the message level.
+// It builds messages signed by the validators of the pool, and delivers them
+// in an explicit order, so every interleaving of messages and state cycles is reproducible
+type msgHarness struct {
+	*mockIbft
+}
+
+func newMsgHarness(t *testing.T, accounts []string, account string) *msgHarness {
+	t.Helper()
+
+	m := newMockIbft(t, accounts, account)
+
+	// the messages carry their digest and certificates from the first sequence
+	justificationBlockNum := uint64(0)
+	m.justificationBlockNum = &justificationBlockNum
+
+	return &msgHarness{
+		mockIbft: m,
+	}
+}
+
+// block returns a block of the first sequence sealed by the proposer,
+// the salt distinguishes the blocks of different proposals
+func (h *msgHarness) block(proposer string, salt uint64) *types.Block {
+	block := h.DummyBlock()
+	block.Header.Timestamp = salt
+
+	header, err := writeSeal(h.pool.get(proposer).priv, block.Header)
+	assert.NoError(h.t, err)
+
+	block.Header = header
+	block.Header.ComputeHash()
+
+	return block
+}
+
+// sign signs the message as the account, and sets the sender as the transport would
+func (h *msgHarness) sign(from string, msg *proto.MessageReq) *proto.MessageReq {
+	assert.NoError(h.t, signMsg(h.pool.get(from).priv, msg))
+	assert.NoError(h.t, validateMsg(msg))
+
+	return msg
+}
+
+// prepares returns the prepare messages of the accounts for the block in the view
+func (h *msgHarness) prepares(view *proto.View, block *types.Block, from ...string) []*proto.MessageReq {
+	msgs := make([]*proto.MessageReq, 0, len(from))
+
+	for _, account := range from {
+		msgs = append(msgs, h.sign(account, &proto.MessageReq{
+			Type:   proto.MessageReq_Prepare,
+			View:   view.Copy(),
+			Digest: block.Hash().String(),
+		}))
+	}
+
+	return msgs
+}
+
+// roundChange returns the round change message of the account,
+// with the block prepared in the prepared round by the prepare messages, if any
+func (h *msgHarness) roundChange(
+	from string,
+	view *proto.View,
+	prepared *types.Block,
+	preparedRound uint64,
+	prepares []*proto.MessageReq,
+) *proto.MessageReq {
+	msg := &proto.MessageReq{
+		Type: proto.MessageReq_RoundChange,
+		View: view.Copy(),
+	}
+
+	if prepared != nil {
+		msg.Digest = prepared.Hash().String()
+		msg.PreparedRound = preparedRound
+	}
+
+	h.sign(from, msg)
+
+	if prepared != nil {
+		// the certificate is attached after signing, as it isn't part of the payload
+		msg.PreparedCertificate = &proto.PreparedCertificate{
+			Proposal: &anypb.Any{
+				Value: prepared.MarshalRLP(),
+			},
+			PrepareMessages: prepares,
+		}
+	}
+
+	return msg
+}
+
+// preprepare returns the preprepare message of the proposer,
+// justified by the round change messages and the prepare messages of the highest prepared block
+func (h *msgHarness) preprepare(
+	from string,
+	view *proto.View,
+	block *types.Block,
+	roundChanges []*proto.MessageReq,
+	prepares []*proto.MessageReq,
+) *proto.MessageReq {
+	msg := h.sign(from, &proto.MessageReq{
+		Type:   proto.MessageReq_Preprepare,
+		View:   view.Copy(),
+		Digest: block.Hash().String(),
+		Proposal: &anypb.Any{
+			Value: block.MarshalRLP(),
+		},
+	})
+
+	if roundChanges != nil {
+		certificate := &proto.RoundChangeCertificate{
+			PrepareMessages: prepares,
+		}
+
+		for _, roundChange := range roundChanges {
+			stripped := roundChange.Copy()
+			stripped.PreparedCertificate = nil
+
+			certificate.RoundChangeMessages = append(certificate.RoundChangeMessages, stripped)
+		}
+
+		msg.RoundChangeCertificate = certificate
+	}
+
+	return msg
+}
+
+// deliver pushes the messages to the node, in the given order
+func (h *msgHarness) deliver(msgs ...*proto.MessageReq) {
+	for _, msg := range msgs {
+		h.Ibft.pushMessage(msg.Copy())
+	}
+}
+
+// outgoing returns the messages of the given type sent by the node
+func (h *msgHarness) outgoing(typ proto.MessageReq_Type) []*proto.MessageReq {
+	msgs := []*proto.MessageReq{}
+
+	for _, msg := range h.respMsg {
+		if msg.Type == typ {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs
+}
+
+// forEachOrder runs the scenario once for every permutation of the indexes [0, n)
+func forEachOrder(t *testing.T, n int, scenario func(t *testing.T, order []int)) {
+	t.Helper()
+
+	var permute func(order []int, k int)
+
+	permute = func(order []int, k int) {
+		if k == len(order) {
+			scenario(t, append([]int{}, order...))
+
+			return
+		}
+
+		for i := k; i < len(order); i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(order, k+1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	permute(order, 0)
+}
+
+func TestJustification_RoundChangeCarriesPreparedCertificate(t *testing.T) {
+	h := newMsgHarness(t, []string{"A", "B", "C", "D"}, "A")
+
+	block := h.block("A", 1)
+	h.state.block = block
+	h.setState(ValidateState)
+
+	// a quorum prepares the block, then the round times out
+	h.deliver(h.prepares(proto.ViewMsg(1, 0), block, "B", "C", "D")...)
+	h.Close()
+
+	h.runCycle()
+
+	h.expect(expectResult{
+		sequence:    1,
+		state:       ValidateState,
+		prepareMsgs: 3,
+		commitMsgs:  1,
+		locked:      true,
+		outgoing:    1, // commit
+	})
+
+	h.forceTimeout()
+	h.runCycle()
+	h.runCycle()
+
+	// the round change message proves the prepared block
+	roundChanges := h.outgoing(proto.MessageReq_RoundChange)
+	assert.Len(t, roundChanges, 1)
+
+	roundChange := roundChanges[0]
+	assert.Equal(t, block.Hash().String(), roundChange.Digest)
+	assert.Equal(t, uint64(0), roundChange.PreparedRound)
+	assert.Len(t, roundChange.PreparedCertificate.PrepareMessages, 3)
+
+	assert.NoError(t, validateMsg(roundChange))
+	assert.NoError(t, verifyRoundChangeMsg(roundChange, h.state.validators, OptimalQuorumSize(h.state.validators)))
+}
+
+func TestJustification_ProposerReproposesHighestPrepared(t *testing.T) {
+	pool := []string{"A", "B", "C", "D"}
+
+	// B is the proposer of the round 1
+	forEachOrder(t, 2, func(t *testing.T, order []int) {
+		h := newMsgHarness(t, pool, "B")
+		h.setState(RoundChangeState)
+
+		// C prepared the block X in the round 0, D didn't prepare anything
+		x := h.block("A", 1)
+		roundChanges := []*proto.MessageReq{
+			h.roundChange("C", proto.ViewMsg(1, 1), x, 0, h.prepares(proto.ViewMsg(1, 0), x, "A", "C", "D")),
+			h.roundChange("D", proto.ViewMsg(1, 1), nil, 0, nil),
+		}
+
+		for _, indx := range order {
+			h.deliver(roundChanges[indx])
+		}
+
+		h.Close()
+
+		// the round change messages of C, D and its own start the round 1
+		h.runCycle()
+
+		h.expect(expectResult{
+			sequence: 1,
+			round:    1,
+			state:    AcceptState,
+			outgoing: 1, // round change
+		})
+
+		// and the node proposes the prepared block, justified by the round change messages
+		h.runCycle()
+
+		h.expect(expectResult{
+			sequence: 1,
+			round:    1,
+			state:    ValidateState,
+			locked:   true,
+			outgoing: 3, // round change, preprepare and prepare
+		})
+
+		preprepares := h.outgoing(proto.MessageReq_Preprepare)
+		assert.Len(t, preprepares, 1)
+
+		preprepare := preprepares[0]
+		assert.Equal(t, x.Hash(), h.state.block.Hash())
+		assert.Equal(t, x.Hash().String(), preprepare.Digest)
+		assert.Len(t, preprepare.RoundChangeCertificate.RoundChangeMessages, 3)
+		assert.Len(t, preprepare.RoundChangeCertificate.PrepareMessages, 3)
+
+		assert.NoError(t, validateMsg(preprepare))
+		assert.NoError(t, verifyProposalJustification(preprepare, h.state.validators, OptimalQuorumSize(h.state.validators)))
+	})
+}
+
+func TestJustification_ValidatorReleasesLock(t *testing.T) {
+	h := newMsgHarness(t, []string{"A", "B", "C", "D"}, "C")
+
+	// C locked the block Y, which wasn't prepared by a quorum
+	y := h.block("A", 2)
+	h.state.block = y
+	h.state.locked = true
+	h.state.view = proto.ViewMsg(1, 1)
+	h.setState(AcceptState)
+
+	// the proposal of B in the round 1 is the block X, prepared in the round 0
+	x := h.block("A", 1)
+	prepares := h.prepares(proto.ViewMsg(1, 0), x, "A", "B", "D")
+	roundChanges := []*proto.MessageReq{
+		h.roundChange("A", proto.ViewMsg(1, 1), x, 0, prepares),
+		h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+		h.roundChange("C", proto.ViewMsg(1, 1), nil, 0, nil),
+	}
+
+	h.deliver(h.preprepare("B", proto.ViewMsg(1, 1), x, roundChanges, prepares))
+	h.Close()
+
+	h.runCycle()
+
+	h.expect(expectResult{
+		sequence: 1,
+		round:    1,
+		state:    ValidateState,
+		outgoing: 1, // prepare
+	})
+
+	assert.Equal(t, x.Hash(), h.state.block.Hash())
+	assert.Equal(t, x.Hash().String(), h.outgoing(proto.MessageReq_Prepare)[0].Digest)
+}
+
+func TestJustification_InvalidProposal(t *testing.T) {
+	pool := []string{"A", "B", "C", "D"}
+
+	cases := []struct {
+		name     string
+		proposal func(h *msgHarness) *proto.MessageReq
+	}{
+		{
+			"missing round change messages",
+			func(h *msgHarness) *proto.MessageReq {
+				return h.preprepare("B", proto.ViewMsg(1, 1), h.block("B", 1), nil, nil)
+			},
+		},
+		{
+			"round change messages below the quorum",
+			func(h *msgHarness) *proto.MessageReq {
+				roundChanges := []*proto.MessageReq{
+					h.roundChange("A", proto.ViewMsg(1, 1), nil, 0, nil),
+					h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+					h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+				}
+
+				return h.preprepare("B", proto.ViewMsg(1, 1), h.block("B", 1), roundChanges, nil)
+			},
+		},
+		{
+			"round change messages of another round",
+			func(h *msgHarness) *proto.MessageReq {
+				roundChanges := []*proto.MessageReq{
+					h.roundChange("A", proto.ViewMsg(1, 2), nil, 0, nil),
+					h.roundChange("B", proto.ViewMsg(1, 2), nil, 0, nil),
+					h.roundChange("D", proto.ViewMsg(1, 2), nil, 0, nil),
+				}
+
+				return h.preprepare("B", proto.ViewMsg(1, 1), h.block("B", 1), roundChanges, nil)
+			},
+		},
+		{
+			"the proposal isn't the prepared block",
+			func(h *msgHarness) *proto.MessageReq {
+				x := h.block("A", 1)
+				prepares := h.prepares(proto.ViewMsg(1, 0), x, "A", "B", "D")
+				roundChanges := []*proto.MessageReq{
+					h.roundChange("A", proto.ViewMsg(1, 1), x, 0, prepares),
+					h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+					h.roundChange("D", proto.ViewMsg(1, 1), nil, 0, nil),
+				}
+
+				return h.preprepare("B", proto.ViewMsg(1, 1), h.block("B", 2), roundChanges, prepares)
+			},
+		},
+		{
+			"prepare messages below the quorum",
+			func(h *msgHarness) *proto.MessageReq {
+				x := h.block("A", 1)
+				prepares := h.prepares(proto.ViewMsg(1, 0), x, "A", "B")
+				roundChanges := []*proto.MessageReq{
+					h.roundChange("A", proto.ViewMsg(1, 1), x, 0, prepares),
+					h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+					h.roundChange("D", proto.ViewMsg(1, 1), nil, 0, nil),
+				}
+
+				return h.preprepare("B", proto.ViewMsg(1, 1), x, roundChanges, prepares)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := newMsgHarness(t, pool, "C")
+			h.state.view = proto.ViewMsg(1, 1)
+			h.setState(AcceptState)
+
+			h.deliver(c.proposal(h))
+			h.Close()
+
+			// the proposal is dropped
+			h.runCycle()
+
+			h.expect(expectResult{
+				sequence: 1,
+				round:    1,
+				state:    AcceptState,
+			})
+		})
+	}
+}
+
+func TestJustification_InvalidRoundChangeDropped(t *testing.T) {
+	pool := []string{"A", "B", "C", "D"}
+
+	// the forged certificate of D doesn't count towards the quorum in any order
+	forEachOrder(t, 3, func(t *testing.T, order []int) {
+		h := newMsgHarness(t, pool, "A")
+		h.setState(RoundChangeState)
+
+		x := h.block("A", 1)
+		roundChanges := []*proto.MessageReq{
+			h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+			h.roundChange("C", proto.ViewMsg(1, 1), nil, 0, nil),
+			h.roundChange("D", proto.ViewMsg(1, 1), x, 0, h.prepares(proto.ViewMsg(1, 0), x, "D")),
+		}
+
+		for _, indx := range order {
+			h.deliver(roundChanges[indx])
+		}
+
+		h.Close()
+
+		h.runCycle()
+
+		// the messages of B, C and its own reach the quorum of the round 1
+		h.expect(expectResult{
+			sequence: 1,
+			round:    1,
+			state:    AcceptState,
+			outgoing: 1, // round change
+		})
+
+		for _, msg := range h.state.getRoundChangeJustification() {
+			assert.NotEqual(t, h.pool.get("D").Address(), msg.FromAddr())
+		}
+	})
+}
@@ -1,45 +1,34 @@
 package ibft
 
 import (
-	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
 	"github.com/0xPolygon/polygon-edge/types"
-	"github.com/umbracle/fastrlp"
 )
 
 // istanbulHeaderHash defines the custom implementation for getting the header hash,
 // because of the extraData field
 func istanbulHeaderHash(h *types.Header) types.Hash {
-	// this function replaces extra so we need to make a copy
-	h = h.Copy() // Remove later
-
-	arena := fastrlp.DefaultArenaPool.Get()
-	defer fastrlp.DefaultArenaPool.Put(arena)
-
-	// when hashing the block for signing we have to remove from
-	// the extra field the seal and committed seal items
-	extra, err := getIbftExtra(h)
+	h, err := headerWithoutSeals(h)
 	if err != nil {
 		return types.Hash{}
 	}
 
-	putIbftExtraValidators(h, extra.Validators)
+	return finality.HeaderHash(h, true)
+}
+
+// headerWithoutSeals returns a copy of the header whose extra data has no seals.
+// This will effectively remove the Seal and Committed Seal fields,
+// while keeping proposer vanity and validator set
+func headerWithoutSeals(h *types.Header) (*types.Header, error) {
+	// this function replaces extra so we need to make a copy
+	h = h.Copy()
 
-	vv := arena.NewArray()
-	vv.Set(arena.NewBytes(h.ParentHash.Bytes()))
-	vv.Set(arena.NewBytes(h.Sha3Uncles.Bytes()))
-	vv.Set(arena.NewBytes(h.Miner.Bytes()))
-	vv.Set(arena.NewBytes(h.StateRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.TxRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.ReceiptsRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.LogsBloom[:]))
-	vv.Set(arena.NewUint(h.Difficulty))
-	vv.Set(arena.NewUint(h.Number))
-	vv.Set(arena.NewUint(h.GasLimit))
-	vv.Set(arena.NewUint(h.GasUsed))
-	vv.Set(arena.NewUint(h.Timestamp))
-	vv.Set(arena.NewCopyBytes(h.ExtraData))
+	extra, err := getIbftExtra(h)
+	if err != nil {
+		return nil, err
+	}
 
-	buf := keccak.Keccak256Rlp(nil, vv)
+	putIbftExtraWithoutSeals(h, extra)
 
-	return types.BytesToHash(buf)
+	return h, nil
 }
@@ -18,12 +18,17 @@ const (
 	// PoS defines the Proof of Stake IBFT type,
 	// where the validator set it changed through staking on the Staking SC
 	PoS MechanismType = "PoS"
+
+	// PoAGovernance defines the Proof of Authority IBFT type,
+	// where the validator set is changed through proposals on the governance SC
+	PoAGovernance MechanismType = "PoAGovernance"
 )
 
 // mechanismTypes is the map used for easy string -> mechanism MechanismType lookups
 var mechanismTypes = map[string]MechanismType{
-	"PoA": PoA,
-	"PoS": PoS,
+	"PoA":           PoA,
+	"PoS":           PoS,
+	"PoAGovernance": PoAGovernance,
 }
 
 // String is a helper method for casting a MechanismType to a string representation
@@ -82,10 +87,13 @@ const (
 	// CalculateProposerHook defines what is the next proposer
 	// based on the previous
 	CalculateProposerHook = "CalculateProposerHook"
+
+	// SystemTxHook defines how the system transactions of a block are applied
+	SystemTxHook HookType = "SystemTxHook"
 )
 
 type ConsensusMechanism interface {
-	// GetType returns the type of IBFT consensus mechanism (PoA / PoS)
+	// GetType returns the type of IBFT consensus mechanism (PoA / PoS / PoAGovernance)
 	GetType() MechanismType
 
 	// GetHookMap returns the hooks registered with the specific consensus mechanism
@@ -173,12 +181,18 @@ type IBFTFork struct {
 	To                *common.JSONNumber `json:"to,omitempty"`
 	MaxValidatorCount *common.JSONNumber `json:"maxValidatorCount,omitempty"`
 	MinValidatorCount *common.JSONNumber `json:"minValidatorCount,omitempty"`
+
+	// Block pacing, the empty blocks are skipped up to the interval
+	// and the blocks are sealed early once the pending transactions reach the target gas
+	MaxEmptyBlockInterval *common.JSONDuration `json:"maxEmptyBlockInterval,omitempty"`
+	AdaptiveBlockTime     *AdaptiveBlockTime   `json:"adaptiveBlockTime,omitempty"`
 }
 
 // ConsensusMechanismFactory is the factory function to create a consensus mechanism
 type ConsensusMechanismFactory func(ibft *Ibft, params *IBFTFork) (ConsensusMechanism, error)
 
 var mechanismBackends = map[MechanismType]ConsensusMechanismFactory{
-	PoA: PoAFactory,
-	PoS: PoSFactory,
+	PoA:           PoAFactory,
+	PoS:           PoSFactory,
+	PoAGovernance: GovernanceFactory,
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
@@ -20,6 +21,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"google.golang.org/grpc"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 )
@@ -40,16 +42,18 @@ type blockchainInterface interface {
 	WriteBlock(block *types.Block) error
 	VerifyPotentialBlock(block *types.Block) error
 	CalculateGasLimit(number uint64) (uint64, error)
+	CalculateBaseFee(parent *types.Header) uint64
 }
 
 type txPoolInterface interface {
-	Prepare()
+	Prepare(baseFee uint64)
 	Length() uint64
 	Peek() *types.Transaction
 	Pop(tx *types.Transaction)
 	Drop(tx *types.Transaction)
 	Demote(tx *types.Transaction)
 	ResetWithHeaders(headers ...*types.Header)
+	PendingGas() uint64
 }
 
 type syncerInterface interface {
@@ -77,12 +81,22 @@ type Ibft struct {
 	validatorKey     *ecdsa.PrivateKey // Private key for the validator
 	validatorKeyAddr types.Address
 
+	blsFork         *BLSFork         // Configuration of the BLS aggregated seals, if any
+	validatorBLSKey *bls.SecretKey   // BLS secret key for the validator, in the BLS mode
+	blsKeys         blsKeyCache      // Cache of the decoded BLS public keys
+	sealSigners     sealSignersCache // Signers of the committed seals of the verified headers
+
 	txpool txPoolInterface // Reference to the transaction pool
 
-	store              *snapshotStore // Snapshot store that keeps track of all snapshots
+	store              *snapshotStore  // Snapshot store that keeps track of all snapshots
+	snapshotDB         snapshotStorage // Storage persisting the snapshots, if any
 	epochSize          uint64
 	quorumSizeBlockNum uint64
 
+	// justificationBlockNum is the height from which the messages carry their digest and the
+	// prepared and round change certificates. Nil keeps the legacy messages, understood by older nodes
+	justificationBlockNum *uint64
+
 	msgQueue *msgQueue     // Structure containing different message queues
 	updateCh chan struct{} // Update channel
 
@@ -101,8 +115,18 @@ type Ibft struct {
 	secretsManager secrets.SecretsManager
 
 	mechanisms []ConsensusMechanism // IBFT ConsensusMechanism used (PoA / PoS)
+	ibftForks  []IBFTFork           // IBFT forks of the mechanisms, with their block pacing
 
 	blockTime time.Duration // Minimum block generation time in seconds
+
+	txOrdering *TxOrderingConfig // Policy for picking transactions from the txpool
+
+	roundTimeouts []*RoundTimeouts // Round timeouts of the forks, sorted by the starting block
+
+	slashing *SlashingConfig // Penalties of the PoS validators
+	evidence *evidencePool   // Equivocations of the validators, reported by the proposer
+
+	clock Clock // Source of time for the timers and block timestamps
 }
 
 // runHook runs a specified hook if it is present in the hook map
@@ -161,22 +185,72 @@ func Factory(
 		quorumSizeBlockNum = uint64(readBlockNum)
 	}
 
+	var justificationBlockNum *uint64
+
+	if rawBlockNum, ok := params.Config.Config["justificationBlockNum"]; ok {
+		//	Block number from which the messages are justified by certificates
+		readBlockNum, ok := rawBlockNum.(float64)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
+		}
+
+		blockNum := uint64(readBlockNum)
+		justificationBlockNum = &blockNum
+	}
+
+	txOrdering, err := GetTxOrderingConfig(params.Config.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	blsFork, err := GetBLSFork(params.Config.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	roundTimeouts, err := GetRoundTimeouts(params.Config.Config, params.RoundTimeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	slashing, err := GetSlashingConfig(params.Config.Config)
+	if err != nil {
+		return nil, err
+	}
+
 	p := &Ibft{
-		logger:             params.Logger.Named("ibft"),
-		config:             params.Config,
-		Grpc:               params.Grpc,
-		blockchain:         params.Blockchain,
-		executor:           params.Executor,
-		closeCh:            make(chan struct{}),
-		txpool:             params.Txpool,
-		state:              &currentState{},
-		network:            params.Network,
-		epochSize:          epochSize,
-		quorumSizeBlockNum: quorumSizeBlockNum,
-		sealing:            params.Seal,
-		metrics:            params.Metrics,
-		secretsManager:     params.SecretsManager,
-		blockTime:          time.Duration(params.BlockTime) * time.Second,
+		logger:                params.Logger.Named("ibft"),
+		config:                params.Config,
+		Grpc:                  params.Grpc,
+		blockchain:            params.Blockchain,
+		executor:              params.Executor,
+		closeCh:               make(chan struct{}),
+		txpool:                params.Txpool,
+		state:                 &currentState{},
+		network:               params.Network,
+		epochSize:             epochSize,
+		quorumSizeBlockNum:    quorumSizeBlockNum,
+		justificationBlockNum: justificationBlockNum,
+		sealing:               params.Seal,
+		metrics:               params.Metrics,
+		secretsManager:        params.SecretsManager,
+		blockTime:             time.Duration(params.BlockTime) * time.Second,
+		txOrdering:            txOrdering,
+		blsFork:               blsFork,
+		roundTimeouts:         roundTimeouts,
+		slashing:              slashing,
+		evidence:              newEvidencePool(),
+		clock:                 systemClock{},
+	}
+
+	// the snapshots are kept in the storage of the blockchain
+	if params.Blockchain != nil {
+		p.snapshotDB = params.Blockchain.Storage()
+	}
+
+	// the system transactions of the blocks are applied by the consensus
+	if params.Executor != nil {
+		params.Executor.SystemTxHandler = p.applySystemTx
 	}
 
 	// Initialize the mechanism
@@ -296,6 +370,12 @@ func (i *Ibft) setupMechanism() error {
 		return err
 	}
 
+	if err := validateForkPacing(ibftForks, i.blockTime); err != nil {
+		return err
+	}
+
+	i.ibftForks = ibftForks
+
 	i.mechanisms = make([]ConsensusMechanism, len(ibftForks))
 
 	for idx, fork := range ibftForks {
@@ -322,7 +402,7 @@ func (i *Ibft) setupTransport() error {
 	}
 
 	// Subscribe to the newly created topic
-	err = topic.Subscribe(func(obj interface{}) {
+	err = topic.Subscribe(func(obj interface{}, _ peer.ID) {
 		msg, ok := obj.(*proto.MessageReq)
 		if !ok {
 			i.logger.Error("invalid type assertion for message request")
@@ -330,26 +410,7 @@ func (i *Ibft) setupTransport() error {
 			return
 		}
 
-		if !i.isSealing() {
-			// if we are not sealing we do not care about the messages
-			// but we need to subscribe to propagate the messages
-			return
-		}
-
-		// decode sender
-		if err := validateMsg(msg); err != nil {
-			i.logger.Error("failed to validate msg", "err", err)
-
-			return
-		}
-
-		if msg.From == i.validatorKeyAddr.String() {
-			// we are the sender, skip this message since we already
-			// relay our own messages internally.
-			return
-		}
-
-		i.pushMessage(msg)
+		i.receiveMessage(msg)
 	})
 
 	if err != nil {
@@ -361,6 +422,32 @@ func (i *Ibft) setupTransport() error {
 	return nil
 }
 
+// receiveMessage validates a message received from the transport
+// and adds it to the message queue
+func (i *Ibft) receiveMessage(msg *proto.MessageReq) {
+	if !i.isSealing() {
+		// if we are not sealing we do not care about the messages
+		// but we need to subscribe to propagate the messages
+		return
+	}
+
+	// decode sender
+	if err := validateMsg(msg); err != nil {
+		i.logger.Error("failed to validate msg", "err", err)
+
+		return
+	}
+
+	if msg.From == i.validatorKeyAddr.String() {
+		// we are the sender, skip this message since we already
+		// relay our own messages internally.
+		return
+	}
+
+	i.observeEvidence(msg)
+	i.pushMessage(msg)
+}
+
 // createKey sets the validator's private key from the secrets manager
 func (i *Ibft) createKey() error {
 	i.msgQueue = newMsgQueue()
@@ -399,6 +486,38 @@ func (i *Ibft) createKey() error {
 		i.validatorKeyAddr = crypto.PubKeyToAddress(&key.PublicKey)
 	}
 
+	if i.blsFork != nil && i.validatorBLSKey == nil {
+		if i.secretsManager.HasSecret(secrets.ValidatorBLSKey) {
+			// The BLS key is present in the secrets manager, load it
+			validatorBLSKey, readErr := crypto.ReadConsensusBLSKey(i.secretsManager)
+			if readErr != nil {
+				return fmt.Errorf("unable to read validator BLS key from Secrets Manager, %w", readErr)
+			}
+
+			i.validatorBLSKey = validatorBLSKey
+		} else {
+			// The BLS key is not present in the secrets manager, generate it.
+			// It will be registered in the first block proposed by the validator
+			validatorBLSKey, validatorBLSKeyEncoded, genErr := crypto.GenerateAndEncodeBLSSecretKey()
+			if genErr != nil {
+				return fmt.Errorf("unable to generate validator BLS key for Secrets Manager, %w", genErr)
+			}
+
+			saveErr := i.secretsManager.SetSecret(secrets.ValidatorBLSKey, validatorBLSKeyEncoded)
+			if saveErr != nil {
+				return fmt.Errorf("unable to save validator BLS key to Secrets Manager, %w", saveErr)
+			}
+
+			i.validatorBLSKey = validatorBLSKey
+		}
+
+		if validator, ok := i.blsFork.Validators[i.validatorKeyAddr]; ok &&
+			validator.PublicKey != hex.EncodeToHex(i.validatorBLSKey.PublicKey().Marshal()) {
+			// the seals of the validator won't verify against the key of the chain config
+			i.logger.Warn("validator BLS key doesn't match the key of the chain config")
+		}
+	}
+
 	return nil
 }
 
@@ -505,8 +624,8 @@ func (i *Ibft) runSyncState() {
 				i.metrics.Rounds.Set(float64(i.state.view.Round))
 
 				i.setState(AcceptState)
-			} else {
-				time.Sleep(1 * time.Second)
+			} else if i.clock.Wait(i.clock.Now().Add(1*time.Second), nil, i.closeCh) == WaitDone {
+				return
 			}
 
 			continue
@@ -542,7 +661,7 @@ func (i *Ibft) runSyncState() {
 			isValidator = i.isValidSnapshot()
 
 			return isValidator
-		}, i.blockTime)
+		}, i.syncBlockTimeout())
 
 		if isValidator {
 			// at this point, we are in sync with the latest chain we know of
@@ -588,6 +707,7 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 	}
 
 	header.GasLimit = gasLimit
+	header.BaseFee = i.blockchain.CalculateBaseFee(parent)
 
 	if hookErr := i.runHook(CandidateVoteHook, header.Number, &candidateVoteHookParams{
 		header: header,
@@ -598,10 +718,10 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 
 	// set the timestamp
 	parentTime := time.Unix(int64(parent.Timestamp), 0)
-	headerTime := parentTime.Add(i.blockTime)
+	headerTime := parentTime.Add(i.blockDelay(header.Number))
 
-	if headerTime.Before(time.Now()) {
-		headerTime = time.Now()
+	if now := i.clock.Now(); headerTime.Before(now) {
+		headerTime = now
 	}
 
 	header.Timestamp = uint64(headerTime.Unix())
@@ -609,6 +729,12 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 	// we need to include in the extra field the current set of validators
 	putIbftExtraValidators(header, snap.Set)
 
+	if i.isBLSActive(header.Number) {
+		if err := i.putBLSExtra(snap, header); err != nil {
+			return nil, err
+		}
+	}
+
 	transition, err := i.executor.BeginTxn(parent.StateRoot, header, i.validatorKeyAddr)
 	if err != nil {
 		return nil, err
@@ -617,7 +743,8 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 	// If the mechanism is PoA -> always build a regular block, regardless of epoch
 	txns := []*types.Transaction{}
 	if i.shouldWriteTransactions(header.Number) {
-		txns = i.writeTransactions(gasLimit, transition)
+		txns = append(txns, i.writeEvidence(header.Number, transition)...)
+		txns = append(txns, i.writeTransactions(header.BaseFee, gasLimit, transition)...)
 	}
 
 	if err := i.PreStateCommit(header, transition); err != nil {
@@ -652,23 +779,53 @@ func (i *Ibft) buildBlock(snap *Snapshot, parent *types.Header) (*types.Block, e
 	return block, nil
 }
 
+// putBLSExtra adds to the extra field the registration of our BLS key if it isn't known yet,
+// and the BLS keys of the validators in the epoch blocks
+func (i *Ibft) putBLSExtra(snap *Snapshot, header *types.Header) error {
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return err
+	}
+
+	if i.rawBLSKey(snap, i.validatorKeyAddr) == nil {
+		extra.BLSRegistration = &BLSRegistration{
+			PublicKey: i.validatorBLSKey.PublicKey().Marshal(),
+			Proof:     i.validatorBLSKey.ProvePossession().Marshal(),
+		}
+	}
+
+	if header.Number%i.epochSize == 0 {
+		extra.ValidatorBLSKeys = i.expectedValidatorBLSKeys(
+			snap,
+			extra.Validators,
+			i.validatorKeyAddr,
+			extra.BLSRegistration,
+		)
+	}
+
+	return PutIbftExtra(header, extra)
+}
+
 type transitionInterface interface {
 	Write(txn *types.Transaction) error
 	WriteFailedReceipt(txn *types.Transaction) error
 }
 
 // writeTransactions writes transactions from the txpool to the transition object
-// and returns transactions that were included in the transition (new block)
-func (i *Ibft) writeTransactions(gasLimit uint64, transition transitionInterface) []*types.Transaction {
+// and returns transactions that were included in the transition (new block).
+// The order in which transactions are picked is defined by the configured tx ordering
+func (i *Ibft) writeTransactions(baseFee, gasLimit uint64, transition transitionInterface) []*types.Transaction {
 	var transactions []*types.Transaction
 
 	successTxCount := 0
 	failedTxCount := 0
 
-	i.txpool.Prepare()
+	i.txpool.Prepare(baseFee)
+
+	selector := newTxSelector(i.txpool, i.txOrdering, baseFee)
 
 	for {
-		tx := i.txpool.Peek()
+		tx := selector.next()
 		if tx == nil {
 			break
 		}
@@ -705,6 +862,7 @@ func (i *Ibft) writeTransactions(gasLimit uint64, transition transitionInterface
 
 		// no errors, pop the tx from the pool
 		i.txpool.Pop(tx)
+		selector.markIncluded(tx)
 
 		successTxCount++
 
@@ -782,9 +940,34 @@ func (i *Ibft) runAcceptState() { // start new round
 	if i.state.proposer == i.validatorKeyAddr {
 		logger.Info("we are the proposer", "block", number)
 
+		if i.state.view.Round > 0 && i.isJustified(i.state.view.Sequence) {
+			// the proposal has to be justified by the round change messages of the round
+			if err := i.adoptHighestPrepared(); err != nil {
+				i.logger.Error("failed to justify the proposal", "err", err)
+				i.setState(RoundChangeState)
+
+				return
+			}
+		}
+
 		if !i.state.locked {
+			// wait for the transactions of the block, following the block pacing of the fork
+			if i.waitForBlock(parent) == WaitDone {
+				return
+			}
+
 			// since the state is not locked, we need to build a new block
 			i.state.block, err = i.buildBlock(snap, parent)
+			if err == nil && errors.Is(i.verifyBlockPacing(parent, i.state.block.Header), errEmptyBlockTooEarly) {
+				// none of the pending transactions made it into the block,
+				// which is built again once an empty block is allowed
+				if i.clock.Wait(i.emptyBlockTime(parent), nil, i.closeCh) == WaitDone {
+					return
+				}
+
+				i.state.block, err = i.buildBlock(snap, parent)
+			}
+
 			if err != nil {
 				i.logger.Error("failed to build block", "err", err)
 				i.setState(RoundChangeState)
@@ -792,12 +975,10 @@ func (i *Ibft) runAcceptState() { // start new round
 				return
 			}
 
-			// calculate how much time do we have to wait to mine the block
-			delay := time.Until(time.Unix(int64(i.state.block.Header.Timestamp), 0))
+			// wait for the timestamp of the block before proposing it
+			deadline := time.Unix(int64(i.state.block.Header.Timestamp), 0)
 
-			select {
-			case <-time.After(delay):
-			case <-i.closeCh:
+			if i.clock.Wait(deadline, nil, i.closeCh) == WaitDone {
 				return
 			}
 		}
@@ -819,7 +1000,8 @@ func (i *Ibft) runAcceptState() { // start new round
 	// we are NOT a proposer for the block. Then, we have to wait
 	// for a pre-prepare message from the proposer
 
-	timeout := exponentialTimeout(i.state.view.Round)
+	// the proposer may wait for transactions before proposing an empty block
+	timeout := i.roundTimeout(i.state.view.Round) + i.proposalDelay(parent)
 	for i.getState() == AcceptState {
 		msg, ok := i.getNextMessage(timeout)
 		if !ok {
@@ -847,6 +1029,13 @@ func (i *Ibft) runAcceptState() { // start new round
 			return
 		}
 
+		if i.state.locked && msg.View.Round > 0 && i.isJustified(msg.View.Sequence) && block.Hash() != i.state.block.Hash() {
+			// the proposal is justified by the round change messages of a quorum,
+			// so the locked block can't have been committed in an earlier round
+			i.logger.Info("releasing the locked block for a justified proposal", "locked", i.state.block.Hash())
+			i.state.unlock()
+		}
+
 		if i.state.locked {
 			// the state is locked, we need to receive the same block
 			if block.Hash() == i.state.block.Hash() {
@@ -892,6 +1081,35 @@ func (i *Ibft) runAcceptState() { // start new round
 	}
 }
 
+// adoptHighestPrepared locks the block prepared in the highest round among the round change messages
+// justifying the current round, since it is the only block which can be proposed
+func (i *Ibft) adoptHighestPrepared() error {
+	roundChanges := i.state.getRoundChangeJustification()
+	if len(roundChanges) == 0 {
+		return errMissingRoundChangeJustification
+	}
+
+	highest := highestPrepared(roundChanges)
+	if highest == nil {
+		// nothing was prepared, any block can be proposed
+		return nil
+	}
+
+	if i.state.block != nil && i.state.block.Hash().String() == highest.Digest {
+		return nil
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(highest.PreparedCertificate.Proposal.Value); err != nil {
+		return err
+	}
+
+	i.state.block = block
+	i.state.lock()
+
+	return nil
+}
+
 // runValidateState implements the Validate state loop.
 //
 // The Validate state is rather simple - all nodes do in this state is read messages
@@ -911,7 +1129,7 @@ func (i *Ibft) runValidateState() {
 		}
 	}
 
-	timeout := exponentialTimeout(i.state.view.Round)
+	timeout := i.roundTimeout(i.state.view.Round)
 	for i.getState() == ValidateState {
 		msg, ok := i.getNextMessage(timeout)
 		if !ok {
@@ -925,11 +1143,25 @@ func (i *Ibft) runValidateState() {
 			continue
 		}
 
+		if i.isJustified(msg.View.Sequence) && msg.Digest != i.state.block.Hash().String() {
+			// the prepare and commit messages of another block don't count towards the quorum
+			i.logger.Debug("message for another block", "type", msg.Type, "from", msg.From, "digest", msg.Digest)
+
+			continue
+		}
+
 		switch msg.Type {
 		case proto.MessageReq_Prepare:
 			i.state.addPrepared(msg)
 
 		case proto.MessageReq_Commit:
+			if i.isBLSActive(i.state.view.Sequence) && !i.hasBLSKey(msg) {
+				// the seal of a validator without a known BLS key can't be aggregated
+				i.logger.Debug("commit from a validator without a BLS key", "from", msg.From)
+
+				continue
+			}
+
 			i.state.addCommitted(msg)
 
 		default:
@@ -937,7 +1169,9 @@ func (i *Ibft) runValidateState() {
 		}
 
 		if i.state.numPrepared() >= i.quorumSize(i.state.view.Sequence)(i.state.validators) {
-			// we have received enough pre-prepare messages
+			// we have received enough pre-prepare messages,
+			// the prepare messages are the certificate of the block in the next rounds
+			i.state.setPrepared()
 			sendCommit()
 		}
 
@@ -989,34 +1223,15 @@ func (i *Ibft) updateMetrics(block *types.Block) {
 	i.metrics.NumTxs.Set(float64(len(block.Body().Transactions)))
 }
 func (i *Ibft) insertBlock(block *types.Block) error {
-	committedSeals := make([][]byte, 0)
-
-	for _, commit := range i.state.committed {
-		// no need to check the format of seal here because writeCommittedSeals will check
-		committedSeal, decodeErr := hex.DecodeHex(commit.Seal)
-		if decodeErr != nil {
-			i.logger.Error(
-				fmt.Sprintf(
-					"unable to decode committed seal from %s",
-					commit.From,
-				),
-			)
-
-			continue
+	if i.isBLSActive(block.Number()) {
+		if err := i.writeBLSCommittedSeals(block); err != nil {
+			return err
 		}
-
-		committedSeals = append(committedSeals, committedSeal)
-	}
-
-	// Push the committed seals to the header
-	header, err := writeCommittedSeals(block.Header, committedSeals)
-	if err != nil {
+	} else if err := i.writeECDSACommittedSeals(block); err != nil {
 		return err
 	}
 
-	// The hash needs to be recomputed since the extra data was changed
-	block.Header = header
-	block.Header.ComputeHash()
+	header := block.Header
 
 	// Verify the header only, since the block body is already verified
 	if err := i.VerifyHeader(block.Header); err != nil {
@@ -1057,10 +1272,113 @@ func (i *Ibft) insertBlock(block *types.Block) error {
 	return nil
 }
 
+// writeECDSACommittedSeals pushes the committed seals to the header of the block
+func (i *Ibft) writeECDSACommittedSeals(block *types.Block) error {
+	committedSeals := make([][]byte, 0)
+
+	for _, commit := range i.state.committed {
+		// no need to check the format of seal here because writeCommittedSeals will check
+		committedSeal, decodeErr := hex.DecodeHex(commit.Seal)
+		if decodeErr != nil {
+			i.logger.Error(
+				fmt.Sprintf(
+					"unable to decode committed seal from %s",
+					commit.From,
+				),
+			)
+
+			continue
+		}
+
+		committedSeals = append(committedSeals, committedSeal)
+	}
+
+	// Push the committed seals to the header
+	header, err := writeCommittedSeals(block.Header, committedSeals)
+	if err != nil {
+		return err
+	}
+
+	// The hash needs to be recomputed since the extra data was changed
+	block.Header = header
+	block.Header.ComputeHash()
+
+	return nil
+}
+
+// writeBLSCommittedSeals aggregates the BLS committed seals in the header of the block
+func (i *Ibft) writeBLSCommittedSeals(block *types.Block) error {
+	snap, err := i.getSnapshot(block.Number() - 1)
+	if err != nil {
+		return err
+	}
+
+	if snap == nil {
+		return fmt.Errorf("snapshot not found for block %d", block.Number()-1)
+	}
+
+	seals := make(map[types.Address][]byte, len(i.state.committed))
+
+	for addr, commit := range i.state.committed {
+		seal, decodeErr := hex.DecodeHex(commit.Seal)
+		if decodeErr != nil {
+			i.logger.Error(
+				fmt.Sprintf(
+					"unable to decode committed seal from %s",
+					commit.From,
+				),
+			)
+
+			continue
+		}
+
+		seals[addr] = seal
+	}
+
+	aggregatedSeal, err := i.aggregateCommittedSeals(snap, block.Header, seals)
+	if err != nil {
+		return err
+	}
+
+	header, err := writeAggregatedSeal(block.Header, aggregatedSeal)
+	if err != nil {
+		return err
+	}
+
+	// The hash needs to be recomputed since the extra data was changed
+	block.Header = header
+	block.Header.ComputeHash()
+
+	return nil
+}
+
+// hasBLSKey checks if the BLS key of the sender of the commit message is known,
+// either in the snapshot or in the proposed block
+func (i *Ibft) hasBLSKey(msg *proto.MessageReq) bool {
+	snap, err := i.getSnapshot(i.state.view.Sequence - 1)
+	if err != nil || snap == nil || i.state.block == nil {
+		return false
+	}
+
+	extra, err := getIbftExtra(i.state.block.Header)
+	if err != nil {
+		return false
+	}
+
+	sealerKey, err := i.sealerBLSKeys(snap, i.state.block.Header, extra)
+	if err != nil {
+		return false
+	}
+
+	return sealerKey(types.StringToAddress(msg.From)) != nil
+}
+
 var (
 	errIncorrectBlockLocked    = fmt.Errorf("block locked is incorrect")
 	errBlockVerificationFailed = fmt.Errorf("block verification failed")
 	errFailedToInsertBlock     = fmt.Errorf("failed to insert block")
+
+	errMissingRoundChangeJustification = fmt.Errorf("no round change messages for the round")
 )
 
 func (i *Ibft) handleStateErr(err error) {
@@ -1115,6 +1433,10 @@ func (i *Ibft) runRoundChangeState() {
 		if maxRound, ok := i.state.maxRound(); ok {
 			i.logger.Debug("round change set max round", "round", maxRound)
 			sendRoundChange(maxRound)
+		} else if fastRound, ok := i.roundChangeFastPath(); ok {
+			// then with the higher round of the F+1 round change messages received earlier
+			i.logger.Debug("round change fast path", "round", fastRound)
+			sendRoundChange(fastRound)
 		} else {
 			// otherwise, do your best to sync up
 			checkTimeout()
@@ -1122,7 +1444,7 @@ func (i *Ibft) runRoundChangeState() {
 	}
 
 	// create a timer for the round change
-	timeout := exponentialTimeout(i.state.view.Round)
+	timeout := i.roundTimeout(i.state.view.Round)
 	for i.getState() == RoundChangeState {
 		msg, ok := i.getNextMessage(timeout)
 		if !ok {
@@ -1134,7 +1456,7 @@ func (i *Ibft) runRoundChangeState() {
 			i.logger.Debug("round change timeout")
 			checkTimeout()
 			// update the timeout duration
-			timeout = exponentialTimeout(i.state.view.Round)
+			timeout = i.roundTimeout(i.state.view.Round)
 
 			continue
 		}
@@ -1145,11 +1467,12 @@ func (i *Ibft) runRoundChangeState() {
 		if num == i.state.validators.MaxFaultyNodes()+1 && i.state.view.Round < msg.View.Round {
 			// weak certificate, try to catch up if our round number is smaller
 			// update timer
-			timeout = exponentialTimeout(i.state.view.Round)
+			timeout = i.roundTimeout(i.state.view.Round)
 			sendRoundChange(msg.View.Round)
 		} else if num == i.quorumSize(i.state.view.Sequence)(i.state.validators) {
-			// start a new round immediately
+			// start a new round immediately, justified by the round change messages
 			i.state.view.Round = msg.View.Round
+			i.state.setRoundChangeJustification(msg.View.Round)
 			i.setState(AcceptState)
 		}
 	}
@@ -1181,16 +1504,48 @@ func (i *Ibft) gossip(typ proto.MessageReq_Type) {
 	// add View
 	msg.View = i.state.view.Copy()
 
+	// before the fork the messages are the legacy ones, without digest nor certificates
+	justified := i.isJustified(msg.View.Sequence)
+
+	// the messages of the proposal refer to its digest
+	if justified && msg.Type != proto.MessageReq_RoundChange {
+		msg.Digest = i.state.block.Hash().String()
+	}
+
 	// if we are sending a preprepare message we need to include the proposed block
 	if msg.Type == proto.MessageReq_Preprepare {
 		msg.Proposal = &anypb.Any{
 			Value: i.state.block.MarshalRLP(),
 		}
+
+		// and the round change messages justifying it after the first round
+		if justified && msg.View.Round > 0 {
+			msg.RoundChangeCertificate = i.roundChangeCertificate()
+		}
+	}
+
+	// if the message is a round change, we need to add the latest prepared block
+	if justified && msg.Type == proto.MessageReq_RoundChange {
+		if prepared := i.state.getPrepared(); prepared != nil {
+			msg.Digest = prepared.digest
+			msg.PreparedRound = prepared.view.Round
+			msg.PreparedCertificate = prepared.certificate
+		}
 	}
 
 	// if the message is commit, we need to add the committed seal
 	if msg.Type == proto.MessageReq_Commit {
-		seal, err := writeCommittedSeal(i.validatorKey, i.state.block.Header)
+		var (
+			seal []byte
+			err  error
+		)
+
+		if i.isBLSActive(i.state.block.Number()) {
+			seal, err = writeBLSCommittedSeal(i.validatorBLSKey, i.state.block.Header)
+		} else {
+			seal, err = writeCommittedSeal(i.validatorKey, i.state.block.Header)
+		}
+
 		if err != nil {
 			i.logger.Error("failed to commit seal", "err", err)
 
@@ -1200,22 +1555,47 @@ func (i *Ibft) gossip(typ proto.MessageReq_Type) {
 		msg.Seal = hex.EncodeToHex(seal)
 	}
 
+	if err := signMsg(i.validatorKey, msg); err != nil {
+		i.logger.Error("failed to sign message", "err", err)
+
+		return
+	}
+
 	if msg.Type != proto.MessageReq_Preprepare {
-		// send a copy to ourselves so that we can process this message as well
+		// send a signed copy to ourselves so that we can process this message as well,
+		// and include it in the certificates
 		msg2 := msg.Copy()
 		msg2.From = i.validatorKeyAddr.String()
 		i.pushMessage(msg2)
 	}
 
-	if err := signMsg(i.validatorKey, msg); err != nil {
-		i.logger.Error("failed to sign message", "err", err)
+	if err := i.transport.Gossip(msg); err != nil {
+		i.logger.Error("failed to gossip", "err", err)
+	}
+}
 
-		return
+// roundChangeCertificate returns the round change messages justifying the proposal of the current round,
+// with the prepare messages of the highest prepared block among them
+func (i *Ibft) roundChangeCertificate() *proto.RoundChangeCertificate {
+	roundChanges := i.state.getRoundChangeJustification()
+	certificate := &proto.RoundChangeCertificate{
+		RoundChangeMessages: make([]*proto.MessageReq, 0, len(roundChanges)),
 	}
 
-	if err := i.transport.Gossip(msg); err != nil {
-		i.logger.Error("failed to gossip", "err", err)
+	for _, msg := range roundChanges {
+		// the prepared certificates are not part of the signed payload,
+		// only the one of the highest prepared block is needed
+		roundChange := msg.Copy()
+		roundChange.PreparedCertificate = nil
+
+		certificate.RoundChangeMessages = append(certificate.RoundChangeMessages, roundChange)
+	}
+
+	if highest := highestPrepared(roundChanges); highest != nil {
+		certificate.PrepareMessages = highest.PreparedCertificate.PrepareMessages
 	}
+
+	return certificate
 }
 
 // getState returns the current IBFT state
@@ -1247,7 +1627,8 @@ func (i *Ibft) isSealing() bool {
 // verifyHeaderImpl implements the actual header verification logic
 func (i *Ibft) verifyHeaderImpl(snap *Snapshot, parent, header *types.Header) error {
 	// ensure the extra data is correctly formatted
-	if _, err := getIbftExtra(header); err != nil {
+	extra, err := getIbftExtra(header)
+	if err != nil {
 		return err
 	}
 
@@ -1273,6 +1654,16 @@ func (i *Ibft) verifyHeaderImpl(snap *Snapshot, parent, header *types.Header) er
 		return err
 	}
 
+	// verify the BLS keys registered in the header
+	if err := i.verifyBLSFields(snap, header, extra); err != nil {
+		return err
+	}
+
+	// verify the timestamp against the block pacing of the fork
+	if err := i.verifyBlockPacing(parent, header); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1296,11 +1687,19 @@ func (i *Ibft) VerifyHeader(header *types.Header) error {
 		return err
 	}
 
-	// verify the committed seals
-	if err := verifyCommittedFields(snap, header, i.quorumSize(header.Number)); err != nil {
+	// verify the committed seals, aggregated in the BLS mode
+	if i.isBLSActive(header.Number) {
+		return i.verifyAggregatedSeal(snap, header, i.quorumSize(header.Number))
+	}
+
+	signers, err := verifyCommittedFields(snap, header, i.quorumSize(header.Number))
+	if err != nil {
 		return err
 	}
 
+	// the signers are counted in the liveness metrics once the block is written
+	i.sealSigners.add(header.Hash, signers)
+
 	return nil
 }
 
@@ -1315,9 +1714,24 @@ func (i *Ibft) quorumSize(blockNumber uint64) QuorumImplementation {
 	return OptimalQuorumSize
 }
 
-// ProcessHeaders updates the snapshot based on previously verified headers
+// isJustified checks if the messages of the block number carry their digest and certificates.
+// Before the fork height the legacy messages are used, so a validator set with older nodes keeps its quorum
+func (i *Ibft) isJustified(blockNumber uint64) bool {
+	return i.justificationBlockNum != nil && blockNumber >= *i.justificationBlockNum
+}
+
+// ProcessHeaders updates the snapshot based on previously verified headers,
+// and the liveness metrics of the validators
 func (i *Ibft) ProcessHeaders(headers []*types.Header) error {
-	return i.processHeaders(headers)
+	if err := i.processHeaders(headers); err != nil {
+		return err
+	}
+
+	for _, header := range headers {
+		i.updateLivenessMetrics(header)
+	}
+
+	return nil
 }
 
 // GetBlockCreator retrieves the block signer from the extra data field
@@ -1356,24 +1770,22 @@ func (i *Ibft) IsLastOfEpoch(number uint64) bool {
 func (i *Ibft) Close() error {
 	close(i.closeCh)
 
-	if i.config.Path != "" {
-		err := i.store.saveToPath(i.config.Path)
-
-		if err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
 // getNextMessage reads a new message from the message queue
 func (i *Ibft) getNextMessage(timeout time.Duration) (*proto.MessageReq, bool) {
-	timeoutCh := time.After(timeout)
+	deadline := i.clock.Now().Add(timeout)
 
 	for {
 		msg := i.msgQueue.readMessage(i.getState(), i.state.view)
 		if msg != nil {
+			if err := i.verifyCertificates(msg.obj); err != nil {
+				i.logger.Error("invalid message certificate", "type", msg.msg, "from", msg.obj.From, "err", err)
+
+				continue
+			}
+
 			return msg.obj, true
 		}
 
@@ -1383,20 +1795,62 @@ func (i *Ibft) getNextMessage(timeout time.Duration) (*proto.MessageReq, bool) {
 			return nil, true
 		}
 
+		// skip the timeout if enough validators moved to a higher round
+		if i.getState() != RoundChangeState {
+			if round, ok := i.roundChangeFastPath(); ok {
+				i.logger.Info("validators moved to a higher round, skipping the timeout", "round", round+1)
+
+				return nil, true
+			}
+		}
+
 		// wait until there is a new message or
 		// someone closes the stopCh (i.e. timeout for round change)
-		select {
-		case <-timeoutCh:
+		switch i.clock.Wait(deadline, i.updateCh, i.closeCh) {
+		case WaitExpired:
 			i.logger.Info("unable to read new message from the message queue", "timeout expired", timeout)
 
 			return nil, true
-		case <-i.closeCh:
+		case WaitDone:
 			return nil, false
-		case <-i.updateCh:
+		case WaitWoken:
 		}
 	}
 }
 
+// verifyCertificates checks the prepared certificate of round change messages,
+// and the justification of the proposal in preprepare messages
+func (i *Ibft) verifyCertificates(msg *proto.MessageReq) error {
+	if !i.isJustified(i.state.view.Sequence) {
+		return nil
+	}
+
+	quorum := i.quorumSize(i.state.view.Sequence)(i.state.validators)
+
+	switch msg.Type {
+	case proto.MessageReq_RoundChange:
+		return verifyRoundChangeMsg(msg, i.state.validators, quorum)
+	case proto.MessageReq_Preprepare:
+		return verifyProposalJustification(msg, i.state.validators, quorum)
+	default:
+		return nil
+	}
+}
+
+// roundChangeFastPath returns the highest round above the current one for which
+// at least F+1 validators sent round change messages, so at least one honest validator moved to it
+func (i *Ibft) roundChangeFastPath() (uint64, bool) {
+	if i.msgQueue == nil || i.state.view == nil || len(i.state.validators) == 0 {
+		return 0, false
+	}
+
+	return i.msgQueue.highestRoundChange(
+		i.state.view,
+		i.state.validators,
+		i.state.validators.MaxFaultyNodes()+1,
+	)
+}
+
 // pushMessage pushes a new message to the message queue
 func (i *Ibft) pushMessage(msg *proto.MessageReq) {
 	task := &msgTask{
@@ -333,16 +333,16 @@ func TestTransition_RoundChangeState_CatchupRound(t *testing.T) {
 	})
 	m.Close()
 
-	// as soon as it starts it will move to round 1 because it has
-	// not processed all the messages yet.
-	// After it receives 3 Round change messages higher than his own
-	// round it will change round again and move to accept
+	// as soon as it starts it will move to round 2 because there are
+	// F+1 round change messages for it in the queue.
+	// After it receives 3 Round change messages for its round
+	// it will move to accept
 	m.runCycle()
 
 	m.expect(expectResult{
 		sequence: 1,
 		round:    2,
-		outgoing: 2, // our new round change
+		outgoing: 1, // our new round change
 		state:    AcceptState,
 	})
 }
@@ -396,11 +396,82 @@ func TestTransition_RoundChangeState_WeakCertificate(t *testing.T) {
 	m.expect(expectResult{
 		sequence: 1,
 		round:    2,
-		outgoing: 2, // two round change messages (0->1, 1->2 after weak certificate)
+		outgoing: 1, // one round change message (0->2 with the fast path)
 		state:    RoundChangeState,
 	})
 }
 
+func TestTransition_AcceptState_RoundChangeFastPath(t *testing.T) {
+	m := newMockIbft(t, []string{"A", "B", "C", "D", "E", "F", "G"}, "B")
+	m.setState(AcceptState)
+
+	// F+1 validators moved to the rounds 2 and 3
+	m.emitMsg(&proto.MessageReq{
+		From: "C",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 2),
+	})
+	m.emitMsg(&proto.MessageReq{
+		From: "D",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 3),
+	})
+	m.emitMsg(&proto.MessageReq{
+		From: "E",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 2),
+	})
+	m.Close()
+
+	// the node doesn't wait for the preprepare message until the timeout
+	m.runCycle()
+
+	m.expect(expectResult{
+		sequence: 1,
+		state:    RoundChangeState,
+	})
+
+	// and moves to the highest round of the F+1 validators
+	m.runCycle()
+
+	m.expect(expectResult{
+		sequence: 1,
+		round:    2,
+		outgoing: 1, // our round change
+		state:    RoundChangeState,
+	})
+}
+
+func TestTransition_AcceptState_RoundChangeFastPath_NotEnough(t *testing.T) {
+	m := newMockIbft(t, []string{"A", "B", "C", "D", "E", "F", "G"}, "B")
+	m.setState(AcceptState)
+
+	// the round change messages of F validators are not enough
+	m.emitMsg(&proto.MessageReq{
+		From: "C",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 2),
+	})
+	m.emitMsg(&proto.MessageReq{
+		From: "D",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 3),
+	})
+	m.emitMsg(&proto.MessageReq{
+		From: "D",
+		Type: proto.MessageReq_RoundChange,
+		View: proto.ViewMsg(1, 4),
+	})
+	m.Close()
+
+	m.runCycle()
+
+	m.expect(expectResult{
+		sequence: 1,
+		state:    AcceptState,
+	})
+}
+
 func TestTransition_RoundChangeState_ErrStartNewRound(t *testing.T) {
 	// if we start a round change because there was an error we start
 	// a new round right away
@@ -594,7 +665,7 @@ func TestWriteTransactions(t *testing.T) {
 			m.txpool = mockTxPool
 			mockTransition := setupMockTransition(test, mockTxPool)
 
-			included := m.writeTransactions(1000, mockTransition)
+			included := m.writeTransactions(0, 1000, mockTransition)
 
 			assert.Equal(t, uint64(test.params.expectedTxPoolLength), m.txpool.Length())
 			assert.Equal(t, test.params.expectedFailReceiptsWritten, len(mockTransition.failReceiptsWritten))
@@ -716,10 +787,19 @@ type mockTxPool struct {
 	resetWithHeadersParam []*types.Header
 }
 
-func (p *mockTxPool) Prepare() {
+func (p *mockTxPool) Prepare(baseFee uint64) {
 
 }
 
+func (p *mockTxPool) PendingGas() uint64 {
+	gas := uint64(0)
+	for _, tx := range p.transactions {
+		gas += tx.Gas
+	}
+
+	return gas
+}
+
 func (p *mockTxPool) Length() uint64 {
 	return uint64(len(p.transactions) + len(p.demoted))
 }
@@ -869,6 +949,10 @@ func (m *mockIbft) CalculateGasLimit(number uint64) (uint64, error) {
 	return m.blockchain.CalculateGasLimit(number)
 }
 
+func (m *mockIbft) CalculateBaseFee(parent *types.Header) uint64 {
+	return m.blockchain.CalculateBaseFee(parent)
+}
+
 func newMockIbft(t *testing.T, accounts []string, account string) *mockIbft {
 	t.Helper()
 
@@ -905,6 +989,7 @@ func newMockIbft(t *testing.T, accounts []string, account string) *mockIbft {
 		state:            newState(),
 		epochSize:        DefaultEpochSize,
 		metrics:          consensus.NilMetrics(),
+		clock:            systemClock{},
 	}
 
 	initIbftMechanism(PoA, ibft)
@@ -0,0 +1,295 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// defaultStatsRange is the number of blocks of the validator stats, when the range start is not set
+	defaultStatsRange = 100
+
+	// maxStatsRange is the maximum number of blocks of the validator stats
+	maxStatsRange = 10000
+
+	// maxSealSigners is the number of verified headers whose committed seal signers are kept
+	maxSealSigners = 256
+)
+
+var (
+	errGenesisLiveness   = errors.New("the genesis block has no proposer")
+	errInvalidStatsRange = errors.New("invalid block range")
+)
+
+// blockLiveness is the participation of the validators in a block
+type blockLiveness struct {
+	proposer        types.Address   // Validator that proposed the block
+	missedProposals []types.Address // Proposers of the earlier rounds of the block, which failed
+	committedSeals  []types.Address // Validators with a committed seal in the block
+	missedSeals     []types.Address // Validators without a committed seal in the block
+}
+
+// sealSignersCache keeps the signers recovered from the committed seals of the verified headers,
+// so they aren't recovered again for the liveness of the written blocks
+type sealSignersCache struct {
+	lock    sync.Mutex
+	signers map[types.Hash]map[types.Address]struct{}
+}
+
+// add keeps the signers of the committed seals of the header
+func (c *sealSignersCache) add(hash types.Hash, signers map[types.Address]struct{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// the headers that failed to be written are never taken, so the cache is dropped once full
+	if c.signers == nil || len(c.signers) >= maxSealSigners {
+		c.signers = map[types.Hash]map[types.Address]struct{}{}
+	}
+
+	c.signers[hash] = signers
+}
+
+// take returns the signers of the committed seals of the header, and removes them from the cache
+func (c *sealSignersCache) take(hash types.Hash) (map[types.Address]struct{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	signers, ok := c.signers[hash]
+	delete(c.signers, hash)
+
+	return signers, ok
+}
+
+// getBlockLiveness returns the participation of the validators of the parent snapshot in the block.
+// The signers of the committed seals are recovered from the header, unless they are given
+func (i *Ibft) getBlockLiveness(header *types.Header, signers map[types.Address]struct{}) (*blockLiveness, error) {
+	if header.Number == 0 {
+		return nil, errGenesisLiveness
+	}
+
+	parent, ok := i.blockchain.GetHeaderByNumber(header.Number - 1)
+	if !ok {
+		return nil, fmt.Errorf("unable to get parent header for block number %d", header.Number)
+	}
+
+	snap, err := i.getSnapshot(parent.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return nil, fmt.Errorf("snapshot not found for block number %d", parent.Number)
+	}
+
+	proposer, err := ecrecoverFromHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if !snap.Set.Includes(proposer) {
+		return nil, fmt.Errorf("proposer %s of block %d is not a validator", proposer, header.Number)
+	}
+
+	var lastProposer types.Address
+	if parent.Number != 0 {
+		if lastProposer, err = ecrecoverFromHeader(parent); err != nil {
+			return nil, err
+		}
+	}
+
+	liveness := &blockLiveness{
+		proposer:        proposer,
+		missedProposals: missedProposals(snap.Set, lastProposer, proposer),
+	}
+
+	if signers == nil {
+		if signers, err = i.committedSealSigners(snap, header); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, validator := range snap.Set {
+		if _, ok := signers[validator]; ok {
+			liveness.committedSeals = append(liveness.committedSeals, validator)
+		} else {
+			liveness.missedSeals = append(liveness.missedSeals, validator)
+		}
+	}
+
+	return liveness, nil
+}
+
+// missedProposals returns the validators that missed their turn to propose the block,
+// in the rounds before the one of its proposer
+func missedProposals(set ValidatorSet, lastProposer, proposer types.Address) []types.Address {
+	var missed []types.Address
+
+	// Since the proposers rotate, only the first rounds of the validator set size are known
+	for round := uint64(0); round < uint64(set.Len()); round++ {
+		expected := set.CalcProposer(round, lastProposer)
+		if expected == proposer {
+			break
+		}
+
+		missed = append(missed, expected)
+	}
+
+	return missed
+}
+
+// committedSealSigners returns the validators that signed the committed seals of the block,
+// from the signer bitmap in the BLS mode
+func (i *Ibft) committedSealSigners(snap *Snapshot, header *types.Header) (map[types.Address]struct{}, error) {
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := map[types.Address]struct{}{}
+
+	if i.isBLSActive(header.Number) {
+		if extra.AggregatedSeal == nil {
+			return nil, ErrMissingAggregatedSeal
+		}
+
+		bitmap := extra.AggregatedSeal.Bitmap
+
+		for indx := 0; indx < len(bitmap)*8 && indx < len(snap.Set); indx++ {
+			if bitmap[indx/8]&(1<<(indx%8)) != 0 {
+				signers[snap.Set[indx]] = struct{}{}
+			}
+		}
+
+		return signers, nil
+	}
+
+	hash, err := calculateHeaderHash(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMsg := finality.CommitMsg(hash)
+
+	for _, seal := range extra.CommittedSeal {
+		addr, err := ecrecoverImpl(seal, rawMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		signers[addr] = struct{}{}
+	}
+
+	return signers, nil
+}
+
+// updateLivenessMetrics counts the proposals and committed seals of the validators in the block,
+// with the signers of the committed seals kept when the header was verified
+func (i *Ibft) updateLivenessMetrics(header *types.Header) {
+	signers, _ := i.sealSigners.take(header.Hash)
+
+	liveness, err := i.getBlockLiveness(header, signers)
+	if err != nil {
+		i.logger.Debug("unable to get the validator liveness", "block", header.Number, "err", err)
+
+		return
+	}
+
+	i.metrics.ValidatorProposals.With("validator", liveness.proposer.String()).Add(1)
+
+	for _, validator := range liveness.missedProposals {
+		i.metrics.ValidatorMissedProposals.With("validator", validator.String()).Add(1)
+	}
+
+	for _, validator := range liveness.committedSeals {
+		i.metrics.ValidatorCommittedSeals.With("validator", validator.String()).Add(1)
+	}
+
+	for _, validator := range liveness.missedSeals {
+		i.metrics.ValidatorMissedSeals.With("validator", validator.String()).Add(1)
+	}
+}
+
+// getValidatorStats sums up the participation of the validators in the blocks of the range
+func (i *Ibft) getValidatorStats(from, to uint64) ([]*proto.ValidatorStats, error) {
+	stats := map[types.Address]*proto.ValidatorStats{}
+
+	get := func(validator types.Address) *proto.ValidatorStats {
+		s, ok := stats[validator]
+		if !ok {
+			s = &proto.ValidatorStats{
+				Address: validator.String(),
+			}
+			stats[validator] = s
+		}
+
+		return s
+	}
+
+	for number := from; number <= to; number++ {
+		header, ok := i.blockchain.GetHeaderByNumber(number)
+		if !ok {
+			return nil, fmt.Errorf("header %d not found", number)
+		}
+
+		liveness, err := i.getBlockLiveness(header, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		get(liveness.proposer).Proposals++
+
+		for _, validator := range liveness.missedProposals {
+			get(validator).MissedProposals++
+		}
+
+		for _, validator := range liveness.committedSeals {
+			get(validator).CommittedSeals++
+		}
+
+		for _, validator := range liveness.missedSeals {
+			get(validator).MissedSeals++
+		}
+	}
+
+	result := make([]*proto.ValidatorStats, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Address < result[j].Address
+	})
+
+	return result, nil
+}
+
+// statsRange returns the block range of the validator stats request,
+// which defaults to the latest blocks
+func statsRange(from, to, latest uint64) (uint64, uint64, error) {
+	if to == 0 || to > latest {
+		to = latest
+	}
+
+	if from == 0 {
+		from = 1
+		if to > defaultStatsRange {
+			from = to - defaultStatsRange + 1
+		}
+	}
+
+	if to == 0 || from > to {
+		return 0, 0, fmt.Errorf("%w: %d-%d", errInvalidStatsRange, from, to)
+	}
+
+	if to-from+1 > maxStatsRange {
+		return 0, 0, fmt.Errorf("%w: at most %d blocks", errInvalidStatsRange, maxStatsRange)
+	}
+
+	return from, to, nil
+}
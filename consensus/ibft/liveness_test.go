@@ -0,0 +1,274 @@
+package ibft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildCommittedHeader builds a child of the parent header, sealed by the proposer
+// and committed by the signers
+func buildCommittedHeader(
+	t *testing.T,
+	pool *testerAccountPool,
+	parent *types.Header,
+	proposer string,
+	signers []string,
+) *types.Header {
+	t.Helper()
+
+	h := &types.Header{
+		Number:     parent.Number + 1,
+		ParentHash: parent.Hash,
+		MixHash:    IstanbulDigest,
+		Nonce:      nonceDropVote,
+	}
+	putIbftExtraValidators(h, pool.ValidatorSet())
+
+	h = pool.get(proposer).sign(h)
+
+	seals := make([][]byte, 0, len(signers))
+
+	for _, signer := range signers {
+		seal, err := writeCommittedSeal(pool.get(signer).priv, h)
+		assert.NoError(t, err)
+
+		seals = append(seals, seal)
+	}
+
+	h, err := writeCommittedSeals(h, seals)
+	assert.NoError(t, err)
+
+	h.ComputeHash()
+
+	return h
+}
+
+// aliasOf returns the alias of the account with the address
+func aliasOf(pool *testerAccountPool, addr types.Address) string {
+	for _, account := range pool.accounts {
+		if account.Address() == addr {
+			return account.alias
+		}
+	}
+
+	return ""
+}
+
+// newLivenessIbft returns an IBFT engine with two blocks of the four validators of the pool.
+// The first block is proposed in the second round, and misses the committed seal of one validator
+func newLivenessIbft(t *testing.T, pool *testerAccountPool) (*Ibft, []*types.Header) {
+	t.Helper()
+
+	genesis := pool.genesis()
+	chain := blockchain.TestBlockchain(t, genesis)
+	set := pool.ValidatorSet()
+
+	parent := chain.Header()
+	proposer1 := aliasOf(pool, set.CalcProposer(1, types.ZeroAddress))
+	header1 := buildCommittedHeader(t, pool, parent, proposer1, []string{"A", "B", "C"})
+
+	proposer2 := aliasOf(pool, set.CalcProposer(0, pool.get(proposer1).Address()))
+	header2 := buildCommittedHeader(t, pool, header1, proposer2, []string{"A", "B", "C", "D"})
+
+	headers := []*types.Header{header1, header2}
+	assert.NoError(t, chain.WriteHeaders(headers))
+
+	ibft := &Ibft{
+		blockchain: chain,
+		config:     &consensus.Config{},
+		epochSize:  DefaultEpochSize,
+		logger:     hclog.NewNullLogger(),
+	}
+
+	initIbftMechanism(PoA, ibft)
+
+	// the snapshots of the written headers are built on setup
+	assert.NoError(t, ibft.setupSnapshot())
+
+	return ibft, headers
+}
+
+func TestLiveness_GetBlockLiveness(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, headers := newLivenessIbft(t, pool)
+	set := pool.ValidatorSet()
+
+	// the proposer of the first round missed its turn
+	liveness, err := ibft.getBlockLiveness(headers[0], nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, set.CalcProposer(1, types.ZeroAddress), liveness.proposer)
+	assert.Equal(t, []types.Address{set.CalcProposer(0, types.ZeroAddress)}, liveness.missedProposals)
+	assert.ElementsMatch(t, []types.Address{
+		pool.get("A").Address(),
+		pool.get("B").Address(),
+		pool.get("C").Address(),
+	}, liveness.committedSeals)
+	assert.Equal(t, []types.Address{pool.get("D").Address()}, liveness.missedSeals)
+
+	// the block of the first round has every committed seal
+	liveness, err = ibft.getBlockLiveness(headers[1], nil)
+	assert.NoError(t, err)
+
+	assert.Empty(t, liveness.missedProposals)
+	assert.Len(t, liveness.committedSeals, 4)
+	assert.Empty(t, liveness.missedSeals)
+
+	// the genesis has no proposer
+	genesis, ok := ibft.blockchain.GetHeaderByNumber(0)
+	assert.True(t, ok)
+
+	_, err = ibft.getBlockLiveness(genesis, nil)
+	assert.ErrorIs(t, err, errGenesisLiveness)
+}
+
+func TestLiveness_VerifiedSealSigners(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, headers := newLivenessIbft(t, pool)
+
+	// the signers kept on verification are used instead of the committed seals
+	ibft.sealSigners.add(headers[0].Hash, map[types.Address]struct{}{
+		pool.get("A").Address(): {},
+	})
+
+	signers, ok := ibft.sealSigners.take(headers[0].Hash)
+	assert.True(t, ok)
+
+	liveness, err := ibft.getBlockLiveness(headers[0], signers)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []types.Address{pool.get("A").Address()}, liveness.committedSeals)
+	assert.Len(t, liveness.missedSeals, 3)
+
+	// the signers are only taken once
+	_, ok = ibft.sealSigners.take(headers[0].Hash)
+	assert.False(t, ok)
+
+	// the cache is dropped once full
+	for n := 0; n <= maxSealSigners; n++ {
+		ibft.sealSigners.add(types.BytesToHash([]byte{byte(n >> 8), byte(n)}), nil)
+	}
+
+	assert.Len(t, ibft.sealSigners.signers, 1)
+}
+
+func TestLiveness_StatsRange(t *testing.T) {
+	cases := []struct {
+		name     string
+		from     uint64
+		to       uint64
+		latest   uint64
+		expected [2]uint64
+		err      bool
+	}{
+		{
+			name:     "defaults to the latest blocks",
+			latest:   250,
+			expected: [2]uint64{151, 250},
+		},
+		{
+			name:     "defaults to the whole chain when it is short",
+			latest:   20,
+			expected: [2]uint64{1, 20},
+		},
+		{
+			name:     "the end is capped to the latest block",
+			from:     10,
+			to:       500,
+			latest:   250,
+			expected: [2]uint64{10, 250},
+		},
+		{
+			name:     "the range ends before the latest block",
+			from:     10,
+			to:       20,
+			latest:   250,
+			expected: [2]uint64{10, 20},
+		},
+		{
+			name:   "the range starts after its end",
+			from:   30,
+			to:     20,
+			latest: 250,
+			err:    true,
+		},
+		{
+			name: "the chain has no blocks",
+			err:  true,
+		},
+		{
+			name:   "the range is too large",
+			from:   1,
+			latest: maxStatsRange + 1,
+			err:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			from, to, err := statsRange(c.from, c.to, c.latest)
+
+			if c.err {
+				assert.ErrorIs(t, err, errInvalidStatsRange)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, [2]uint64{from, to})
+		})
+	}
+}
+
+func TestOperator_Stats(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, _ := newLivenessIbft(t, pool)
+	set := pool.ValidatorSet()
+
+	o := &operator{ibft: ibft}
+
+	resp, err := o.Stats(context.Background(), &proto.StatsReq{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(1), resp.From)
+	assert.Equal(t, uint64(2), resp.To)
+	assert.Len(t, resp.Validators, 4)
+
+	stats := map[string]*proto.ValidatorStats{}
+
+	var proposals, missedProposals, committedSeals, missedSeals uint64
+
+	for _, s := range resp.Validators {
+		stats[s.Address] = s
+
+		proposals += s.Proposals
+		missedProposals += s.MissedProposals
+		committedSeals += s.CommittedSeals
+		missedSeals += s.MissedSeals
+	}
+
+	assert.Equal(t, uint64(2), proposals)
+	assert.Equal(t, uint64(1), missedProposals)
+	assert.Equal(t, uint64(7), committedSeals)
+	assert.Equal(t, uint64(1), missedSeals)
+
+	assert.Equal(t, uint64(1), stats[set.CalcProposer(0, types.ZeroAddress).String()].MissedProposals)
+	assert.Equal(t, uint64(1), stats[pool.get("D").Address().String()].MissedSeals)
+
+	// the range cannot start after the latest block
+	_, err = o.Stats(context.Background(), &proto.StatsReq{From: 3})
+	assert.ErrorIs(t, err, errInvalidStatsRange)
+}
@@ -2,9 +2,13 @@ package ibft
 
 import (
 	"container/heap"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 // msgQueue defines the structure that holds message queues for different IBFT states
@@ -75,6 +79,235 @@ func (m *msgQueue) readMessage(state IbftState, current *proto.View) *msgTask {
 	}
 }
 
+// highestRoundChange returns the highest round above the current one in the current sequence,
+// for which at least minSenders validators sent a round change message for that round or a higher one
+func (m *msgQueue) highestRoundChange(
+	current *proto.View,
+	validators ValidatorSet,
+	minSenders int,
+) (uint64, bool) {
+	m.queueLock.Lock()
+	defer m.queueLock.Unlock()
+
+	// the highest round of each sender
+	senderRounds := map[types.Address]uint64{}
+
+	for _, task := range m.roundChangeStateQueue {
+		if task.view.Sequence != current.Sequence || task.view.Round <= current.Round {
+			continue
+		}
+
+		from := task.obj.FromAddr()
+		if !validators.Includes(from) {
+			continue
+		}
+
+		if task.view.Round > senderRounds[from] {
+			senderRounds[from] = task.view.Round
+		}
+	}
+
+	if minSenders <= 0 || len(senderRounds) < minSenders {
+		return 0, false
+	}
+
+	rounds := make([]uint64, 0, len(senderRounds))
+	for _, round := range senderRounds {
+		rounds = append(rounds, round)
+	}
+
+	sort.Slice(rounds, func(i, j int) bool {
+		return rounds[i] > rounds[j]
+	})
+
+	return rounds[minSenders-1], true
+}
+
+var (
+	errInvalidPreparedCertificate    = errors.New("invalid prepared certificate")
+	errInvalidRoundChangeCertificate = errors.New("invalid round change certificate")
+)
+
+// verifyRoundChangeMsg checks the prepared certificate of a round change message,
+// which has to prove that a quorum prepared its digest in an earlier round
+func verifyRoundChangeMsg(msg *proto.MessageReq, validators ValidatorSet, quorum int) error {
+	if msg.Digest == "" {
+		// nothing was prepared
+		return nil
+	}
+
+	if msg.PreparedRound >= msg.View.Round {
+		return fmt.Errorf(
+			"%w: prepared in round %d, not before round %d",
+			errInvalidPreparedCertificate,
+			msg.PreparedRound,
+			msg.View.Round,
+		)
+	}
+
+	certificate := msg.PreparedCertificate
+	if certificate == nil || certificate.Proposal == nil {
+		return fmt.Errorf("%w: missing prepared block", errInvalidPreparedCertificate)
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(certificate.Proposal.Value); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPreparedCertificate, err)
+	}
+
+	if block.Number() != msg.View.Sequence || block.Hash().String() != msg.Digest {
+		return fmt.Errorf("%w: the prepared block doesn't match the digest", errInvalidPreparedCertificate)
+	}
+
+	if err := verifyPrepareMessages(
+		certificate.PrepareMessages,
+		proto.ViewMsg(msg.View.Sequence, msg.PreparedRound),
+		msg.Digest,
+		validators,
+		quorum,
+	); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPreparedCertificate, err)
+	}
+
+	return nil
+}
+
+// verifyProposalJustification checks the round change certificate of a preprepare message.
+// The proposal of a round > 0 is justified by the round change messages of a quorum,
+// and it has to be the block prepared in the highest round among them, if any
+func verifyProposalJustification(msg *proto.MessageReq, validators ValidatorSet, quorum int) error {
+	if msg.View.Round == 0 {
+		// the first round doesn't need a justification
+		return nil
+	}
+
+	certificate := msg.RoundChangeCertificate
+	if certificate == nil {
+		return fmt.Errorf("%w: missing round change messages", errInvalidRoundChangeCertificate)
+	}
+
+	senders := map[types.Address]struct{}{}
+
+	for _, roundChange := range certificate.RoundChangeMessages {
+		if roundChange.Type != proto.MessageReq_RoundChange ||
+			roundChange.View == nil ||
+			cmpView(roundChange.View, msg.View) != 0 {
+			return fmt.Errorf("%w: unexpected message", errInvalidRoundChangeCertificate)
+		}
+
+		if roundChange.Digest != "" && roundChange.PreparedRound >= roundChange.View.Round {
+			return fmt.Errorf("%w: prepared in a later round", errInvalidRoundChangeCertificate)
+		}
+
+		from, err := recoverSender(roundChange, validators)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidRoundChangeCertificate, err)
+		}
+
+		senders[from] = struct{}{}
+	}
+
+	if len(senders) < quorum {
+		return fmt.Errorf(
+			"%w: %d round change messages, expected at least %d",
+			errInvalidRoundChangeCertificate,
+			len(senders),
+			quorum,
+		)
+	}
+
+	highest := highestPrepared(certificate.RoundChangeMessages)
+	if highest == nil {
+		// nothing was prepared, any block can be proposed
+		return nil
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(msg.Proposal.GetValue()); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidRoundChangeCertificate, err)
+	}
+
+	if block.Hash().String() != highest.Digest {
+		return fmt.Errorf("%w: the proposal isn't the highest prepared block", errInvalidRoundChangeCertificate)
+	}
+
+	if err := verifyPrepareMessages(
+		certificate.PrepareMessages,
+		proto.ViewMsg(msg.View.Sequence, highest.PreparedRound),
+		highest.Digest,
+		validators,
+		quorum,
+	); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidRoundChangeCertificate, err)
+	}
+
+	return nil
+}
+
+// verifyPrepareMessages checks that a quorum of validators sent prepare messages for the digest in the view
+func verifyPrepareMessages(
+	msgs []*proto.MessageReq,
+	view *proto.View,
+	digest string,
+	validators ValidatorSet,
+	quorum int,
+) error {
+	senders := map[types.Address]struct{}{}
+
+	for _, prepare := range msgs {
+		if prepare.Type != proto.MessageReq_Prepare ||
+			prepare.View == nil ||
+			cmpView(prepare.View, view) != 0 ||
+			prepare.Digest != digest {
+			return fmt.Errorf("unexpected prepare message")
+		}
+
+		from, err := recoverSender(prepare, validators)
+		if err != nil {
+			return err
+		}
+
+		senders[from] = struct{}{}
+	}
+
+	if len(senders) < quorum {
+		return fmt.Errorf("%d prepare messages, expected at least %d", len(senders), quorum)
+	}
+
+	return nil
+}
+
+// recoverSender recovers the sender of a message of a certificate, which has to be a validator
+func recoverSender(msg *proto.MessageReq, validators ValidatorSet) (types.Address, error) {
+	if err := validateMsg(msg); err != nil {
+		return types.ZeroAddress, err
+	}
+
+	from := msg.FromAddr()
+	if !validators.Includes(from) {
+		return types.ZeroAddress, fmt.Errorf("message from a non validator %s", from)
+	}
+
+	return from, nil
+}
+
+// highestPrepared returns the round change message with the block prepared in the highest round, if any
+func highestPrepared(roundChanges []*proto.MessageReq) *proto.MessageReq {
+	var highest *proto.MessageReq
+
+	for _, msg := range roundChanges {
+		if msg.Digest == "" {
+			continue
+		}
+
+		if highest == nil || msg.PreparedRound > highest.PreparedRound {
+			highest = msg
+		}
+	}
+
+	return highest
+}
+
 // getQueue checks the passed in state, and returns the corresponding message queue
 func (m *msgQueue) getQueue(state IbftState) *msgQueueImpl {
 	if state == RoundChangeState {
@@ -77,3 +77,120 @@ func TestCmpView(t *testing.T) {
 		assert.Equal(t, cmpView(c.v, c.y), c.res)
 	}
 }
+
+func TestMsgQueue_HighestRoundChange(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D", "X")
+
+	validators := ValidatorSet{
+		pool.get("A").Address(),
+		pool.get("B").Address(),
+		pool.get("C").Address(),
+		pool.get("D").Address(),
+	}
+
+	m := newMsgQueue()
+	push := func(account string, view *proto.View) {
+		m.pushMessage(mockQueueMsg(pool.get(account).Address().String(), msgRoundChange, view))
+	}
+
+	current := proto.ViewMsg(2, 1)
+
+	// old, current and future sequences are ignored, as well as non validators
+	push("A", proto.ViewMsg(1, 5))
+	push("B", proto.ViewMsg(2, 1))
+	push("C", proto.ViewMsg(3, 5))
+	push("X", proto.ViewMsg(2, 5))
+
+	_, ok := m.highestRoundChange(current, validators, 1)
+	assert.False(t, ok)
+
+	// the rounds of the senders support the lower rounds
+	push("A", proto.ViewMsg(2, 5))
+	push("A", proto.ViewMsg(2, 7))
+	push("B", proto.ViewMsg(2, 3))
+
+	round, ok := m.highestRoundChange(current, validators, 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(7), round)
+
+	round, ok = m.highestRoundChange(current, validators, 2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), round)
+
+	_, ok = m.highestRoundChange(current, validators, 3)
+	assert.False(t, ok)
+
+	// the messages are not consumed
+	assert.Equal(t, 7, m.roundChangeStateQueue.Len())
+}
+
+func TestVerifyRoundChangeMsg(t *testing.T) {
+	h := newMsgHarness(t, []string{"A", "B", "C", "D"}, "A")
+	quorum := OptimalQuorumSize(h.state.validators)
+
+	x := h.block("A", 1)
+	prepares := h.prepares(proto.ViewMsg(1, 0), x, "A", "B", "C")
+
+	cases := []struct {
+		name  string
+		msg   *proto.MessageReq
+		valid bool
+	}{
+		{
+			"nothing prepared",
+			h.roundChange("B", proto.ViewMsg(1, 1), nil, 0, nil),
+			true,
+		},
+		{
+			"prepared by a quorum",
+			h.roundChange("B", proto.ViewMsg(1, 1), x, 0, prepares),
+			true,
+		},
+		{
+			"prepared in the same round",
+			h.roundChange("B", proto.ViewMsg(1, 1), x, 1, h.prepares(proto.ViewMsg(1, 1), x, "A", "B", "C")),
+			false,
+		},
+		{
+			"prepare messages of another round",
+			h.roundChange("B", proto.ViewMsg(1, 2), x, 1, prepares),
+			false,
+		},
+		{
+			"prepare messages of another block",
+			h.roundChange("B", proto.ViewMsg(1, 1), h.block("A", 2), 0, prepares),
+			false,
+		},
+		{
+			"prepare messages below the quorum",
+			h.roundChange("B", proto.ViewMsg(1, 1), x, 0, prepares[:2]),
+			false,
+		},
+		{
+			"prepare messages of a non validator",
+			h.roundChange("B", proto.ViewMsg(1, 1), x, 0, append(prepares[:2:2], func() *proto.MessageReq {
+				h.pool.add("X")
+
+				return h.prepares(proto.ViewMsg(1, 0), x, "X")[0]
+			}())),
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := verifyRoundChangeMsg(c.msg, h.state.validators, quorum)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errInvalidPreparedCertificate)
+			}
+		})
+	}
+
+	// the prepared block is required
+	msg := h.roundChange("B", proto.ViewMsg(1, 1), x, 0, prepares)
+	msg.PreparedCertificate.Proposal = nil
+	assert.ErrorIs(t, verifyRoundChangeMsg(msg, h.state.validators, quorum), errInvalidPreparedCertificate)
+}
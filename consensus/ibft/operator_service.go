@@ -106,6 +106,11 @@ func (o *operator) Propose(ctx context.Context, req *proto.Candidate) (*empty.Em
 		return nil, err
 	}
 
+	// the vote wouldn't be cast in the next blocks
+	if o.ibft.isGovernanceActive(o.ibft.blockchain.Header().Number + 1) {
+		return nil, errGovernanceProposal
+	}
+
 	// check if the candidate is already there
 	o.candidatesLock.Lock()
 	defer o.candidatesLock.Unlock()
@@ -159,3 +164,65 @@ func (o *operator) Candidates(ctx context.Context, req *empty.Empty) (*proto.Can
 
 	return resp, nil
 }
+
+// Stats returns the proposals and committed seals of the validators in a block range
+func (o *operator) Stats(ctx context.Context, req *proto.StatsReq) (*proto.StatsResp, error) {
+	from, to, err := statsRange(req.From, req.To, o.ibft.blockchain.Header().Number)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := o.ibft.getValidatorStats(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.StatsResp{
+		From:       from,
+		To:         to,
+		Validators: validators,
+	}, nil
+}
+
+// GetBlockProof returns the proof of the committed seals of a block, the latest one if not set
+func (o *operator) GetBlockProof(ctx context.Context, req *proto.BlockProofReq) (*proto.BlockProof, error) {
+	number := req.Number
+	if number == 0 {
+		number = o.ibft.blockchain.Header().Number
+	}
+
+	proof, err := o.ibft.GetBlockProof(number)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.BlockProof{
+		Header:           proof.Header.MarshalRLP(),
+		Hash:             proof.Hash.String(),
+		Validators:       make([]string, len(proof.Validators)),
+		CommittedSeals:   proof.CommittedSeals,
+		ValidatorBLSKeys: proof.ValidatorBLSKeys,
+		Quorum:           proof.Quorum,
+	}
+
+	for indx, addr := range proof.Validators {
+		resp.Validators[indx] = addr.String()
+	}
+
+	if proof.AggregatedSeal != nil {
+		resp.SignerBitmap = proof.AggregatedSeal.Bitmap
+		resp.AggregatedSeal = proof.AggregatedSeal.Signature
+	}
+
+	return resp, nil
+}
+
+// ReportEquivocation adds the equivocation of a validator to the evidence
+// that the node reports in the blocks it proposes
+func (o *operator) ReportEquivocation(ctx context.Context, req *proto.EquivocationReq) (*empty.Empty, error) {
+	if err := o.ibft.reportEquivocation(req.First, req.Second); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
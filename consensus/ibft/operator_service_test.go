@@ -7,7 +7,9 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -125,3 +127,136 @@ func TestOperator_Propose(t *testing.T) {
 	})
 	assert.Error(t, err)
 }
+
+func TestOperator_ProposeWithGovernance(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C")
+
+	cases := []struct {
+		name string
+		from uint64
+		err  error
+	}{
+		{
+			name: "votes before the governance fork",
+			from: 2,
+		},
+		{
+			name: "rejects the votes once the governance contract manages the validators",
+			from: 1,
+			err:  errGovernanceProposal,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ibft := &Ibft{
+				blockchain: blockchain.TestBlockchain(t, pool.genesis()),
+				config:     &consensus.Config{},
+				epochSize:  DefaultEpochSize,
+			}
+			assert.NoError(t, ibft.setupSnapshot())
+
+			gov, err := GovernanceFactory(ibft, &IBFTFork{
+				Type:       PoAGovernance,
+				From:       common.JSONNumber{Value: c.from},
+				Deployment: &common.JSONNumber{Value: 1},
+			})
+			assert.NoError(t, err)
+
+			ibft.mechanisms = []ConsensusMechanism{gov}
+
+			o := &operator{ibft: ibft}
+
+			pool.add("X")
+
+			_, err = o.Propose(context.Background(), &proto.Candidate{
+				Address: pool.get("X").Address().String(),
+				Auth:    true,
+			})
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Empty(t, o.candidates)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, o.candidates, 1)
+		})
+	}
+}
+
+func TestOperator_GetBlockProof(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, headers := newLivenessIbft(t, pool)
+
+	o := &operator{ibft: ibft}
+
+	// the proof of the latest block is returned if the number isn't set
+	resp, err := o.GetBlockProof(context.Background(), &proto.BlockProofReq{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, istanbulHeaderHash(headers[1]).String(), resp.Hash)
+	assert.Len(t, resp.Validators, 4)
+	assert.Len(t, resp.CommittedSeals, 4)
+	assert.Equal(t, uint64(3), resp.Quorum)
+
+	header := &types.Header{}
+	assert.NoError(t, header.UnmarshalRLP(resp.Header))
+	assert.Equal(t, headers[1].Number, header.Number)
+
+	resp, err = o.GetBlockProof(context.Background(), &proto.BlockProofReq{Number: 1})
+	assert.NoError(t, err)
+	assert.Len(t, resp.CommittedSeals, 3)
+
+	_, err = o.GetBlockProof(context.Background(), &proto.BlockProofReq{Number: 3})
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestOperator_ReportEquivocation(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A")
+
+	keyA := pool.get("A").priv
+
+	o := &operator{ibft: &Ibft{
+		logger:   hclog.NewNullLogger(),
+		evidence: newEvidencePool(),
+	}}
+
+	first := signedMsg(t, keyA, proto.MessageReq_Commit, 2, 0, "digest1")
+	second := signedMsg(t, keyA, proto.MessageReq_Commit, 2, 0, "digest2")
+
+	// the messages must sign different digests
+	_, err := o.ReportEquivocation(context.Background(), &proto.EquivocationReq{
+		First:  first,
+		Second: first,
+	})
+	assert.ErrorIs(t, err, errInvalidEvidence)
+
+	_, err = o.ReportEquivocation(context.Background(), &proto.EquivocationReq{
+		First: first,
+	})
+	assert.ErrorIs(t, err, errInvalidEvidence)
+
+	_, err = o.ReportEquivocation(context.Background(), &proto.EquivocationReq{
+		First:  first,
+		Second: second,
+	})
+	assert.NoError(t, err)
+
+	if list := o.ibft.evidence.list(); assert.Len(t, list, 1) {
+		assert.Equal(t, pool.get("A").Address(), list[0].offender())
+	}
+
+	// the equivocation is reported once
+	_, err = o.ReportEquivocation(context.Background(), &proto.EquivocationReq{
+		First:  second,
+		Second: first,
+	})
+	assert.ErrorIs(t, err, errInvalidEvidence)
+}
@@ -0,0 +1,210 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// pacingPollInterval is the interval at which the proposer checks the pending transactions,
+// while it waits to seal a block following the block pacing of the fork
+const pacingPollInterval = 100 * time.Millisecond
+
+var (
+	ErrInvalidMaxEmptyBlockInterval = errors.New("max empty block interval must be at least 1s")
+	ErrInvalidTargetGas             = errors.New("target gas must be greater than 0")
+	ErrInvalidMinBlockTime          = errors.New("min block time must be at most the block time")
+	errEmptyBlockTooEarly           = errors.New("empty block sealed before the max empty block interval")
+	errBlockTooEarly                = errors.New("block sealed before the min block time")
+)
+
+// AdaptiveBlockTime lets the proposer seal a block before the block time,
+// as soon as the gas of the pending transactions reaches the target gas
+type AdaptiveBlockTime struct {
+	MinBlockTime common.JSONDuration `json:"minBlockTime"`
+	TargetGas    common.JSONNumber   `json:"targetGas"`
+}
+
+// validatePacing checks the block pacing options of the fork against the block time
+func (f *IBFTFork) validatePacing(blockTime time.Duration) error {
+	if f.MaxEmptyBlockInterval != nil && f.MaxEmptyBlockInterval.Value < time.Second {
+		return ErrInvalidMaxEmptyBlockInterval
+	}
+
+	if adaptive := f.AdaptiveBlockTime; adaptive != nil {
+		if adaptive.TargetGas.Value == 0 {
+			return ErrInvalidTargetGas
+		}
+
+		if adaptive.MinBlockTime.Value > blockTime {
+			return ErrInvalidMinBlockTime
+		}
+	}
+
+	return nil
+}
+
+// hasPacing checks if the fork changes when the blocks are sealed
+func (f *IBFTFork) hasPacing() bool {
+	return f.MaxEmptyBlockInterval != nil || f.AdaptiveBlockTime != nil
+}
+
+// validateForkPacing checks the block pacing options of every fork
+func validateForkPacing(forks []IBFTFork, blockTime time.Duration) error {
+	for _, fork := range forks {
+		if err := fork.validatePacing(blockTime); err != nil {
+			return fmt.Errorf("invalid block pacing of the fork from block %d, %w", fork.From.Value, err)
+		}
+	}
+
+	return nil
+}
+
+// getIBFTFork returns the IBFT fork of the block, the latest one if several forks cover it
+func (i *Ibft) getIBFTFork(number uint64) *IBFTFork {
+	var current *IBFTFork
+
+	for idx, fork := range i.ibftForks {
+		if number < fork.From.Value || (fork.To != nil && fork.To.Value < number) {
+			continue
+		}
+
+		if current == nil || fork.From.Value >= current.From.Value {
+			current = &i.ibftForks[idx]
+		}
+	}
+
+	return current
+}
+
+// blockDelay returns the minimum time between the parent and the block.
+// It is shortened to the min block time of the adaptive block time
+// once the pending transactions reach the target gas
+func (i *Ibft) blockDelay(number uint64) time.Duration {
+	fork := i.getIBFTFork(number)
+	if fork != nil && fork.AdaptiveBlockTime != nil &&
+		i.txpool.PendingGas() >= fork.AdaptiveBlockTime.TargetGas.Value {
+		return fork.AdaptiveBlockTime.MinBlockTime.Value
+	}
+
+	return i.blockTime
+}
+
+// isIdle checks if the block would be empty and could be skipped, since nothing is pending.
+// The blocks without transactions from the txpool, like the PoS epoch blocks, are never skipped
+func (i *Ibft) isIdle(number uint64) bool {
+	if !i.shouldWriteTransactions(number) {
+		return false
+	}
+
+	if i.evidence != nil && len(i.evidence.list()) > 0 {
+		return false
+	}
+
+	return i.txpool.PendingGas() == 0
+}
+
+// emptyBlockTime returns the earliest time of an empty block on top of the parent,
+// or the time of the parent if the fork doesn't skip the empty blocks
+func (i *Ibft) emptyBlockTime(parent *types.Header) time.Time {
+	parentTime := time.Unix(int64(parent.Timestamp), 0)
+
+	fork := i.getIBFTFork(parent.Number + 1)
+	if fork == nil || fork.MaxEmptyBlockInterval == nil {
+		return parentTime
+	}
+
+	return parentTime.Add(fork.MaxEmptyBlockInterval.Value)
+}
+
+// waitForBlock waits until the proposer can seal the block on top of the parent,
+// following the block pacing of the fork. The empty blocks are skipped up to the max empty block interval,
+// and the block is sealed after the min block time once the pending transactions reach the target gas
+func (i *Ibft) waitForBlock(parent *types.Header) WaitResult {
+	number := parent.Number + 1
+
+	fork := i.getIBFTFork(number)
+	if fork == nil || !fork.hasPacing() {
+		return WaitExpired
+	}
+
+	parentTime := time.Unix(int64(parent.Timestamp), 0)
+
+	for {
+		sealTime := parentTime.Add(i.blockDelay(number))
+
+		if fork.MaxEmptyBlockInterval != nil && i.isIdle(number) {
+			if emptyTime := i.emptyBlockTime(parent); emptyTime.After(sealTime) {
+				sealTime = emptyTime
+			}
+		}
+
+		now := i.clock.Now()
+		if !now.Before(sealTime) {
+			return WaitExpired
+		}
+
+		// the pending transactions are checked again at the next poll
+		deadline := now.Add(pacingPollInterval)
+		if sealTime.Before(deadline) {
+			deadline = sealTime
+		}
+
+		if i.clock.Wait(deadline, nil, i.closeCh) == WaitDone {
+			return WaitDone
+		}
+	}
+}
+
+// proposalDelay returns how long the proposer may still wait for transactions
+// before proposing an empty block on top of the parent
+func (i *Ibft) proposalDelay(parent *types.Header) time.Duration {
+	if delay := i.emptyBlockTime(parent).Sub(i.clock.Now()); delay > 0 {
+		return delay
+	}
+
+	return 0
+}
+
+// syncBlockTimeout returns how long a block is expected to take on top of the current header
+func (i *Ibft) syncBlockTimeout() time.Duration {
+	fork := i.getIBFTFork(i.blockchain.Header().Number + 1)
+	if fork != nil && fork.MaxEmptyBlockInterval != nil && fork.MaxEmptyBlockInterval.Value > i.blockTime {
+		return fork.MaxEmptyBlockInterval.Value
+	}
+
+	return i.blockTime
+}
+
+// verifyBlockPacing checks the timestamp of the header against the block pacing of the fork.
+// The empty blocks have to wait for the max empty block interval,
+// and every block for the min block time of the adaptive block time
+func (i *Ibft) verifyBlockPacing(parent, header *types.Header) error {
+	fork := i.getIBFTFork(header.Number)
+	if fork == nil || !fork.hasPacing() {
+		return nil
+	}
+
+	var elapsed time.Duration
+	if header.Timestamp > parent.Timestamp {
+		elapsed = time.Duration(header.Timestamp-parent.Timestamp) * time.Second
+	}
+
+	// the timestamps are in seconds
+	if adaptive := fork.AdaptiveBlockTime; adaptive != nil &&
+		elapsed < adaptive.MinBlockTime.Value.Truncate(time.Second) {
+		return errBlockTooEarly
+	}
+
+	if fork.MaxEmptyBlockInterval != nil &&
+		header.TxRoot == types.EmptyRootHash &&
+		i.shouldWriteTransactions(header.Number) &&
+		elapsed < fork.MaxEmptyBlockInterval.Value.Truncate(time.Second) {
+		return errEmptyBlockTooEarly
+	}
+
+	return nil
+}
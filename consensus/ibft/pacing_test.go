@@ -0,0 +1,307 @@
+package ibft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// manualClock is a Clock whose waits expire at once, moving the time to their deadline
+type manualClock struct {
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	return c.now
+}
+
+func (c *manualClock) Wait(deadline time.Time, _, _ <-chan struct{}) WaitResult {
+	if deadline.After(c.now) {
+		c.now = deadline
+	}
+
+	return WaitExpired
+}
+
+// newPacingIbft returns a PoA IBFT engine with a 2s block time and the block pacing of the fork
+func newPacingIbft(t *testing.T, fork IBFTFork) *Ibft {
+	t.Helper()
+
+	ibft := &Ibft{
+		config: &consensus.Config{
+			Config: map[string]interface{}{
+				"types": []interface{}{&fork},
+			},
+		},
+		blockTime: 2 * time.Second,
+		txpool:    &mockTxPool{},
+		closeCh:   make(chan struct{}),
+	}
+
+	assert.NoError(t, ibft.setupMechanism())
+
+	return ibft
+}
+
+func TestIBFTFork_ValidatePacing(t *testing.T) {
+	cases := []struct {
+		name string
+		fork IBFTFork
+		err  error
+	}{
+		{
+			name: "no block pacing",
+			fork: IBFTFork{},
+		},
+		{
+			name: "valid block pacing",
+			fork: IBFTFork{
+				MaxEmptyBlockInterval: &common.JSONDuration{Value: time.Minute},
+				AdaptiveBlockTime: &AdaptiveBlockTime{
+					MinBlockTime: common.JSONDuration{Value: time.Second},
+					TargetGas:    common.JSONNumber{Value: 1000000},
+				},
+			},
+		},
+		{
+			name: "max empty block interval below a second",
+			fork: IBFTFork{
+				MaxEmptyBlockInterval: &common.JSONDuration{Value: 500 * time.Millisecond},
+			},
+			err: ErrInvalidMaxEmptyBlockInterval,
+		},
+		{
+			name: "no target gas",
+			fork: IBFTFork{
+				AdaptiveBlockTime: &AdaptiveBlockTime{
+					MinBlockTime: common.JSONDuration{Value: time.Second},
+				},
+			},
+			err: ErrInvalidTargetGas,
+		},
+		{
+			name: "min block time above the block time",
+			fork: IBFTFork{
+				AdaptiveBlockTime: &AdaptiveBlockTime{
+					MinBlockTime: common.JSONDuration{Value: 3 * time.Second},
+					TargetGas:    common.JSONNumber{Value: 1000000},
+				},
+			},
+			err: ErrInvalidMinBlockTime,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateForkPacing([]IBFTFork{c.fork}, 2*time.Second)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetIBFTFork(t *testing.T) {
+	ibft := &Ibft{
+		ibftForks: []IBFTFork{
+			{Type: PoA, From: common.JSONNumber{Value: 0}, To: &common.JSONNumber{Value: 10}},
+			{Type: PoS, From: common.JSONNumber{Value: 11}},
+			{Type: PoAGovernance, From: common.JSONNumber{Value: 20}},
+		},
+	}
+
+	assert.Equal(t, PoA, ibft.getIBFTFork(10).Type)
+	assert.Equal(t, PoS, ibft.getIBFTFork(11).Type)
+
+	// the latest fork covering the block is used
+	assert.Equal(t, PoAGovernance, ibft.getIBFTFork(20).Type)
+
+	assert.Nil(t, (&Ibft{}).getIBFTFork(1))
+}
+
+func TestVerifyBlockPacing(t *testing.T) {
+	ibft := newPacingIbft(t, IBFTFork{
+		Type:                  PoA,
+		MaxEmptyBlockInterval: &common.JSONDuration{Value: 30 * time.Second},
+		AdaptiveBlockTime: &AdaptiveBlockTime{
+			MinBlockTime: common.JSONDuration{Value: 1500 * time.Millisecond},
+			TargetGas:    common.JSONNumber{Value: 1000000},
+		},
+	})
+
+	parent := &types.Header{Number: 1, Timestamp: 100}
+
+	cases := []struct {
+		name      string
+		timestamp uint64
+		txRoot    types.Hash
+		err       error
+	}{
+		{
+			name:      "block after the min block time",
+			timestamp: 101,
+			txRoot:    types.StringToHash("1"),
+		},
+		{
+			name:      "block before the min block time",
+			timestamp: 100,
+			txRoot:    types.StringToHash("1"),
+			err:       errBlockTooEarly,
+		},
+		{
+			name:      "empty block after the max empty block interval",
+			timestamp: 130,
+			txRoot:    types.EmptyRootHash,
+		},
+		{
+			name:      "empty block before the max empty block interval",
+			timestamp: 129,
+			txRoot:    types.EmptyRootHash,
+			err:       errEmptyBlockTooEarly,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			header := &types.Header{
+				Number:    2,
+				Timestamp: c.timestamp,
+				TxRoot:    c.txRoot,
+			}
+
+			assert.ErrorIs(t, ibft.verifyBlockPacing(parent, header), c.err)
+		})
+	}
+
+	t.Run("empty blocks without transactions from the txpool", func(t *testing.T) {
+		ibft := newPacingIbft(t, IBFTFork{
+			Type:                  PoS,
+			MaxEmptyBlockInterval: &common.JSONDuration{Value: 30 * time.Second},
+		})
+		ibft.epochSize = 2
+
+		// the epoch block is empty
+		header := &types.Header{Number: 2, Timestamp: 102, TxRoot: types.EmptyRootHash}
+		assert.NoError(t, ibft.verifyBlockPacing(parent, header))
+	})
+
+	t.Run("no block pacing", func(t *testing.T) {
+		ibft := newPacingIbft(t, IBFTFork{Type: PoA})
+
+		header := &types.Header{Number: 2, Timestamp: 100, TxRoot: types.EmptyRootHash}
+		assert.NoError(t, ibft.verifyBlockPacing(parent, header))
+	})
+}
+
+func TestWaitForBlock(t *testing.T) {
+	parent := &types.Header{Number: 1, Timestamp: 100}
+	parentTime := time.Unix(100, 0)
+
+	fork := IBFTFork{
+		Type:                  PoA,
+		MaxEmptyBlockInterval: &common.JSONDuration{Value: 30 * time.Second},
+		AdaptiveBlockTime: &AdaptiveBlockTime{
+			MinBlockTime: common.JSONDuration{Value: time.Second},
+			TargetGas:    common.JSONNumber{Value: 100},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		fork     IBFTFork
+		gas      []uint64
+		expected time.Duration
+	}{
+		{
+			name:     "skips the empty blocks",
+			fork:     fork,
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "seals after the block time below the target gas",
+			fork:     fork,
+			gas:      []uint64{50},
+			expected: 2 * time.Second,
+		},
+		{
+			name:     "seals after the min block time once the target gas is reached",
+			fork:     fork,
+			gas:      []uint64{50, 50},
+			expected: time.Second,
+		},
+		{
+			name:     "doesn't wait without block pacing",
+			fork:     IBFTFork{Type: PoA},
+			expected: 0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ibft := newPacingIbft(t, c.fork)
+
+			clock := &manualClock{now: parentTime}
+			ibft.clock = clock
+
+			pool := &mockTxPool{}
+			for _, gas := range c.gas {
+				pool.transactions = append(pool.transactions, &types.Transaction{Gas: gas})
+			}
+
+			ibft.txpool = pool
+
+			assert.Equal(t, WaitExpired, ibft.waitForBlock(parent))
+			assert.Equal(t, parentTime.Add(c.expected), clock.now)
+		})
+	}
+
+	t.Run("seals once a transaction arrives", func(t *testing.T) {
+		ibft := newPacingIbft(t, fork)
+
+		pool := &mockTxPool{}
+		ibft.txpool = pool
+
+		clock := &manualClock{now: parentTime}
+		ibft.clock = &arrivalClock{
+			manualClock: clock,
+			at:          parentTime.Add(10 * time.Second),
+			arrive: func() {
+				pool.transactions = append(pool.transactions, &types.Transaction{Gas: 10})
+			},
+		}
+
+		assert.Equal(t, WaitExpired, ibft.waitForBlock(parent))
+		assert.Equal(t, parentTime.Add(10*time.Second), clock.now)
+
+		// the validators wait for the proposer up to the max empty block interval
+		assert.Equal(t, 20*time.Second, ibft.proposalDelay(parent))
+	})
+}
+
+// arrivalClock is a manualClock running a callback once the time reaches the arrival
+type arrivalClock struct {
+	*manualClock
+
+	at     time.Time
+	arrive func()
+}
+
+func (c *arrivalClock) Wait(deadline time.Time, wake, done <-chan struct{}) WaitResult {
+	res := c.manualClock.Wait(deadline, wake, done)
+
+	if c.arrive != nil && !c.now.Before(c.at) {
+		c.arrive()
+		c.arrive = nil
+	}
+
+	return res
+}
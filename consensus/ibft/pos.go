@@ -3,6 +3,8 @@ package ibft
 import (
 	"errors"
 	"fmt"
+	"sort"
+
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -40,11 +42,11 @@ func PoSFactory(ibft *Ibft, params *IBFTFork) (ConsensusMechanism, error) {
 // IsAvailable returns indicates if mechanism should be called at given height
 func (pos *PoSMechanism) IsAvailable(hookType HookType, height uint64) bool {
 	switch hookType {
-	case AcceptStateLogHook, VerifyBlockHook, CalculateProposerHook:
+	case AcceptStateLogHook, VerifyBlockHook, CalculateProposerHook, SystemTxHook:
 		return pos.IsInRange(height)
 	case PreStateCommitHook:
-		// deploy contract on ContractDeployment
-		return height == pos.ContractDeployment
+		// deploy contract on ContractDeployment, and jail the offline validators at the end of epoch
+		return height == pos.ContractDeployment || pos.isDowntimeCheck(height)
 	case InsertBlockHook:
 		// update validators when the one before the beginning or the end of epoch
 		return height+1 == pos.From || pos.IsInRange(height) && pos.ibft.IsLastOfEpoch(height)
@@ -151,13 +153,23 @@ type preStateCommitHookParams struct {
 	txn    *state.Transition
 }
 
-// verifyBlockHook checks if the block is an epoch block and if it has any transactions
+// preStateCommitHook deploys the Staking contract, and jails the offline validators at the end of epoch
 func (pos *PoSMechanism) preStateCommitHook(rawParams interface{}) error {
 	params, ok := rawParams.(*preStateCommitHookParams)
 	if !ok {
 		return ErrInvalidHookParam
 	}
 
+	if pos.isDowntimeCheck(params.header.Number) {
+		if err := pos.jailOfflineValidators(params.header, params.txn); err != nil {
+			return err
+		}
+	}
+
+	if params.header.Number != pos.ContractDeployment {
+		return nil
+	}
+
 	// Deploy Staking contract
 	contractState, err := stakingHelper.PredeployStakingSC(nil, stakingHelper.PredeployParams{
 		MinValidatorCount: pos.MinValidatorCount,
@@ -194,6 +206,9 @@ func (pos *PoSMechanism) initializeHookMap() {
 
 	// Register the CalculateProposerHook
 	pos.hookMap[CalculateProposerHook] = pos.calculateProposerHook
+
+	// Register the SystemTxHook
+	pos.hookMap[SystemTxHook] = pos.systemTxHook
 }
 
 // ShouldWriteTransactions indicates if transactions should be written to a block
@@ -210,42 +225,196 @@ func (pos *PoSMechanism) getNextValidators(header *types.Header) (ValidatorSet,
 		return nil, err
 	}
 
-	return staking.QueryValidators(transition, pos.ibft.validatorKeyAddr)
+	validators, err := staking.QueryValidators(transition, pos.ibft.validatorKeyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the jailed validators are left out until they are released, even if they staked again
+	nextValidators := make(ValidatorSet, 0, len(validators))
+
+	for _, validator := range validators {
+		until, err := staking.QueryJailedUntil(transition, pos.ibft.validatorKeyAddr, validator)
+		if err != nil {
+			return nil, err
+		}
+
+		if until <= header.Number {
+			nextValidators = append(nextValidators, validator)
+		}
+	}
+
+	return nextValidators, nil
 }
 
-// updateSnapshotValidators updates validators in snapshot at given height
+// updateValidators updates validators in snapshot at given height
 func (pos *PoSMechanism) updateValidators(num uint64) error {
-	header, ok := pos.ibft.blockchain.GetHeaderByNumber(num)
+	return pos.ibft.updateSnapshotValidators(num, pos.getNextValidators)
+}
+
+// slashing returns the slashing configuration of the validators
+func (pos *PoSMechanism) slashing() *SlashingConfig {
+	if pos.ibft.slashing == nil {
+		return DefaultSlashingConfig()
+	}
+
+	return pos.ibft.slashing
+}
+
+// jailUntil returns the block until which a validator penalized in the block is jailed,
+// which is the end of the current epoch plus the jail epochs
+func (pos *PoSMechanism) jailUntil(number uint64) uint64 {
+	return (pos.ibft.GetEpoch(number) + pos.slashing().JailEpochs.Value) * pos.ibft.epochSize
+}
+
+// isDowntimeCheck checks if the offline validators are jailed in the block
+func (pos *PoSMechanism) isDowntimeCheck(height uint64) bool {
+	return pos.slashing().DowntimeThreshold.Value > 0 &&
+		pos.IsInRange(height) &&
+		pos.ibft.IsLastOfEpoch(height)
+}
+
+// jailOfflineValidators jails the validators that missed at least the downtime threshold of proposals
+// in the blocks of the epoch, before the given end of epoch block
+func (pos *PoSMechanism) jailOfflineValidators(header *types.Header, txn *state.Transition) error {
+	first := header.Number - pos.ibft.epochSize + 1
+	if first < pos.From {
+		first = pos.From
+	}
+
+	if first == 0 {
+		first = 1
+	}
+
+	var lastProposer types.Address
+
+	if first > 1 {
+		parent, ok := pos.ibft.blockchain.GetHeaderByNumber(first - 1)
+		if !ok {
+			return fmt.Errorf("header %d not found", first-1)
+		}
+
+		proposer, err := ecrecoverFromHeader(parent)
+		if err != nil {
+			return err
+		}
+
+		lastProposer = proposer
+	}
+
+	missed := map[types.Address]uint64{}
+
+	for number := first; number < header.Number; number++ {
+		current, ok := pos.ibft.blockchain.GetHeaderByNumber(number)
+		if !ok {
+			return fmt.Errorf("header %d not found", number)
+		}
+
+		snap, err := pos.ibft.getSnapshot(number - 1)
+		if err != nil {
+			return err
+		}
+
+		if snap == nil {
+			return fmt.Errorf("cannot find snapshot at %d", number-1)
+		}
+
+		proposer, err := ecrecoverFromHeader(current)
+		if err != nil {
+			return err
+		}
+
+		for _, validator := range missedProposals(snap.Set, lastProposer, proposer) {
+			missed[validator]++
+		}
+
+		lastProposer = proposer
+	}
+
+	offline := make([]types.Address, 0, len(missed))
+
+	for validator, count := range missed {
+		if count >= pos.slashing().DowntimeThreshold.Value {
+			offline = append(offline, validator)
+		}
+	}
+
+	// the validators are jailed in the same order by every node,
+	// since the minimum number of validators can stop the jailing
+	sort.Slice(offline, func(i, j int) bool {
+		return offline[i].String() < offline[j].String()
+	})
+
+	until := pos.jailUntil(header.Number)
+
+	for _, validator := range offline {
+		jailed, err := staking.Jail(txn, validator, until)
+		if err != nil {
+			return err
+		}
+
+		if !jailed {
+			pos.ibft.logger.Warn("unable to jail offline validator", "validator", validator, "missed", missed[validator])
+
+			continue
+		}
+
+		pos.ibft.logger.Info("jailed offline validator", "validator", validator, "missed", missed[validator], "until", until)
+	}
+
+	return nil
+}
+
+// systemTxHook slashes and jails the validator reported for an equivocation by the system transaction
+func (pos *PoSMechanism) systemTxHook(rawParams interface{}) error {
+	params, ok := rawParams.(*systemTxHookParams)
 	if !ok {
-		return errors.New("header not found")
+		return ErrInvalidHookParam
 	}
 
-	validators, err := pos.getNextValidators(header)
+	if params.txn.To == nil || *params.txn.To != staking.AddrStakingContract {
+		return errSystemTxNotHandled
+	}
+
+	evidence, err := decodeEquivocation(params.txn.Input)
 	if err != nil {
 		return err
 	}
 
-	snap, err := pos.ibft.getSnapshot(header.Number)
+	if err := evidence.verify(); err != nil {
+		return err
+	}
+
+	sequence := evidence.sequence()
+	if sequence == 0 || sequence >= params.number || params.number-sequence > maxEvidenceAge {
+		return fmt.Errorf("%w: equivocation at %d can't be reported in %d", errInvalidEvidence, sequence, params.number)
+	}
+
+	snap, err := pos.ibft.getSnapshot(sequence - 1)
 	if err != nil {
 		return err
 	}
 
-	if snap == nil {
-		return fmt.Errorf("cannot find snapshot at %d", header.Number)
+	offender := evidence.offender()
+
+	if snap == nil || !snap.Set.Includes(offender) {
+		return fmt.Errorf("%w: %s isn't a validator at %d", errInvalidEvidence, offender, sequence)
 	}
 
-	if !snap.Set.Equal(&validators) {
-		newSnap := snap.Copy()
-		newSnap.Set = validators
-		newSnap.Number = header.Number
-		newSnap.Hash = header.Hash.String()
+	// the staking contract rejects the evidence already slashed
+	amount, err := staking.Slash(params.transition, offender, pos.slashing().SlashPercentage.Value, evidence.id())
+	if err != nil {
+		return fmt.Errorf("%w: %s can't be slashed at %d, %v", errInvalidEvidence, offender, sequence, err)
+	}
 
-		if snap.Number != header.Number {
-			pos.ibft.store.add(newSnap)
-		} else {
-			pos.ibft.store.replace(newSnap)
-		}
+	until := pos.jailUntil(params.number)
+	if _, err := staking.Jail(params.transition, offender, until); err != nil {
+		return err
 	}
 
+	pos.ibft.logger.Info("slashed equivocating validator", "validator", offender, "amount", amount, "until", until)
+
+	params.handled = true
+
 	return nil
 }
@@ -0,0 +1,82 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
+)
+
+var (
+	ErrBlockNotFound     = errors.New("block not found")
+	ErrGenesisBlockProof = errors.New("the genesis block has no committed seals")
+)
+
+// GetBlockProof returns the proof of the committed seals of the block,
+// which light clients and bridges check with the finality package
+func (i *Ibft) GetBlockProof(number uint64) (*finality.Proof, error) {
+	if number == 0 {
+		return nil, ErrGenesisBlockProof
+	}
+
+	header, ok := i.blockchain.GetHeaderByNumber(number)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrBlockNotFound, number)
+	}
+
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	// the validators of the extra data are the ones of the parent snapshot, which seal the block
+	proof := &finality.Proof{
+		Header:     header.Copy(),
+		Hash:       istanbulHeaderHash(header),
+		Validators: extra.Validators,
+		Quorum:     uint64(i.quorumSize(number)(ValidatorSet(extra.Validators))),
+	}
+
+	putIbftExtraWithoutSeals(proof.Header, extra)
+
+	if !i.isBLSActive(number) {
+		proof.CommittedSeals = extra.CommittedSeal
+
+		return proof, nil
+	}
+
+	if extra.AggregatedSeal == nil {
+		return nil, ErrMissingAggregatedSeal
+	}
+
+	proof.AggregatedSeal = &finality.AggregatedSeal{
+		Bitmap:    extra.AggregatedSeal.Bitmap,
+		Signature: extra.AggregatedSeal.Signature,
+	}
+
+	snap, err := i.getSnapshot(number - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		return nil, fmt.Errorf("snapshot not found for block %d", number-1)
+	}
+
+	sealerKey, err := i.sealerBLSKeys(snap, header, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.ValidatorBLSKeys = make([][]byte, len(extra.Validators))
+
+	for indx, addr := range extra.Validators {
+		if key := sealerKey(addr); key != nil {
+			proof.ValidatorBLSKeys[indx] = key.Marshal()
+		} else {
+			proof.ValidatorBLSKeys[indx] = []byte{}
+		}
+	}
+
+	return proof, nil
+}
@@ -0,0 +1,101 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlockProof(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, headers := newLivenessIbft(t, pool)
+
+	// the first block misses the committed seal of one validator
+	proof, err := ibft.GetBlockProof(1)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+
+	assert.Equal(t, istanbulHeaderHash(headers[0]), proof.Hash)
+	assert.Equal(t, []types.Address(pool.ValidatorSet()), proof.Validators)
+	assert.Len(t, proof.CommittedSeals, 3)
+	assert.Equal(t, uint64(3), proof.Quorum)
+
+	// the seals are removed from the header of the proof
+	extra, err := getIbftExtra(proof.Header)
+	assert.NoError(t, err)
+	assert.Empty(t, extra.Seal)
+	assert.Empty(t, extra.CommittedSeal)
+
+	_, err = ibft.GetBlockProof(0)
+	assert.ErrorIs(t, err, ErrGenesisBlockProof)
+
+	_, err = ibft.GetBlockProof(3)
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestGetBlockProof_BLS(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	ibft, keys := newBLSTester(t, pool, "A", "B", "C", "D")
+
+	chain := blockchain.TestBlockchain(t, pool.genesis())
+
+	ibft.blockchain = chain
+	ibft.store = newSnapshotStore()
+	ibft.store.add(&Snapshot{Set: pool.ValidatorSet()})
+
+	snap, err := ibft.getSnapshot(0)
+	assert.NoError(t, err)
+
+	header := &types.Header{
+		Number:     1,
+		ParentHash: chain.Header().Hash,
+		MixHash:    IstanbulDigest,
+	}
+	_ = PutIbftExtra(header, &IstanbulExtra{
+		Validators:    pool.ValidatorSet(),
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	})
+	header = pool.get("A").sign(header)
+
+	// the seal of D is missing
+	seals := map[types.Address][]byte{}
+
+	for _, account := range []string{"A", "B", "C"} {
+		seal, err := writeBLSCommittedSeal(keys[account], header)
+		assert.NoError(t, err)
+
+		seals[pool.get(account).Address()] = seal
+	}
+
+	aggregatedSeal, err := ibft.aggregateCommittedSeals(snap, header, seals)
+	assert.NoError(t, err)
+
+	header, err = writeAggregatedSeal(header, aggregatedSeal)
+	assert.NoError(t, err)
+
+	header.ComputeHash()
+	assert.NoError(t, chain.WriteHeaders([]*types.Header{header}))
+
+	proof, err := ibft.GetBlockProof(1)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+
+	assert.Empty(t, proof.CommittedSeals)
+	assert.Equal(t, []byte{0x07}, proof.AggregatedSeal.Bitmap)
+
+	if assert.Len(t, proof.ValidatorBLSKeys, 4) {
+		for indx, addr := range proof.Validators {
+			key, err := bls.UnmarshalPublicKey(proof.ValidatorBLSKeys[indx])
+			assert.NoError(t, err)
+			assert.True(t, key.Equal(keys[aliasOf(pool, addr)].PublicKey()))
+		}
+	}
+}
@@ -1,17 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
+// 	protoc-gen-go v1.28.0
 // 	protoc        v3.12.0
 // source: consensus/ibft/proto/ibft.proto
 
 package proto
 
 import (
-	proto "github.com/golang/protobuf/proto"
-	any "github.com/golang/protobuf/ptypes/any"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,10 +22,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type MessageReq_Type int32
 
 const (
@@ -141,10 +136,18 @@ type MessageReq struct {
 	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	// view is the view assigned to the message
 	View *View `protobuf:"bytes,5,opt,name=view,proto3" json:"view,omitempty"`
-	// hash of the locked block
+	// digest is the hash of the proposed block, or of the prepared block in round change messages
 	Digest string `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
 	// proposal is the rlp encoded block in preprepare messages
-	Proposal *any.Any `protobuf:"bytes,7,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Proposal *anypb.Any `protobuf:"bytes,7,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// preparedRound is the round of the prepared block (digest) in round change messages
+	PreparedRound uint64 `protobuf:"varint,8,opt,name=preparedRound,proto3" json:"preparedRound,omitempty"`
+	// preparedCertificate proves the prepared block of round change messages.
+	// It isn't covered by the signature since its messages are signed
+	PreparedCertificate *PreparedCertificate `protobuf:"bytes,9,opt,name=preparedCertificate,proto3" json:"preparedCertificate,omitempty"`
+	// roundChangeCertificate justifies the proposal of preprepare messages in rounds > 0.
+	// It isn't covered by the signature since its messages are signed
+	RoundChangeCertificate *RoundChangeCertificate `protobuf:"bytes,10,opt,name=roundChangeCertificate,proto3" json:"roundChangeCertificate,omitempty"`
 }
 
 func (x *MessageReq) Reset() {
@@ -221,13 +224,34 @@ func (x *MessageReq) GetDigest() string {
 	return ""
 }
 
-func (x *MessageReq) GetProposal() *any.Any {
+func (x *MessageReq) GetProposal() *anypb.Any {
 	if x != nil {
 		return x.Proposal
 	}
 	return nil
 }
 
+func (x *MessageReq) GetPreparedRound() uint64 {
+	if x != nil {
+		return x.PreparedRound
+	}
+	return 0
+}
+
+func (x *MessageReq) GetPreparedCertificate() *PreparedCertificate {
+	if x != nil {
+		return x.PreparedCertificate
+	}
+	return nil
+}
+
+func (x *MessageReq) GetRoundChangeCertificate() *RoundChangeCertificate {
+	if x != nil {
+		return x.RoundChangeCertificate
+	}
+	return nil
+}
+
 type View struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -283,6 +307,120 @@ func (x *View) GetSequence() uint64 {
 	return 0
 }
 
+type PreparedCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal is the rlp encoded prepared block
+	Proposal *anypb.Any `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// prepareMessages are the prepare messages of the quorum which prepared the block
+	PrepareMessages []*MessageReq `protobuf:"bytes,2,rep,name=prepareMessages,proto3" json:"prepareMessages,omitempty"`
+}
+
+func (x *PreparedCertificate) Reset() {
+	*x = PreparedCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_ibft_proto_ibft_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreparedCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparedCertificate) ProtoMessage() {}
+
+func (x *PreparedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_ibft_proto_ibft_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparedCertificate.ProtoReflect.Descriptor instead.
+func (*PreparedCertificate) Descriptor() ([]byte, []int) {
+	return file_consensus_ibft_proto_ibft_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreparedCertificate) GetProposal() *anypb.Any {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *PreparedCertificate) GetPrepareMessages() []*MessageReq {
+	if x != nil {
+		return x.PrepareMessages
+	}
+	return nil
+}
+
+type RoundChangeCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// roundChangeMessages are the round change messages of the quorum, without their prepared certificates
+	RoundChangeMessages []*MessageReq `protobuf:"bytes,1,rep,name=roundChangeMessages,proto3" json:"roundChangeMessages,omitempty"`
+	// prepareMessages are the prepare messages of the highest prepared block of the round change messages
+	PrepareMessages []*MessageReq `protobuf:"bytes,2,rep,name=prepareMessages,proto3" json:"prepareMessages,omitempty"`
+}
+
+func (x *RoundChangeCertificate) Reset() {
+	*x = RoundChangeCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_ibft_proto_ibft_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundChangeCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundChangeCertificate) ProtoMessage() {}
+
+func (x *RoundChangeCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_ibft_proto_ibft_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundChangeCertificate.ProtoReflect.Descriptor instead.
+func (*RoundChangeCertificate) Descriptor() ([]byte, []int) {
+	return file_consensus_ibft_proto_ibft_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoundChangeCertificate) GetRoundChangeMessages() []*MessageReq {
+	if x != nil {
+		return x.RoundChangeMessages
+	}
+	return nil
+}
+
+func (x *RoundChangeCertificate) GetPrepareMessages() []*MessageReq {
+	if x != nil {
+		return x.PrepareMessages
+	}
+	return nil
+}
+
 var File_consensus_ibft_proto_ibft_proto protoreflect.FileDescriptor
 
 var file_consensus_ibft_proto_ibft_proto_rawDesc = []byte{
@@ -294,7 +432,7 @@ var file_consensus_ibft_proto_ibft_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21, 0x0a,
 	0x0d, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0xa5, 0x02, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x22, 0xea, 0x03, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12,
 	0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x54, 0x79,
 	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
@@ -308,15 +446,45 @@ var file_consensus_ibft_proto_ibft_proto_rawDesc = []byte{
 	0x67, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x40, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e,
-	0x0a, 0x0a, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x22, 0x38, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x32, 0x71, 0x0a, 0x04, 0x49, 0x62, 0x66, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x48, 0x61,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x13,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x13, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x16, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x16, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x40, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x22, 0x38, 0x0a,
+	0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x12, 0x38, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x16,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x32, 0x71, 0x0a, 0x04, 0x49, 0x62, 0x66, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x48, 0x61,
 	0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x11, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65,
@@ -341,28 +509,36 @@ func file_consensus_ibft_proto_ibft_proto_rawDescGZIP() []byte {
 }
 
 var file_consensus_ibft_proto_ibft_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_consensus_ibft_proto_ibft_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_consensus_ibft_proto_ibft_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_consensus_ibft_proto_ibft_proto_goTypes = []interface{}{
-	(MessageReq_Type)(0),  // 0: v1.MessageReq.Type
-	(*HandshakeResp)(nil), // 1: v1.HandshakeResp
-	(*MessageReq)(nil),    // 2: v1.MessageReq
-	(*View)(nil),          // 3: v1.View
-	(*any.Any)(nil),       // 4: google.protobuf.Any
-	(*empty.Empty)(nil),   // 5: google.protobuf.Empty
+	(MessageReq_Type)(0),           // 0: v1.MessageReq.Type
+	(*HandshakeResp)(nil),          // 1: v1.HandshakeResp
+	(*MessageReq)(nil),             // 2: v1.MessageReq
+	(*View)(nil),                   // 3: v1.View
+	(*PreparedCertificate)(nil),    // 4: v1.PreparedCertificate
+	(*RoundChangeCertificate)(nil), // 5: v1.RoundChangeCertificate
+	(*anypb.Any)(nil),              // 6: google.protobuf.Any
+	(*emptypb.Empty)(nil),          // 7: google.protobuf.Empty
 }
 var file_consensus_ibft_proto_ibft_proto_depIdxs = []int32{
-	0, // 0: v1.MessageReq.type:type_name -> v1.MessageReq.Type
-	3, // 1: v1.MessageReq.view:type_name -> v1.View
-	4, // 2: v1.MessageReq.proposal:type_name -> google.protobuf.Any
-	5, // 3: v1.Ibft.Handshake:input_type -> google.protobuf.Empty
-	2, // 4: v1.Ibft.Message:input_type -> v1.MessageReq
-	1, // 5: v1.Ibft.Handshake:output_type -> v1.HandshakeResp
-	5, // 6: v1.Ibft.Message:output_type -> google.protobuf.Empty
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: v1.MessageReq.type:type_name -> v1.MessageReq.Type
+	3,  // 1: v1.MessageReq.view:type_name -> v1.View
+	6,  // 2: v1.MessageReq.proposal:type_name -> google.protobuf.Any
+	4,  // 3: v1.MessageReq.preparedCertificate:type_name -> v1.PreparedCertificate
+	5,  // 4: v1.MessageReq.roundChangeCertificate:type_name -> v1.RoundChangeCertificate
+	6,  // 5: v1.PreparedCertificate.proposal:type_name -> google.protobuf.Any
+	2,  // 6: v1.PreparedCertificate.prepareMessages:type_name -> v1.MessageReq
+	2,  // 7: v1.RoundChangeCertificate.roundChangeMessages:type_name -> v1.MessageReq
+	2,  // 8: v1.RoundChangeCertificate.prepareMessages:type_name -> v1.MessageReq
+	7,  // 9: v1.Ibft.Handshake:input_type -> google.protobuf.Empty
+	2,  // 10: v1.Ibft.Message:input_type -> v1.MessageReq
+	1,  // 11: v1.Ibft.Handshake:output_type -> v1.HandshakeResp
+	7,  // 12: v1.Ibft.Message:output_type -> google.protobuf.Empty
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_consensus_ibft_proto_ibft_proto_init() }
@@ -407,6 +583,30 @@ func file_consensus_ibft_proto_ibft_proto_init() {
 				return nil
 			}
 		}
+		file_consensus_ibft_proto_ibft_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreparedCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_ibft_proto_ibft_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundChangeCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -414,7 +614,7 @@ func file_consensus_ibft_proto_ibft_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_consensus_ibft_proto_ibft_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,9 @@ type IbftOperatorClient interface {
 	Propose(ctx context.Context, in *Candidate, opts ...grpc.CallOption) (*empty.Empty, error)
 	Candidates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CandidatesResp, error)
 	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IbftStatusResp, error)
+	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatsResp, error)
+	GetBlockProof(ctx context.Context, in *BlockProofReq, opts ...grpc.CallOption) (*BlockProof, error)
+	ReportEquivocation(ctx context.Context, in *EquivocationReq, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type ibftOperatorClient struct {
@@ -69,6 +72,33 @@ func (c *ibftOperatorClient) Status(ctx context.Context, in *empty.Empty, opts .
 	return out, nil
 }
 
+func (c *ibftOperatorClient) Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatsResp, error) {
+	out := new(StatsResp)
+	err := c.cc.Invoke(ctx, "/v1.IbftOperator/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ibftOperatorClient) GetBlockProof(ctx context.Context, in *BlockProofReq, opts ...grpc.CallOption) (*BlockProof, error) {
+	out := new(BlockProof)
+	err := c.cc.Invoke(ctx, "/v1.IbftOperator/GetBlockProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ibftOperatorClient) ReportEquivocation(ctx context.Context, in *EquivocationReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/v1.IbftOperator/ReportEquivocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IbftOperatorServer is the server API for IbftOperator service.
 // All implementations must embed UnimplementedIbftOperatorServer
 // for forward compatibility
@@ -77,6 +107,9 @@ type IbftOperatorServer interface {
 	Propose(context.Context, *Candidate) (*empty.Empty, error)
 	Candidates(context.Context, *empty.Empty) (*CandidatesResp, error)
 	Status(context.Context, *empty.Empty) (*IbftStatusResp, error)
+	Stats(context.Context, *StatsReq) (*StatsResp, error)
+	GetBlockProof(context.Context, *BlockProofReq) (*BlockProof, error)
+	ReportEquivocation(context.Context, *EquivocationReq) (*empty.Empty, error)
 	mustEmbedUnimplementedIbftOperatorServer()
 }
 
@@ -96,6 +129,15 @@ func (UnimplementedIbftOperatorServer) Candidates(context.Context, *empty.Empty)
 func (UnimplementedIbftOperatorServer) Status(context.Context, *empty.Empty) (*IbftStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedIbftOperatorServer) Stats(context.Context, *StatsReq) (*StatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedIbftOperatorServer) GetBlockProof(context.Context, *BlockProofReq) (*BlockProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProof not implemented")
+}
+func (UnimplementedIbftOperatorServer) ReportEquivocation(context.Context, *EquivocationReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportEquivocation not implemented")
+}
 func (UnimplementedIbftOperatorServer) mustEmbedUnimplementedIbftOperatorServer() {}
 
 // UnsafeIbftOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -181,6 +223,60 @@ func _IbftOperator_Status_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IbftOperator_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IbftOperatorServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.IbftOperator/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IbftOperatorServer).Stats(ctx, req.(*StatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IbftOperator_GetBlockProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockProofReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IbftOperatorServer).GetBlockProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.IbftOperator/GetBlockProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IbftOperatorServer).GetBlockProof(ctx, req.(*BlockProofReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IbftOperator_ReportEquivocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquivocationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IbftOperatorServer).ReportEquivocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.IbftOperator/ReportEquivocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IbftOperatorServer).ReportEquivocation(ctx, req.(*EquivocationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IbftOperator_ServiceDesc is the grpc.ServiceDesc for IbftOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,6 +300,18 @@ var IbftOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _IbftOperator_Status_Handler,
 		},
+		{
+			MethodName: "Stats",
+			Handler:    _IbftOperator_Stats_Handler,
+		},
+		{
+			MethodName: "GetBlockProof",
+			Handler:    _IbftOperator_GetBlockProof_Handler,
+		},
+		{
+			MethodName: "ReportEquivocation",
+			Handler:    _IbftOperator_ReportEquivocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "consensus/ibft/proto/operator.proto",
@@ -5,10 +5,15 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// PayloadNoSig returns the byte representation of the message request, without the signature field
+// PayloadNoSig returns the byte representation of the message request, without the signature field.
+// The sender is recovered from the signature, and the certificates are made of signed messages,
+// so they aren't part of the payload either
 func (m *MessageReq) PayloadNoSig() ([]byte, error) {
 	m = m.Copy()
 	m.Signature = ""
+	m.From = ""
+	m.PreparedCertificate = nil
+	m.RoundChangeCertificate = nil
 
 	data, err := proto.Marshal(m)
 	if err != nil {
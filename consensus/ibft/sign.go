@@ -4,20 +4,14 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
-	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/0xPolygon/polygon-edge/types"
-	"github.com/umbracle/fastrlp"
 )
 
-func commitMsg(b []byte) []byte {
-	// message that the nodes need to sign to commit to a block
-	// hash with COMMIT_MSG_CODE which is the same value used in quorum
-	return crypto.Keccak256(b, []byte{byte(proto.MessageReq_Commit)})
-}
-
 func ecrecoverImpl(sig, msg []byte) (types.Address, error) {
 	pub, err := crypto.RecoverPubkey(sig, crypto.Keccak256(msg))
 	if err != nil {
@@ -51,7 +45,7 @@ func signSealImpl(prv *ecdsa.PrivateKey, h *types.Header, committed bool) ([]byt
 	// if we are singing the committed seals we need to do something more
 	msg := hash
 	if committed {
-		msg = commitMsg(hash)
+		msg = finality.CommitMsg(hash)
 	}
 
 	seal, err := crypto.Sign(prv, crypto.Keccak256(msg))
@@ -88,6 +82,31 @@ func writeCommittedSeal(prv *ecdsa.PrivateKey, h *types.Header) ([]byte, error)
 	return signSealImpl(prv, h, true)
 }
 
+func writeBLSCommittedSeal(key *bls.SecretKey, h *types.Header) ([]byte, error) {
+	hash, err := calculateHeaderHash(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Sign(finality.CommitMsg(hash)).Marshal(), nil
+}
+
+func writeAggregatedSeal(h *types.Header, seal *AggregatedSeal) (*types.Header, error) {
+	h = h.Copy()
+
+	extra, err := getIbftExtra(h)
+	if err != nil {
+		return nil, err
+	}
+
+	extra.AggregatedSeal = seal
+	if err := PutIbftExtra(h, extra); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
 func writeCommittedSeals(h *types.Header, seals [][]byte) (*types.Header, error) {
 	h = h.Copy()
 
@@ -115,41 +134,12 @@ func writeCommittedSeals(h *types.Header, seals [][]byte) (*types.Header, error)
 }
 
 func calculateHeaderHash(h *types.Header) ([]byte, error) {
-	h = h.Copy() // make a copy since we update the extra field
-
-	arena := fastrlp.DefaultArenaPool.Get()
-	defer fastrlp.DefaultArenaPool.Put(arena)
-
-	// when hashing the block for signing we have to remove from
-	// the extra field the seal and committed seal items
-	extra, err := getIbftExtra(h)
+	h, err := headerWithoutSeals(h)
 	if err != nil {
 		return nil, err
 	}
 
-	// This will effectively remove the Seal and Committed Seal fields,
-	// while keeping proposer vanity and validator set
-	// because extra.Validators is what we got from `h` in the first place.
-	putIbftExtraValidators(h, extra.Validators)
-
-	vv := arena.NewArray()
-	vv.Set(arena.NewBytes(h.ParentHash.Bytes()))
-	vv.Set(arena.NewBytes(h.Sha3Uncles.Bytes()))
-	vv.Set(arena.NewBytes(h.Miner.Bytes()))
-	vv.Set(arena.NewBytes(h.StateRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.TxRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.ReceiptsRoot.Bytes()))
-	vv.Set(arena.NewBytes(h.LogsBloom[:]))
-	vv.Set(arena.NewUint(h.Difficulty))
-	vv.Set(arena.NewUint(h.Number))
-	vv.Set(arena.NewUint(h.GasLimit))
-	vv.Set(arena.NewUint(h.GasUsed))
-	vv.Set(arena.NewUint(h.Timestamp))
-	vv.Set(arena.NewCopyBytes(h.ExtraData))
-
-	buf := keccak.Keccak256Rlp(nil, vv)
-
-	return buf, nil
+	return finality.HeaderHash(h, false).Bytes(), nil
 }
 
 func verifySigner(snap *Snapshot, header *types.Header) error {
@@ -165,44 +155,45 @@ func verifySigner(snap *Snapshot, header *types.Header) error {
 	return nil
 }
 
-// verifyCommittedFields is checking for consensus proof in the header
+// verifyCommittedFields is checking for consensus proof in the header,
+// and returns the validators that signed the committed seals
 func verifyCommittedFields(
 	snap *Snapshot,
 	header *types.Header,
 	quorumSizeFn QuorumImplementation,
-) error {
+) (map[types.Address]struct{}, error) {
 	extra, err := getIbftExtra(header)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Committed seals shouldn't be empty
 	if len(extra.CommittedSeal) == 0 {
-		return fmt.Errorf("empty committed seals")
+		return nil, fmt.Errorf("empty committed seals")
 	}
 
 	// get the message that needs to be signed
 	// this not signing! just removing the fields that should be signed
 	hash, err := calculateHeaderHash(header)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rawMsg := commitMsg(hash)
+	rawMsg := finality.CommitMsg(hash)
 
 	visited := map[types.Address]struct{}{}
 
 	for _, seal := range extra.CommittedSeal {
 		addr, err := ecrecoverImpl(seal, rawMsg)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if _, ok := visited[addr]; ok {
-			return fmt.Errorf("repeated seal")
+			return nil, fmt.Errorf("repeated seal")
 		} else {
 			if !snap.Set.Includes(addr) {
-				return fmt.Errorf("signed by non validator")
+				return nil, fmt.Errorf("signed by non validator")
 			}
 			visited[addr] = struct{}{}
 		}
@@ -212,10 +203,10 @@ func verifyCommittedFields(
 	// 	2F 	is the required number of honest validators who provided the committed seals
 	// 	+1	is the proposer
 	if validSeals := len(visited); validSeals < quorumSizeFn(snap.Set) {
-		return fmt.Errorf("not enough seals to seal block")
+		return nil, fmt.Errorf("not enough seals to seal block")
 	}
 
-	return nil
+	return visited, nil
 }
 
 func validateMsg(msg *proto.MessageReq) error {
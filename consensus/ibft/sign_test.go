@@ -59,7 +59,9 @@ func TestSign_CommittedSeals(t *testing.T) {
 
 		assert.NoError(t, err)
 
-		return verifyCommittedFields(snap, sealed, OptimalQuorumSize)
+		_, err = verifyCommittedFields(snap, sealed, OptimalQuorumSize)
+
+		return err
 	}
 
 	// Correct
@@ -0,0 +1,151 @@
+package ibft
+
+import (
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+var (
+	errMissingSimulationKey    = errors.New("validator key is required")
+	errMissingSimulationBLSKey = errors.New("validator BLS key is required by the BLS fork")
+)
+
+// SimulationConfig is the configuration of an IBFT engine
+// that runs outside of a server, with the dependencies supplied by the caller
+type SimulationConfig struct {
+	Logger          hclog.Logger
+	Config          map[string]interface{} // IBFT parameters, as in the genesis file
+	BlockTime       uint64                 // Minimum block time in seconds
+	ValidatorKey    *ecdsa.PrivateKey
+	ValidatorBLSKey *bls.SecretKey // Required when the BLS fork is configured
+	Executor        *state.Executor
+	Blockchain      blockchainInterface
+	Txpool          txPoolInterface
+	Syncer          syncerInterface
+}
+
+// SimulationTransport delivers the messages gossiped by an engine created with NewForSimulation
+type SimulationTransport interface {
+	Gossip(msg *proto.MessageReq) error
+}
+
+// SimulationEngine is an IBFT engine wired to in-memory dependencies.
+// Its state machine is run by the caller, which also delivers the gossiped messages
+type SimulationEngine struct {
+	*Ibft
+}
+
+// SimulationGenesis returns the genesis header of a simulated chain with the given validator set,
+// hashed with the IBFT header hash
+func SimulationGenesis(genesis *chain.Genesis, validators []types.Address) *types.Header {
+	header := genesis.GenesisHeader()
+	header.MixHash = IstanbulDigest
+	putIbftExtraValidators(header, validators)
+
+	header.Hash = istanbulHeaderHash(header)
+
+	return header
+}
+
+// NewForSimulation creates an IBFT engine from the simulation config,
+// which gossips through the transport and reads the time from the clock
+func NewForSimulation(
+	config *SimulationConfig,
+	transport SimulationTransport,
+	clock Clock,
+) (*SimulationEngine, error) {
+	if config.ValidatorKey == nil {
+		return nil, errMissingSimulationKey
+	}
+
+	engine, err := Factory(&consensus.ConsensusParams{
+		Config:    &consensus.Config{Config: config.Config},
+		Logger:    config.Logger,
+		Seal:      true,
+		Metrics:   consensus.NilMetrics(),
+		BlockTime: config.BlockTime,
+		Executor:  config.Executor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i, _ := engine.(*Ibft)
+
+	if i.blsFork != nil && config.ValidatorBLSKey == nil {
+		return nil, errMissingSimulationBLSKey
+	}
+
+	i.blockchain = config.Blockchain
+	i.txpool = config.Txpool
+	i.syncer = config.Syncer
+	i.transport = transport
+	i.clock = clock
+	i.validatorKey = config.ValidatorKey
+	i.validatorKeyAddr = crypto.PubKeyToAddress(&config.ValidatorKey.PublicKey)
+	i.validatorBLSKey = config.ValidatorBLSKey
+
+	// the operator holds the candidates of the PoA votes, it is not served over gRPC
+	i.operator = &operator{ibft: i}
+
+	if err := i.Initialize(); err != nil {
+		return nil, err
+	}
+
+	// set up the message queue and the channels of the state machine
+	if err := i.createKey(); err != nil {
+		return nil, err
+	}
+
+	return &SimulationEngine{Ibft: i}, nil
+}
+
+// Run runs the state machine until the engine is closed
+func (e *SimulationEngine) Run() {
+	e.start()
+}
+
+// Address returns the validator address of the engine
+func (e *SimulationEngine) Address() types.Address {
+	return e.validatorKeyAddr
+}
+
+// Deliver handles a message received from the transport
+func (e *SimulationEngine) Deliver(msg *proto.MessageReq) {
+	e.receiveMessage(msg)
+}
+
+// SignMessage signs the message with the validator key
+func (e *SimulationEngine) SignMessage(msg *proto.MessageReq) error {
+	return signMsg(e.validatorKey, msg)
+}
+
+// SealProposal writes the proposer seal of the block, and computes its hash
+func (e *SimulationEngine) SealProposal(block *types.Block) error {
+	header, err := writeSeal(e.validatorKey, block.Header)
+	if err != nil {
+		return err
+	}
+
+	block.Header = header.ComputeHash()
+
+	return nil
+}
+
+// CommittedSeal returns the committed seal of the validator for the header
+func (e *SimulationEngine) CommittedSeal(header *types.Header) ([]byte, error) {
+	if e.isBLSActive(header.Number) {
+		return writeBLSCommittedSeal(e.validatorBLSKey, header)
+	}
+
+	return writeCommittedSeal(e.validatorKey, header)
+}
@@ -0,0 +1,134 @@
+package simulation
+
+import (
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Behaviour makes a validator Byzantine, by deciding what it gossips.
+// The engine of the validator is honest, only its outgoing messages are altered
+type Behaviour interface {
+	// Gossip returns the messages the node sends to the recipient, for a message of its engine
+	Gossip(node *Node, msg *proto.MessageReq, to int) []*proto.MessageReq
+}
+
+// BehaviourFunc is a Behaviour implemented by a function
+type BehaviourFunc func(node *Node, msg *proto.MessageReq, to int) []*proto.MessageReq
+
+// Gossip calls the function
+func (f BehaviourFunc) Gossip(node *Node, msg *proto.MessageReq, to int) []*proto.MessageReq {
+	return f(node, msg, to)
+}
+
+// Silent is a validator that never sends any message
+type Silent struct{}
+
+// Gossip drops the message
+func (Silent) Gossip(_ *Node, _ *proto.MessageReq, _ int) []*proto.MessageReq {
+	return nil
+}
+
+// Equivocate is a validator that proposes a different block to some of its peers,
+// and then votes for both of its proposals
+type Equivocate struct {
+	// Peers are the indexes of the validators that receive the other proposal
+	Peers []int
+
+	// forks maps the digest of the honest proposals to the other proposal
+	forks map[string]*types.Block
+}
+
+// Gossip sends the other proposal, and the votes for it, to the selected peers
+func (e *Equivocate) Gossip(node *Node, msg *proto.MessageReq, to int) []*proto.MessageReq {
+	if !e.targets(to) {
+		return []*proto.MessageReq{msg}
+	}
+
+	fork, err := e.fork(node, msg)
+	if err != nil {
+		node.sim.logger.Error("failed to fork the proposal", "err", err)
+
+		return []*proto.MessageReq{msg}
+	}
+
+	if fork == nil {
+		return []*proto.MessageReq{msg}
+	}
+
+	other := msg.Copy()
+	other.Digest = fork.Hash().String()
+
+	switch msg.Type {
+	case proto.MessageReq_Preprepare:
+		other.Proposal = &anypb.Any{
+			Value: fork.MarshalRLP(),
+		}
+	case proto.MessageReq_Commit:
+		seal, err := node.engine.CommittedSeal(fork.Header)
+		if err != nil {
+			node.sim.logger.Error("failed to seal the other proposal", "err", err)
+
+			return []*proto.MessageReq{msg}
+		}
+
+		other.Seal = hex.EncodeToHex(seal)
+	}
+
+	if err := node.engine.SignMessage(other); err != nil {
+		node.sim.logger.Error("failed to sign the other proposal", "err", err)
+
+		return []*proto.MessageReq{msg}
+	}
+
+	return []*proto.MessageReq{other}
+}
+
+// targets checks if the validator receives the other proposal
+func (e *Equivocate) targets(index int) bool {
+	for _, peer := range e.Peers {
+		if peer == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fork returns the other proposal for the votes on a proposal of the node,
+// and creates it from the preprepare message. It returns nil for the other messages
+func (e *Equivocate) fork(node *Node, msg *proto.MessageReq) (*types.Block, error) {
+	switch msg.Type {
+	case proto.MessageReq_Prepare, proto.MessageReq_Commit:
+		return e.forks[msg.Digest], nil
+	case proto.MessageReq_Preprepare:
+	default:
+		return nil, nil
+	}
+
+	if fork, ok := e.forks[msg.Digest]; ok {
+		return fork, nil
+	}
+
+	// the other proposal only differs by its timestamp
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(msg.Proposal.Value); err != nil {
+		return nil, err
+	}
+
+	block.Header = block.Header.Copy()
+	block.Header.Timestamp++
+
+	if err := node.engine.SealProposal(block); err != nil {
+		return nil, err
+	}
+
+	if e.forks == nil {
+		e.forks = make(map[string]*types.Block)
+	}
+
+	e.forks[msg.Digest] = block
+
+	return block, nil
+}
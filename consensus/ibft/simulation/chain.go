@@ -0,0 +1,224 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/protocol"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// blockchain is the in-memory chain of a validator
+type blockchain struct {
+	node   *Node
+	blocks []*types.Block
+}
+
+func newBlockchain(node *Node, genesis *types.Header) *blockchain {
+	return &blockchain{
+		node:   node,
+		blocks: []*types.Block{{Header: genesis}},
+	}
+}
+
+// Header returns the header of the latest block
+func (b *blockchain) Header() *types.Header {
+	return b.blocks[len(b.blocks)-1].Header
+}
+
+// GetHeaderByNumber returns the header of the block at the given height
+func (b *blockchain) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	block, ok := b.getBlock(number)
+	if !ok {
+		return nil, false
+	}
+
+	return block.Header, true
+}
+
+func (b *blockchain) getBlock(number uint64) (*types.Block, bool) {
+	if number >= uint64(len(b.blocks)) {
+		return nil, false
+	}
+
+	return b.blocks[number], true
+}
+
+// getHashHelper returns the block hashes of the chain for the executor
+func (b *blockchain) getHashHelper(_ *types.Header) state.GetHashByNumber {
+	return func(number uint64) types.Hash {
+		header, ok := b.GetHeaderByNumber(number)
+		if !ok {
+			return types.ZeroHash
+		}
+
+		return header.Hash
+	}
+}
+
+// WriteBlock appends the block to the chain, and reports it to the safety checker.
+// Like the real blockchain, it lets the engine process the new header
+func (b *blockchain) WriteBlock(block *types.Block) error {
+	head := b.Header()
+
+	if block.Number() != head.Number+1 {
+		return fmt.Errorf("invalid block number %d, expected %d", block.Number(), head.Number+1)
+	}
+
+	if block.ParentHash() != head.Hash {
+		return fmt.Errorf("invalid parent hash %s of block %d", block.ParentHash(), block.Number())
+	}
+
+	b.blocks = append(b.blocks, block)
+
+	if engine := b.node.engine; engine != nil {
+		if err := engine.ProcessHeaders([]*types.Header{block.Header}); err != nil {
+			return err
+		}
+	}
+
+	b.node.sim.checker.Observe(b.node.index, block)
+
+	return nil
+}
+
+// VerifyPotentialBlock accepts every proposal, as the blocks of the simulation carry no transactions
+func (b *blockchain) VerifyPotentialBlock(_ *types.Block) error {
+	return nil
+}
+
+// CalculateGasLimit keeps the gas limit of the genesis
+func (b *blockchain) CalculateGasLimit(_ uint64) (uint64, error) {
+	return b.blocks[0].Header.GasLimit, nil
+}
+
+// CalculateBaseFee returns no base fee, as the blocks of the simulation carry no transactions
+func (b *blockchain) CalculateBaseFee(_ *types.Header) uint64 {
+	return 0
+}
+
+// syncer serves the blocks of the reachable validators that are ahead of the node
+type syncer struct {
+	node *Node
+
+	// peers maps the last returned sync peer to its validator
+	peers map[*protocol.SyncPeer]*Node
+}
+
+func (s *syncer) Start() {}
+
+// BestPeer returns the highest running validator reachable by the node, if it is ahead
+func (s *syncer) BestPeer() *protocol.SyncPeer {
+	if !s.node.running {
+		return nil
+	}
+
+	var best *Node
+
+	for _, other := range s.node.sim.nodes {
+		if other == s.node || !other.running || !s.node.sim.network.Reachable(other.index, s.node.index) {
+			continue
+		}
+
+		if other.Height() > s.node.Height() && (best == nil || other.Height() > best.Height()) {
+			best = other
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	head := best.chain.Header()
+	p := protocol.NewSyncPeer(peer.ID(fmt.Sprintf("node-%d", best.index)), &protocol.Status{
+		Difficulty: new(big.Int).SetUint64(head.Number),
+		Hash:       head.Hash,
+		Number:     head.Number,
+	})
+
+	s.peers = map[*protocol.SyncPeer]*Node{p: best}
+
+	return p
+}
+
+// BulkSyncWithPeer verifies and writes the blocks of the peer that the node is missing
+func (s *syncer) BulkSyncWithPeer(p *protocol.SyncPeer, newBlockHandler func(block *types.Block)) error {
+	if err := s.bulkSync(p, newBlockHandler); err != nil {
+		// back off, since the engine retries right away
+		s.node.Wait(s.node.Now().Add(time.Second), nil, nil)
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *syncer) bulkSync(p *protocol.SyncPeer, newBlockHandler func(block *types.Block)) error {
+	source, ok := s.peers[p]
+	if !ok {
+		return errUnknownNode
+	}
+
+	for number := s.node.Height() + 1; number <= p.Number(); number++ {
+		block, ok := source.Block(number)
+		if !ok {
+			return fmt.Errorf("block %d not found", number)
+		}
+
+		if err := s.node.engine.VerifyHeader(block.Header); err != nil {
+			return err
+		}
+
+		if err := s.node.chain.WriteBlock(block); err != nil {
+			return err
+		}
+
+		newBlockHandler(block)
+	}
+
+	return nil
+}
+
+// WatchSyncWithPeer waits for a block time, as the validators gossip no new blocks
+func (s *syncer) WatchSyncWithPeer(
+	_ *protocol.SyncPeer,
+	_ func(b *types.Block) bool,
+	blockTimeout time.Duration,
+) {
+	s.node.Wait(s.node.Now().Add(blockTimeout), nil, nil)
+}
+
+func (s *syncer) GetSyncProgression() *progress.Progression {
+	return nil
+}
+
+func (s *syncer) Broadcast(_ *types.Block) {}
+
+// txpool is an empty transaction pool
+type txpool struct{}
+
+func (p *txpool) Prepare(_ uint64) {}
+
+func (p *txpool) Length() uint64 {
+	return 0
+}
+
+func (p *txpool) PendingGas() uint64 {
+	return 0
+}
+
+func (p *txpool) Peek() *types.Transaction {
+	return nil
+}
+
+func (p *txpool) Pop(_ *types.Transaction) {}
+
+func (p *txpool) Drop(_ *types.Transaction) {}
+
+func (p *txpool) Demote(_ *types.Transaction) {}
+
+func (p *txpool) ResetWithHeaders(_ ...*types.Header) {}
@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Violation is a height at which two validators have finalized different blocks
+type Violation struct {
+	Height uint64
+	Nodes  [2]int        // Indexes of the validators
+	Hashes [2]types.Hash // Hashes of their blocks
+}
+
+// Error describes the violation
+func (v *Violation) Error() string {
+	return fmt.Sprintf(
+		"conflicting blocks at height %d: %s by node %d and %s by node %d",
+		v.Height, v.Hashes[0], v.Nodes[0], v.Hashes[1], v.Nodes[1],
+	)
+}
+
+// finalizedBlock is the first block finalized at a height, and the validator that finalized it
+type finalizedBlock struct {
+	hash types.Hash
+	node int
+}
+
+// Checker verifies the safety of the consensus, by checking that
+// the validators never finalize two different blocks at the same height
+type Checker struct {
+	finalized  map[uint64]finalizedBlock
+	violations []*Violation
+}
+
+// NewChecker creates a safety checker
+func NewChecker() *Checker {
+	return &Checker{
+		finalized: make(map[uint64]finalizedBlock),
+	}
+}
+
+// Observe records a block finalized by a validator, and reports a violation
+// if another validator has finalized a different block at the same height
+func (c *Checker) Observe(node int, block *types.Block) {
+	height := block.Number()
+
+	first, ok := c.finalized[height]
+	if !ok {
+		c.finalized[height] = finalizedBlock{
+			hash: block.Hash(),
+			node: node,
+		}
+
+		return
+	}
+
+	if first.hash != block.Hash() {
+		c.violations = append(c.violations, &Violation{
+			Height: height,
+			Nodes:  [2]int{first.node, node},
+			Hashes: [2]types.Hash{first.hash, block.Hash()},
+		})
+	}
+}
+
+// Violations returns all the violations found so far
+func (c *Checker) Violations() []*Violation {
+	return c.violations
+}
+
+// Err returns the first violation, or nil if the consensus has been safe
+func (c *Checker) Err() error {
+	if len(c.violations) == 0 {
+		return nil
+	}
+
+	return c.violations[0]
+}
@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker(t *testing.T) {
+	block := func(number uint64, hash string) *types.Block {
+		return &types.Block{
+			Header: &types.Header{
+				Number: number,
+				Hash:   types.StringToHash(hash),
+			},
+		}
+	}
+
+	c := NewChecker()
+
+	c.Observe(0, block(1, "0x1"))
+	c.Observe(1, block(1, "0x1"))
+	c.Observe(0, block(2, "0x2"))
+	assert.NoError(t, c.Err())
+
+	c.Observe(2, block(2, "0x3"))
+	c.Observe(3, block(2, "0x2"))
+
+	assert.Equal(t, []*Violation{
+		{
+			Height: 2,
+			Nodes:  [2]int{0, 2},
+			Hashes: [2]types.Hash{types.StringToHash("0x2"), types.StringToHash("0x3")},
+		},
+	}, c.Violations())
+	assert.EqualError(t, c.Err(), c.Violations()[0].Error())
+}
@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+)
+
+// NetworkConfig are the conditions of the simulated network
+type NetworkConfig struct {
+	Latency  time.Duration // Base delay of every message
+	Jitter   time.Duration // Maximum random delay added to the base delay
+	DropRate float64       // Probability of a message to be lost, between 0 and 1
+}
+
+// Network delivers the messages between the validators of a simulation
+type Network struct {
+	config NetworkConfig
+	rand   *rand.Rand
+
+	// partition maps every validator to its group, nil when the network is not partitioned
+	partition map[int]int
+
+	sent    int
+	dropped int
+}
+
+func newNetwork(config NetworkConfig, rand *rand.Rand) *Network {
+	return &Network{
+		config: config,
+		rand:   rand,
+	}
+}
+
+// Sent returns the number of messages sent so far
+func (n *Network) Sent() int {
+	return n.sent
+}
+
+// Dropped returns the number of messages lost so far, by the drop rate or the partitions
+func (n *Network) Dropped() int {
+	return n.dropped
+}
+
+// SetConditions changes the latency, jitter and drop rate of the messages sent from now on
+func (n *Network) SetConditions(config NetworkConfig) {
+	n.config = config
+}
+
+// Partition splits the validators into groups that can only reach each other.
+// The validators that are not part of any group are isolated
+func (n *Network) Partition(groups ...[]int) {
+	n.partition = make(map[int]int)
+
+	for group, members := range groups {
+		for _, index := range members {
+			n.partition[index] = group
+		}
+	}
+}
+
+// Heal removes the partitions of the network
+func (n *Network) Heal() {
+	n.partition = nil
+}
+
+// Reachable checks if a message from one validator can reach another
+func (n *Network) Reachable(from, to int) bool {
+	if n.partition == nil {
+		return true
+	}
+
+	fromGroup, ok := n.partition[from]
+	if !ok {
+		return false
+	}
+
+	toGroup, ok := n.partition[to]
+
+	return ok && fromGroup == toGroup
+}
+
+// delay returns the delay of the next message
+func (n *Network) delay() time.Duration {
+	delay := n.config.Latency
+
+	if n.config.Jitter > 0 {
+		delay += time.Duration(n.rand.Int63n(int64(n.config.Jitter)))
+	}
+
+	return delay
+}
+
+// lost checks if the next message is lost
+func (n *Network) lost() bool {
+	return n.config.DropRate > 0 && n.rand.Float64() < n.config.DropRate
+}
+
+// gossip sends a message of the node to every other validator,
+// through the behaviour of the node if it is Byzantine
+func (n *Network) gossip(from *Node, msg *proto.MessageReq) {
+	for _, to := range from.sim.nodes {
+		if to == from {
+			continue
+		}
+
+		msgs := []*proto.MessageReq{msg}
+		if from.behaviour != nil {
+			msgs = from.behaviour.Gossip(from, msg, to.index)
+		}
+
+		for _, out := range msgs {
+			n.send(from, to, out)
+		}
+	}
+}
+
+// send schedules the delivery of a copy of the message, unless it is lost
+func (n *Network) send(from, to *Node, msg *proto.MessageReq) {
+	n.sent++
+
+	if !n.Reachable(from.index, to.index) || n.lost() {
+		n.dropped++
+
+		return
+	}
+
+	// the receiver recovers the sender into the message, so every delivery gets its own copy
+	msg = msg.Copy()
+
+	from.sim.schedule(from.sim.now.Add(n.delay()), func() {
+		// a partition also cuts the messages in flight
+		if !n.Reachable(from.index, to.index) {
+			n.dropped++
+
+			return
+		}
+
+		to.deliver(msg)
+	})
+}
@@ -0,0 +1,214 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Node is a validator of the simulation
+type Node struct {
+	index     int
+	sim       *Simulation
+	key       *ecdsa.PrivateKey
+	address   types.Address
+	behaviour Behaviour
+	params    map[string]interface{} // IBFT parameters of the engine
+
+	chain    *blockchain
+	executor *state.Executor
+	engine   *ibft.SimulationEngine
+
+	running bool // Flag indicating if the state machine of the node is running
+
+	// The scheduling state of the node, updated while it holds the control
+	waiting    bool                 // Flag indicating if the node waits on its clock
+	wakeable   bool                 // Flag indicating if a new message ends the wait
+	generation uint64               // Counter of the waits, to discard the stale timers
+	resumeCh   chan ibft.WaitResult // Channel giving the control back to the node
+}
+
+func newNode(s *Simulation, index int, key *ecdsa.PrivateKey) (*Node, error) {
+	n := &Node{
+		index:     index,
+		sim:       s,
+		key:       key,
+		address:   crypto.PubKeyToAddress(&key.PublicKey),
+		behaviour: s.config.Behaviours[index],
+		params:    s.config.Engine,
+		resumeCh:  make(chan ibft.WaitResult),
+	}
+
+	if params, ok := s.config.Engines[index]; ok {
+		n.params = params
+	}
+
+	n.executor = state.NewExecutor(
+		&chain.Params{Forks: chain.AllForksEnabled},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		s.logger,
+	)
+
+	if root := n.executor.WriteGenesis(nil); root != s.genesis.StateRoot {
+		return nil, fmt.Errorf("unexpected genesis state root %s", root)
+	}
+
+	n.chain = newBlockchain(n, s.genesis)
+	n.executor.GetHash = n.chain.getHashHelper
+
+	return n, nil
+}
+
+// Index returns the index of the node in the validator set of the genesis
+func (n *Node) Index() int {
+	return n.index
+}
+
+// Address returns the validator address of the node
+func (n *Node) Address() types.Address {
+	return n.address
+}
+
+// Running checks if the state machine of the node is running
+func (n *Node) Running() bool {
+	return n.running
+}
+
+// Height returns the number of the latest block of the node
+func (n *Node) Height() uint64 {
+	return n.chain.Header().Number
+}
+
+// Block returns the block of the node at the given height
+func (n *Node) Block(number uint64) (*types.Block, bool) {
+	return n.chain.getBlock(number)
+}
+
+// Engine returns the IBFT engine of the node, nil if it is not running
+func (n *Node) Engine() *ibft.SimulationEngine {
+	if !n.running {
+		return nil
+	}
+
+	return n.engine
+}
+
+// start creates a new engine on top of the chain of the node, and runs it until its first wait
+func (n *Node) start() error {
+	if n.running {
+		return errNodeRunning
+	}
+
+	engine, err := ibft.NewForSimulation(&ibft.SimulationConfig{
+		Logger:       n.sim.logger.Named(fmt.Sprintf("node-%d", n.index)),
+		Config:       n.params,
+		BlockTime:    n.sim.config.BlockTime,
+		ValidatorKey: n.key,
+		Executor:     n.executor,
+		Blockchain:   n.chain,
+		Txpool:       &txpool{},
+		Syncer:       &syncer{node: n},
+	}, &transport{node: n}, n)
+	if err != nil {
+		return err
+	}
+
+	n.engine = engine
+	n.running = true
+
+	go func() {
+		engine.Run()
+
+		n.waiting = false
+		n.sim.yieldCh <- struct{}{}
+	}()
+
+	<-n.sim.yieldCh
+
+	return nil
+}
+
+// stop closes the engine of the node, and runs it until its state machine returns
+func (n *Node) stop() {
+	if !n.running {
+		return
+	}
+
+	n.running = false
+	_ = n.engine.Close()
+
+	if n.waiting {
+		n.resume(ibft.WaitDone)
+	}
+}
+
+// resume gives the control to the node, until its next wait
+func (n *Node) resume(result ibft.WaitResult) {
+	n.waiting = false
+	n.resumeCh <- result
+	<-n.sim.yieldCh
+}
+
+// deliver hands a message received from the network to the engine
+func (n *Node) deliver(msg *proto.MessageReq) {
+	if !n.running {
+		return
+	}
+
+	n.engine.Deliver(msg)
+
+	if n.waiting && n.wakeable {
+		n.resume(ibft.WaitWoken)
+	}
+}
+
+// Now returns the virtual time, as the clock of the engine
+func (n *Node) Now() time.Time {
+	return n.sim.now
+}
+
+// Wait gives the control back to the simulation until the deadline passes,
+// a message is delivered (if wake is set) or the node is stopped.
+// The wake channel is only checked for its presence, as the simulation
+// knows whenever it would fire
+func (n *Node) Wait(deadline time.Time, wake, _ <-chan struct{}) ibft.WaitResult {
+	if !n.running {
+		return ibft.WaitDone
+	}
+
+	n.generation++
+	n.waiting = true
+	n.wakeable = wake != nil
+
+	generation := n.generation
+
+	n.sim.schedule(deadline, func() {
+		if n.waiting && n.generation == generation {
+			n.resume(ibft.WaitExpired)
+		}
+	})
+
+	n.sim.yieldCh <- struct{}{}
+
+	return <-n.resumeCh
+}
+
+// transport sends the messages of a node through the simulated network
+type transport struct {
+	node *Node
+}
+
+// Gossip sends the message to every other validator, as decided by the behaviour of the node
+func (t *transport) Gossip(msg *proto.MessageReq) error {
+	t.node.sim.network.gossip(t.node, msg)
+
+	return nil
+}
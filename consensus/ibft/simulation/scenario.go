@@ -0,0 +1,221 @@
+package simulation
+
+import (
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Action is a change of the simulation, applied at a scripted time
+type Action func(s *Simulation)
+
+// Step is an action of a scenario, at a virtual time since the start of the simulation
+type Step struct {
+	At     time.Duration
+	Action Action
+}
+
+// Scenario is a scripted simulation
+type Scenario struct {
+	Name   string
+	Config Config
+	Steps  []Step
+
+	// Height is the chain height that every running honest validator must reach
+	Height uint64
+	// Timeout is the virtual time limit of the scenario
+	Timeout time.Duration
+	// Stalls marks the scenarios in which the honest validators are known not to reach the height.
+	// Only the safety of their chains is checked
+	Stalls bool
+}
+
+// Result is the outcome of a scenario
+type Result struct {
+	Reached    bool           // Flag indicating if the honest validators reached the height
+	Elapsed    time.Duration  // Virtual time of the run
+	Events     int            // Number of fired events
+	Heights    []uint64       // Chain heights of the validators
+	Hashes     [][]types.Hash // Block hashes of the validators, by height
+	Violations []*Violation   // Safety violations
+}
+
+// Run runs the scenario until the honest validators reach its height, or until its timeout
+func (sc *Scenario) Run() (*Result, error) {
+	config := sc.Config
+
+	s, err := New(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.Stop()
+
+	for _, step := range sc.Steps {
+		s.Schedule(step.At, step.Action)
+	}
+
+	reached := s.RunUntil(sc.Timeout, func(s *Simulation) bool {
+		return s.HonestHeight() >= sc.Height
+	})
+
+	result := &Result{
+		Reached:    reached,
+		Elapsed:    s.Elapsed(),
+		Events:     s.Events(),
+		Heights:    s.Heights(),
+		Hashes:     make([][]types.Hash, len(s.nodes)),
+		Violations: s.checker.Violations(),
+	}
+
+	for index, node := range s.nodes {
+		for number := uint64(1); number <= node.Height(); number++ {
+			block, _ := node.Block(number)
+			result.Hashes[index] = append(result.Hashes[index], block.Hash())
+		}
+	}
+
+	return result, nil
+}
+
+// Partition splits the validators into groups that can only reach each other
+func Partition(groups ...[]int) Action {
+	return func(s *Simulation) {
+		s.network.Partition(groups...)
+	}
+}
+
+// Heal removes the partitions of the network
+func Heal() Action {
+	return func(s *Simulation) {
+		s.network.Heal()
+	}
+}
+
+// SetConditions changes the latency, jitter and drop rate of the network
+func SetConditions(config NetworkConfig) Action {
+	return func(s *Simulation) {
+		s.network.SetConditions(config)
+	}
+}
+
+// Crash stops the validator, which keeps its chain
+func Crash(index int) Action {
+	return func(s *Simulation) {
+		if node := s.Node(index); node != nil {
+			node.stop()
+		}
+	}
+}
+
+// Restart starts a new engine on the chain of a crashed validator
+func Restart(index int) Action {
+	return func(s *Simulation) {
+		node := s.Node(index)
+		if node == nil || node.running {
+			return
+		}
+
+		if err := node.start(); err != nil {
+			s.logger.Error("failed to restart the node", "node", index, "err", err)
+		}
+	}
+}
+
+// Upgrade restarts the validator with new IBFT parameters, as an operator upgrading its node
+func Upgrade(index int, params map[string]interface{}) Action {
+	return func(s *Simulation) {
+		node := s.Node(index)
+		if node == nil {
+			return
+		}
+
+		node.stop()
+		node.params = params
+
+		if err := node.start(); err != nil {
+			s.logger.Error("failed to upgrade the node", "node", index, "err", err)
+		}
+	}
+}
+
+// Scenarios returns the scripted scenarios of four validators,
+// which tolerate one faulty validator
+func Scenarios() []*Scenario {
+	network := NetworkConfig{
+		Latency: 50 * time.Millisecond,
+		Jitter:  100 * time.Millisecond,
+	}
+
+	return []*Scenario{
+		{
+			Name:    "honest validators",
+			Config:  Config{Validators: 4, Network: network},
+			Height:  10,
+			Timeout: 10 * time.Minute,
+		},
+		{
+			Name: "lossy network",
+			Config: Config{Validators: 4, Network: NetworkConfig{
+				Latency:  network.Latency,
+				Jitter:   network.Jitter,
+				DropRate: 0.1,
+			}},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+		},
+		{
+			Name:   "isolated validator catches up",
+			Config: Config{Validators: 4, Network: network},
+			Steps: []Step{
+				{At: 5 * time.Second, Action: Partition([]int{0, 1, 2}, []int{3})},
+				{At: 2 * time.Minute, Action: Heal()},
+			},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+		},
+		{
+			Name:   "split network heals",
+			Config: Config{Validators: 4, Network: network},
+			Steps: []Step{
+				{At: 5 * time.Second, Action: Partition([]int{0, 1}, []int{2, 3})},
+				{At: 2 * time.Minute, Action: Heal()},
+			},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+		},
+		{
+			Name:   "crashed validator restarts",
+			Config: Config{Validators: 4, Network: network},
+			Steps: []Step{
+				{At: 5 * time.Second, Action: Crash(1)},
+				{At: 2 * time.Minute, Action: Restart(1)},
+			},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+		},
+		{
+			Name: "silent validator",
+			Config: Config{
+				Validators: 4,
+				Network:    network,
+				Behaviours: map[int]Behaviour{0: Silent{}},
+			},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+		},
+		{
+			// the validators locked on the block of one proposal only send a commit once it is
+			// proposed again, so the other validators never gather a quorum of prepare messages
+			Name: "equivocating proposer",
+			Config: Config{
+				Validators: 4,
+				Network:    network,
+				Behaviours: map[int]Behaviour{0: &Equivocate{Peers: []int{2, 3}}},
+			},
+			Height:  10,
+			Timeout: 30 * time.Minute,
+			Stalls:  true,
+		},
+	}
+}
@@ -0,0 +1,325 @@
+// Package simulation runs IBFT validators in a single process, on a virtual clock.
+//
+// Every validator runs the real IBFT state machine, wired to an in-memory blockchain
+// and to a simulated network. Only one validator runs at a time: it hands control back
+// to the simulation whenever it waits on its clock, and the simulation then fires the
+// next event (a message delivery, a timer or a scripted action) in virtual time order.
+// With the same configuration and seed, a run always produces the same chains
+package simulation
+
+import (
+	"container/heap"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	defaultBlockTime = 2 // seconds
+)
+
+var (
+	// genesisTime is the starting point of the virtual clock
+	genesisTime = time.Unix(1600000000, 0)
+
+	errNoValidators     = errors.New("at least one validator is required")
+	errUnknownNode      = errors.New("unknown node")
+	errNodeRunning      = errors.New("node is already running")
+	errInvalidBehaviour = errors.New("behaviour of an unknown node")
+	errInvalidEngine    = errors.New("IBFT parameters of an unknown node")
+)
+
+// Config is the configuration of a simulation
+type Config struct {
+	Validators int                            // Number of validators in the genesis
+	Seed       int64                          // Seed of the keys and of the network randomness
+	BlockTime  uint64                         // Minimum block time in seconds
+	Engine     map[string]interface{}         // IBFT parameters, as in the genesis file. PoA from the justification fork if not set
+	Engines    map[int]map[string]interface{} // IBFT parameters of single validators, by index. Engine if not set
+	Network    NetworkConfig                  // Initial conditions of the network
+	Behaviours map[int]Behaviour              // Byzantine behaviours of the validators, by index
+	Logger     hclog.Logger
+}
+
+// Simulation is a set of IBFT validators running on a virtual clock
+type Simulation struct {
+	config  *Config
+	logger  hclog.Logger
+	now     time.Time
+	events  eventQueue
+	seq     uint64
+	fired   int
+	nodes   []*Node
+	network *Network
+	checker *Checker
+	genesis *types.Header
+
+	// yieldCh is signaled by the running node once it waits or exits
+	yieldCh chan struct{}
+}
+
+// New creates a simulation and starts all of its validators
+func New(config *Config) (*Simulation, error) {
+	if config.Validators <= 0 {
+		return nil, errNoValidators
+	}
+
+	for index := range config.Behaviours {
+		if index < 0 || index >= config.Validators {
+			return nil, fmt.Errorf("%w: %d", errInvalidBehaviour, index)
+		}
+	}
+
+	for index := range config.Engines {
+		if index < 0 || index >= config.Validators {
+			return nil, fmt.Errorf("%w: %d", errInvalidEngine, index)
+		}
+	}
+
+	if config.BlockTime == 0 {
+		config.BlockTime = defaultBlockTime
+	}
+
+	if config.Engine == nil {
+		config.Engine = map[string]interface{}{
+			"type":                  string(ibft.PoA),
+			"justificationBlockNum": float64(0),
+		}
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+
+	s := &Simulation{
+		config:  config,
+		logger:  logger,
+		now:     genesisTime,
+		network: newNetwork(config.Network, rand.New(rand.NewSource(config.Seed))), //nolint:gosec
+		checker: NewChecker(),
+		yieldCh: make(chan struct{}),
+	}
+
+	keys := make([]*ecdsa.PrivateKey, config.Validators)
+	validators := make([]types.Address, config.Validators)
+
+	for index := range keys {
+		key, err := validatorKey(config.Seed, index)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[index] = key
+		validators[index] = crypto.PubKeyToAddress(&key.PublicKey)
+	}
+
+	s.genesis = ibft.SimulationGenesis(&chain.Genesis{
+		Timestamp: uint64(genesisTime.Unix()),
+		GasLimit:  chain.GenesisGasLimit,
+	}, validators)
+
+	for index, key := range keys {
+		node, err := newNode(s, index, key)
+		if err != nil {
+			return nil, err
+		}
+
+		s.nodes = append(s.nodes, node)
+	}
+
+	for _, node := range s.nodes {
+		if err := node.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// validatorKey derives the key of a validator from the seed of the simulation
+func validatorKey(seed int64, index int) (*ecdsa.PrivateKey, error) {
+	return crypto.ParsePrivateKey(
+		crypto.Keccak256([]byte(fmt.Sprintf("ibft-simulation-%d-%d", seed, index))),
+	)
+}
+
+// Now returns the virtual time of the simulation
+func (s *Simulation) Now() time.Time {
+	return s.now
+}
+
+// Elapsed returns the virtual time since the start of the simulation
+func (s *Simulation) Elapsed() time.Duration {
+	return s.now.Sub(genesisTime)
+}
+
+// Events returns the number of events fired so far
+func (s *Simulation) Events() int {
+	return s.fired
+}
+
+// Nodes returns the validators of the simulation
+func (s *Simulation) Nodes() []*Node {
+	return s.nodes
+}
+
+// Node returns the validator with the given index
+func (s *Simulation) Node(index int) *Node {
+	if index < 0 || index >= len(s.nodes) {
+		return nil
+	}
+
+	return s.nodes[index]
+}
+
+// Network returns the simulated network
+func (s *Simulation) Network() *Network {
+	return s.network
+}
+
+// Checker returns the safety checker, which observes every finalized block
+func (s *Simulation) Checker() *Checker {
+	return s.checker
+}
+
+// Schedule runs the action at the given virtual time since the start of the simulation
+func (s *Simulation) Schedule(at time.Duration, action Action) {
+	s.schedule(genesisTime.Add(at), func() {
+		action(s)
+	})
+}
+
+// RunUntil fires the events in order until the condition holds,
+// or until the virtual time limit since the start of the simulation.
+// It returns true if the condition was met
+func (s *Simulation) RunUntil(limit time.Duration, cond func(*Simulation) bool) bool {
+	deadline := genesisTime.Add(limit)
+
+	for {
+		if cond != nil && cond(s) {
+			return true
+		}
+
+		if s.events.Len() == 0 || s.events[0].at.After(deadline) {
+			s.now = deadline
+
+			return false
+		}
+
+		ev, _ := heap.Pop(&s.events).(*event)
+		if ev.at.After(s.now) {
+			s.now = ev.at
+		}
+
+		s.fired++
+		ev.fire()
+	}
+}
+
+// Run fires the events until the virtual time limit since the start of the simulation
+func (s *Simulation) Run(limit time.Duration) {
+	s.RunUntil(limit, nil)
+}
+
+// Stop closes all the validators that are still running
+func (s *Simulation) Stop() {
+	for _, node := range s.nodes {
+		node.stop()
+	}
+}
+
+// Heights returns the chain height of every validator
+func (s *Simulation) Heights() []uint64 {
+	heights := make([]uint64, len(s.nodes))
+
+	for index, node := range s.nodes {
+		heights[index] = node.Height()
+	}
+
+	return heights
+}
+
+// HonestHeight returns the lowest chain height of the running honest validators
+func (s *Simulation) HonestHeight() uint64 {
+	var (
+		height uint64
+		found  bool
+	)
+
+	for _, node := range s.nodes {
+		if !node.Running() || node.behaviour != nil {
+			continue
+		}
+
+		if !found || node.Height() < height {
+			height = node.Height()
+			found = true
+		}
+	}
+
+	return height
+}
+
+// schedule adds an event at the given time, or now if the time has already passed
+func (s *Simulation) schedule(at time.Time, fire func()) {
+	if at.Before(s.now) {
+		at = s.now
+	}
+
+	s.seq++
+	heap.Push(&s.events, &event{
+		at:   at,
+		seq:  s.seq,
+		fire: fire,
+	})
+}
+
+// event is a callback fired at a point of the virtual time
+type event struct {
+	at   time.Time
+	seq  uint64 // Insertion order, to break the ties between events at the same time
+	fire func()
+}
+
+// eventQueue is a min-heap of events, by time and insertion order
+type eventQueue []*event
+
+func (q eventQueue) Len() int {
+	return len(q)
+}
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+
+	return q[i].at.Before(q[j].at)
+}
+
+func (q eventQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *eventQueue) Push(x interface{}) {
+	ev, _ := x.(*event)
+	*q = append(*q, ev)
+}
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	ev := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return ev
+}
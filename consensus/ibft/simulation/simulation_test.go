@@ -0,0 +1,158 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenarios(t *testing.T) {
+	for _, sc := range Scenarios() {
+		sc := sc
+
+		t.Run(sc.Name, func(t *testing.T) {
+			result, err := sc.Run()
+
+			assert.NoError(t, err)
+			assert.Empty(t, result.Violations)
+			assert.Equal(t, !sc.Stalls, result.Reached, "heights %v after %s", result.Heights, result.Elapsed)
+		})
+	}
+}
+
+func TestSimulation_Deterministic(t *testing.T) {
+	scenario := func() *Scenario {
+		return &Scenario{
+			Config: Config{
+				Validators: 4,
+				Seed:       7,
+				Network: NetworkConfig{
+					Latency:  50 * time.Millisecond,
+					Jitter:   200 * time.Millisecond,
+					DropRate: 0.1,
+				},
+				Behaviours: map[int]Behaviour{1: &Equivocate{Peers: []int{0}}},
+			},
+			Height:  5,
+			Timeout: 30 * time.Minute,
+		}
+	}
+
+	first, err := scenario().Run()
+	assert.NoError(t, err)
+
+	second, err := scenario().Run()
+	assert.NoError(t, err)
+
+	assert.True(t, first.Reached)
+	assert.Equal(t, first.Hashes, second.Hashes)
+	assert.Equal(t, first.Events, second.Events)
+	assert.Equal(t, first.Elapsed, second.Elapsed)
+}
+
+func TestSimulation_SplitNetworkHalts(t *testing.T) {
+	s, err := New(&Config{Validators: 4})
+	assert.NoError(t, err)
+
+	defer s.Stop()
+
+	// no group has a quorum of 3 validators
+	s.Schedule(0, Partition([]int{0, 1}, []int{2, 3}))
+	s.Run(5 * time.Minute)
+
+	assert.Equal(t, []uint64{0, 0, 0, 0}, s.Heights())
+
+	s.Schedule(s.Elapsed(), Heal())
+
+	assert.True(t, s.RunUntil(30*time.Minute, func(s *Simulation) bool {
+		return s.HonestHeight() >= 3
+	}))
+	assert.NoError(t, s.Checker().Err())
+}
+
+func TestSimulation_CrashedValidators(t *testing.T) {
+	s, err := New(&Config{Validators: 4})
+	assert.NoError(t, err)
+
+	defer s.Stop()
+
+	// a single crashed validator is tolerated
+	s.Schedule(0, Crash(3))
+
+	assert.True(t, s.RunUntil(10*time.Minute, func(s *Simulation) bool {
+		return s.HonestHeight() >= 3
+	}))
+
+	// two of them are not
+	s.Schedule(s.Elapsed(), Crash(2))
+	s.Run(s.Elapsed() + 5*time.Minute)
+
+	heights := s.Heights()
+	assert.Equal(t, heights[0], heights[1])
+	assert.LessOrEqual(t, heights[0], uint64(4))
+
+	// the validators make progress again once one of them is back
+	s.Schedule(s.Elapsed(), Restart(2))
+
+	assert.True(t, s.RunUntil(s.Elapsed()+30*time.Minute, func(s *Simulation) bool {
+		return s.HonestHeight() >= heights[0]+3
+	}))
+	assert.NoError(t, s.Checker().Err())
+}
+
+func TestSimulation_MixedVersionsAcrossFork(t *testing.T) {
+	legacy := map[string]interface{}{
+		"type": string(ibft.PoA),
+	}
+	upgraded := map[string]interface{}{
+		"type":                  string(ibft.PoA),
+		"justificationBlockNum": float64(5),
+	}
+
+	// half of the validators run a version without the justification rules,
+	// so every quorum has validators of both versions
+	s, err := New(&Config{
+		Validators: 4,
+		Engine:     upgraded,
+		Engines: map[int]map[string]interface{}{
+			0: legacy,
+			1: legacy,
+		},
+	})
+	assert.NoError(t, err)
+
+	defer s.Stop()
+
+	// the upgraded validators send the legacy messages before the fork
+	assert.True(t, s.RunUntil(10*time.Minute, func(s *Simulation) bool {
+		return s.HonestHeight() >= 4
+	}), "heights %v", s.Heights())
+
+	// the other validators are upgraded before the fork, and the chain goes on across it
+	s.Schedule(s.Elapsed(), Upgrade(0, upgraded))
+	s.Schedule(s.Elapsed(), Upgrade(1, upgraded))
+
+	assert.True(t, s.RunUntil(s.Elapsed()+30*time.Minute, func(s *Simulation) bool {
+		return s.HonestHeight() >= 10
+	}), "heights %v", s.Heights())
+	assert.NoError(t, s.Checker().Err())
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := New(&Config{})
+	assert.ErrorIs(t, err, errNoValidators)
+
+	_, err = New(&Config{
+		Validators: 4,
+		Behaviours: map[int]Behaviour{4: Silent{}},
+	})
+	assert.ErrorIs(t, err, errInvalidBehaviour)
+
+	_, err = New(&Config{
+		Validators: 4,
+		Engines:    map[int]map[string]interface{}{-1: nil},
+	})
+	assert.ErrorIs(t, err, errInvalidEngine)
+}
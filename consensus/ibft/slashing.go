@@ -0,0 +1,197 @@
+package ibft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// DefaultSlashPercentage is the percentage of the stake burned for an equivocation, if not set
+	DefaultSlashPercentage = 10
+
+	// DefaultJailEpochs is the number of epochs during which a penalized validator is jailed, if not set
+	DefaultJailEpochs = 2
+)
+
+var (
+	ErrInvalidSlashPercentage = errors.New("slash percentage must be at most 100")
+	errSystemTxNotHandled     = errors.New("system transaction not handled by the consensus")
+)
+
+// SlashingConfig is the configuration of the penalties of the PoS validators.
+// The validators that equivocate are slashed and jailed, the offline ones are jailed
+// if they missed at least the downtime threshold of proposals in an epoch
+type SlashingConfig struct {
+	SlashPercentage   common.JSONNumber `json:"slashPercentage"`
+	JailEpochs        common.JSONNumber `json:"jailEpochs"`
+	DowntimeThreshold common.JSONNumber `json:"downtimeThreshold"`
+}
+
+// DefaultSlashingConfig returns the slashing configuration used if the IBFT config doesn't set one.
+// The offline validators aren't jailed by default
+func DefaultSlashingConfig() *SlashingConfig {
+	return &SlashingConfig{
+		SlashPercentage: common.JSONNumber{Value: DefaultSlashPercentage},
+		JailEpochs:      common.JSONNumber{Value: DefaultJailEpochs},
+	}
+}
+
+// GetSlashingConfig returns the slashing configuration of the IBFT config
+func GetSlashingConfig(ibftConfig map[string]interface{}) (*SlashingConfig, error) {
+	rawConfig, ok := ibftConfig["slashing"]
+	if !ok {
+		return DefaultSlashingConfig(), nil
+	}
+
+	raw, err := json.Marshal(rawConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &SlashingConfig{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, fmt.Errorf("invalid slashing config, %w", err)
+	}
+
+	if config.SlashPercentage.Value > 100 {
+		return nil, ErrInvalidSlashPercentage
+	}
+
+	return config, nil
+}
+
+// systemTxHookParams are the params passed into the SystemTxHook
+type systemTxHookParams struct {
+	number     uint64
+	txn        *types.Transaction
+	transition *state.Transition
+
+	// handled is set by the hook applying the system transaction
+	handled bool
+}
+
+// applySystemTx applies a system transaction of the block with the hook of the mechanism handling it.
+// The block is invalid if no mechanism handles the transaction
+func (i *Ibft) applySystemTx(transition *state.Transition, txn *types.Transaction) error {
+	params := &systemTxHookParams{
+		number:     uint64(transition.GetTxContext().Number),
+		txn:        txn,
+		transition: transition,
+	}
+
+	if err := i.runHook(SystemTxHook, params.number, params); err != nil {
+		return err
+	}
+
+	if !params.handled {
+		return errSystemTxNotHandled
+	}
+
+	return nil
+}
+
+// writeEvidence writes the system transactions reporting the pending equivocations,
+// and returns the ones included in the block. The equivocations that can't be reported are dropped
+func (i *Ibft) writeEvidence(number uint64, transition transitionInterface) []*types.Transaction {
+	if i.evidence == nil {
+		return nil
+	}
+
+	i.evidence.prune(number)
+
+	var transactions []*types.Transaction
+
+	for _, e := range i.evidence.list() {
+		// the equivocations of the current height are reported in the next blocks
+		if e.sequence() >= number {
+			continue
+		}
+
+		input, err := e.encode()
+		if err == nil {
+			txn := types.NewSystemTx(
+				big.NewInt(int64(i.config.Params.ChainID)),
+				number,
+				staking.AddrStakingContract,
+				input,
+			)
+			txn.ComputeHash()
+
+			if err = transition.Write(txn); err == nil {
+				transactions = append(transactions, txn)
+
+				continue
+			}
+		}
+
+		i.logger.Debug("dropping equivocation evidence", "offender", e.offender(), "sequence", e.sequence(), "err", err)
+		i.evidence.drop(e)
+	}
+
+	return transactions
+}
+
+// observeEvidence looks for an equivocation of the sender of the validated message
+func (i *Ibft) observeEvidence(msg *proto.MessageReq) {
+	if i.evidence == nil {
+		return
+	}
+
+	if e := i.evidence.observe(msg); e != nil {
+		i.logger.Warn(
+			"validator equivocated",
+			"offender", e.offender(),
+			"sequence", e.first.View.Sequence,
+			"round", e.first.View.Round,
+			"type", e.first.Type,
+		)
+	}
+}
+
+// reportEquivocation adds the equivocation reported by the operator to the pending evidence
+func (i *Ibft) reportEquivocation(first, second *proto.MessageReq) error {
+	if i.evidence == nil {
+		return errors.New("equivocations aren't reported")
+	}
+
+	if first == nil || second == nil {
+		return fmt.Errorf("%w: missing message", errInvalidEvidence)
+	}
+
+	// the certificates aren't signed by the sender
+	e := &equivocation{
+		first:  first.Copy(),
+		second: second.Copy(),
+	}
+
+	for _, msg := range []*proto.MessageReq{e.first, e.second} {
+		msg.PreparedCertificate = nil
+		msg.RoundChangeCertificate = nil
+	}
+
+	if err := e.verify(); err != nil {
+		return err
+	}
+
+	if !i.evidence.add(e) {
+		return fmt.Errorf("%w: already reported", errInvalidEvidence)
+	}
+
+	i.logger.Warn(
+		"validator equivocation reported",
+		"offender", e.offender(),
+		"sequence", e.first.View.Sequence,
+		"round", e.first.View.Round,
+		"type", e.first.Type,
+	)
+
+	return nil
+}
@@ -0,0 +1,355 @@
+package ibft
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+const testChainID = 100
+
+// setTestExecutor sets an executor running the EVM on an empty in-memory state
+func setTestExecutor(ibft *Ibft) {
+	ibft.config.Params = &chain.Params{
+		ChainID: testChainID,
+		Forks:   chain.AllForksEnabled,
+	}
+	ibft.executor = state.NewExecutor(
+		ibft.config.Params,
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	ibft.executor.SetRuntime(evm.NewEVM())
+	ibft.executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+}
+
+// newSlashingIbft returns a PoS IBFT engine with the blocks of newLivenessIbft,
+// and the state root of the staking SC with the validators of the pool
+func newSlashingIbft(t *testing.T, pool *testerAccountPool) (*Ibft, types.Hash) {
+	t.Helper()
+
+	ibft, _ := newLivenessIbft(t, pool)
+
+	setTestExecutor(ibft)
+	ibft.slashing = DefaultSlashingConfig()
+	ibft.executor.SystemTxHandler = ibft.applySystemTx
+
+	initIbftMechanism(PoS, ibft)
+
+	contract, err := stakingHelper.PredeployStakingSC(pool.ValidatorSet(), stakingHelper.PredeployParams{
+		MinValidatorCount: 1,
+		MaxValidatorCount: 10,
+	})
+	assert.NoError(t, err)
+
+	root := ibft.executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		staking.AddrStakingContract: contract,
+	})
+
+	return ibft, root
+}
+
+// callStaking calls the view method of the staking SC, and returns its first output
+func callStaking(t *testing.T, transition *state.Transition, method string, args ...interface{}) interface{} {
+	t.Helper()
+
+	input, err := abis.StakingABI.Methods[method].Encode(args)
+	assert.NoError(t, err)
+
+	res := transition.Call2(types.ZeroAddress, staking.AddrStakingContract, input, big.NewInt(0), 100000)
+	assert.NoError(t, res.Err)
+
+	output, err := abis.StakingABI.Methods[method].Outputs.Decode(res.ReturnValue)
+	assert.NoError(t, err)
+
+	return output.(map[string]interface{})["0"]
+}
+
+// assertSystemTxErr checks the error of the system transaction, wrapped by the state transition
+func assertSystemTxErr(t *testing.T, err, expected error) {
+	t.Helper()
+
+	var appErr *state.TransitionApplicationError
+	if assert.ErrorAs(t, err, &appErr) {
+		assert.ErrorIs(t, appErr.Err, expected)
+	}
+}
+
+func TestGetSlashingConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected *SlashingConfig
+		err      error
+	}{
+		{
+			name:     "defaults without config",
+			config:   map[string]interface{}{},
+			expected: DefaultSlashingConfig(),
+		},
+		{
+			name: "config from genesis",
+			config: map[string]interface{}{
+				"slashing": map[string]interface{}{
+					"slashPercentage":   "0x32",
+					"jailEpochs":        "0x1",
+					"downtimeThreshold": "0x5",
+				},
+			},
+			expected: &SlashingConfig{
+				SlashPercentage:   common.JSONNumber{Value: 50},
+				JailEpochs:        common.JSONNumber{Value: 1},
+				DowntimeThreshold: common.JSONNumber{Value: 5},
+			},
+		},
+		{
+			name: "slash percentage above 100",
+			config: map[string]interface{}{
+				"slashing": map[string]interface{}{
+					"slashPercentage": "0x65",
+				},
+			},
+			err: ErrInvalidSlashPercentage,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config, err := GetSlashingConfig(c.config)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, config)
+		})
+	}
+}
+
+func TestPoS_SystemTxHook(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	nonValidatorKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	keyB := pool.get("B").priv
+	addrB := pool.get("B").Address()
+
+	equivocationTx := func(e *equivocation, number uint64) *types.Transaction {
+		txn := types.NewSystemTx(big.NewInt(testChainID), number, staking.AddrStakingContract, mustEncode(t, e))
+		txn.ComputeHash()
+
+		return txn
+	}
+
+	validEquivocation := &equivocation{
+		first:  signedMsg(t, keyB, proto.MessageReq_Commit, 2, 0, "digest1"),
+		second: signedMsg(t, keyB, proto.MessageReq_Commit, 2, 0, "digest2"),
+	}
+
+	t.Run("slashes and jails the equivocating validator", func(t *testing.T) {
+		ibft, root := newSlashingIbft(t, pool)
+
+		transition, err := ibft.executor.BeginTxn(root, &types.Header{Number: 3}, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		assert.NoError(t, transition.Write(equivocationTx(validEquivocation, 3)))
+
+		// 10% of the stake is burned
+		defaultStake := hex.DecodeHexToBig(strings.TrimPrefix(stakingHelper.DefaultStakedBalance, "0x"))
+		slashed := new(big.Int).Div(defaultStake, big.NewInt(10))
+
+		assert.Equal(t, new(big.Int).Sub(defaultStake, slashed), callStaking(t, transition, "accountStake", addrB))
+		assert.Equal(t, slashed, transition.GetBalance(types.ZeroAddress))
+
+		balance := new(big.Int).Mul(big.NewInt(4), defaultStake)
+		assert.Equal(t, balance.Sub(balance, slashed), transition.GetBalance(staking.AddrStakingContract))
+
+		// the validator is jailed until the end of the second next epoch
+		until := new(big.Int).SetUint64((ibft.GetEpoch(3) + DefaultJailEpochs) * ibft.epochSize)
+
+		assert.Equal(t, false, callStaking(t, transition, "isValidator", addrB))
+		assert.Equal(t, until, callStaking(t, transition, "jailedUntil", addrB))
+
+		// the Slashed and Jailed events of the staking SC
+		if receipts := transition.Receipts(); assert.Len(t, receipts, 1) {
+			assert.Len(t, receipts[0].Logs, 2)
+			assert.Equal(t, uint64(0), receipts[0].GasUsed)
+		}
+
+		// the validator is slashed once for the height
+		other := &equivocation{
+			first:  signedMsg(t, keyB, proto.MessageReq_Prepare, 2, 1, "digest1"),
+			second: signedMsg(t, keyB, proto.MessageReq_Prepare, 2, 1, "digest2"),
+		}
+		assertSystemTxErr(t, transition.Write(equivocationTx(other, 3)), errInvalidEvidence)
+	})
+
+	cases := []struct {
+		name   string
+		txn    func() *types.Transaction
+		number uint64
+		err    error
+	}{
+		{
+			name: "invalid evidence",
+			txn: func() *types.Transaction {
+				return equivocationTx(&equivocation{
+					first:  signedMsg(t, keyB, proto.MessageReq_Commit, 2, 0, "digest1"),
+					second: signedMsg(t, keyB, proto.MessageReq_Commit, 2, 0, "digest1"),
+				}, 3)
+			},
+			number: 3,
+			err:    errInvalidEvidence,
+		},
+		{
+			name: "equivocation of the current height",
+			txn: func() *types.Transaction {
+				return equivocationTx(validEquivocation, 2)
+			},
+			number: 2,
+			err:    errInvalidEvidence,
+		},
+		{
+			name: "equivocation too old",
+			txn: func() *types.Transaction {
+				return equivocationTx(validEquivocation, maxEvidenceAge+3)
+			},
+			number: maxEvidenceAge + 3,
+			err:    errInvalidEvidence,
+		},
+		{
+			name: "equivocation of a non validator",
+			txn: func() *types.Transaction {
+				return equivocationTx(&equivocation{
+					first:  signedMsg(t, nonValidatorKey, proto.MessageReq_Commit, 2, 0, "digest1"),
+					second: signedMsg(t, nonValidatorKey, proto.MessageReq_Commit, 2, 0, "digest2"),
+				}, 3)
+			},
+			number: 3,
+			err:    errInvalidEvidence,
+		},
+		{
+			name: "system transaction of another contract",
+			txn: func() *types.Transaction {
+				txn := types.NewSystemTx(big.NewInt(testChainID), 3, types.StringToAddress("1"), nil)
+				txn.ComputeHash()
+
+				return txn
+			},
+			number: 3,
+			err:    errSystemTxNotHandled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ibft, root := newSlashingIbft(t, pool)
+
+			transition, err := ibft.executor.BeginTxn(root, &types.Header{Number: c.number}, types.ZeroAddress)
+			assert.NoError(t, err)
+
+			assertSystemTxErr(t, transition.Write(c.txn()), c.err)
+			assert.Empty(t, transition.Receipts())
+			assert.Equal(t, true, callStaking(t, transition, "isValidator", addrB))
+		})
+	}
+
+	t.Run("not handled by PoA", func(t *testing.T) {
+		ibft, root := newSlashingIbft(t, pool)
+		initIbftMechanism(PoA, ibft)
+
+		transition, err := ibft.executor.BeginTxn(root, &types.Header{Number: 3}, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		assertSystemTxErr(t, transition.Write(equivocationTx(validEquivocation, 3)), errSystemTxNotHandled)
+	})
+}
+
+func TestPoS_JailOfflineValidators(t *testing.T) {
+	pool := newTesterAccountPool()
+	pool.add("A", "B", "C", "D")
+
+	// the proposer of the first round of the first block missed its turn
+	set := pool.ValidatorSet()
+	offline := set.CalcProposer(0, types.ZeroAddress)
+
+	cases := []struct {
+		name      string
+		threshold uint64
+		jailed    bool
+	}{
+		{
+			name:      "jails the validator that missed the threshold of proposals",
+			threshold: 1,
+			jailed:    true,
+		},
+		{
+			name:      "keeps the validators below the threshold",
+			threshold: 2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ibft, root := newSlashingIbft(t, pool)
+
+			// the third block ends the epoch
+			ibft.epochSize = 3
+			ibft.slashing.DowntimeThreshold = common.JSONNumber{Value: c.threshold}
+
+			header := &types.Header{Number: 3, GasLimit: 10000000}
+			assert.True(t, ibft.mechanisms[0].IsAvailable(PreStateCommitHook, header.Number))
+
+			transition, err := ibft.executor.BeginTxn(root, header, types.ZeroAddress)
+			assert.NoError(t, err)
+
+			assert.NoError(t, ibft.PreStateCommit(header, transition))
+
+			_, header.StateRoot = transition.Commit()
+
+			pos, ok := ibft.mechanisms[0].(*PoSMechanism)
+			assert.True(t, ok)
+
+			validators, err := pos.getNextValidators(header)
+			assert.NoError(t, err)
+
+			if !c.jailed {
+				assert.Len(t, validators, 4)
+
+				return
+			}
+
+			assert.Len(t, validators, 3)
+			assert.False(t, validators.Includes(offline))
+
+			// the validator is jailed until the end of the second next epoch
+			transition, err = ibft.executor.BeginTxn(header.StateRoot, header, types.ZeroAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, big.NewInt(9), callStaking(t, transition, "jailedUntil", offline))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ibft
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"sync/atomic"
 
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 )
@@ -20,8 +22,15 @@ func (i *Ibft) setupSnapshot() error {
 	i.store = newSnapshotStore()
 
 	// Read from storage
+	if i.snapshotDB != nil {
+		if err := i.store.loadFromStorage(i.snapshotDB, i.logger); err != nil {
+			return err
+		}
+	}
+
+	// Move the snapshots saved by the previous versions into the storage
 	if i.config.Path != "" {
-		if err := i.store.loadFromPath(i.config.Path, i.logger); err != nil {
+		if err := i.store.migrateFromPath(i.config.Path, i.logger); err != nil {
 			return err
 		}
 	}
@@ -112,6 +121,19 @@ func (i *Ibft) addHeaderSnap(header *types.Header) error {
 		Set:    extra.Validators,
 	}
 
+	// The BLS keys registered in the previous epochs are checkpointed in the header
+	for indx, key := range extra.ValidatorBLSKeys {
+		if len(key) == 0 || indx >= len(extra.Validators) {
+			continue
+		}
+
+		if snap.BLSKeys == nil {
+			snap.BLSKeys = map[types.Address]string{}
+		}
+
+		snap.BLSKeys[extra.Validators[indx]] = hex.EncodeToHex(key)
+	}
+
 	i.store.add(snap)
 
 	return nil
@@ -170,6 +192,11 @@ func (i *Ibft) processHeaders(headers []*types.Header) error {
 			return fmt.Errorf("unauthorized proposer")
 		}
 
+		// Record the BLS key registered by the proposer
+		if err := registerBLSKey(snap, h, proposer); err != nil {
+			return err
+		}
+
 		if hookErr := i.runHook(
 			ProcessHeadersHook,
 			h.Number,
@@ -194,6 +221,67 @@ func (i *Ibft) processHeaders(headers []*types.Header) error {
 	return nil
 }
 
+// updateSnapshotValidators replaces the validators of the snapshot at given height
+// with the ones read from the state of the header, for the mechanisms managing them in a contract
+func (i *Ibft) updateSnapshotValidators(
+	num uint64,
+	getNextValidators func(header *types.Header) (ValidatorSet, error),
+) error {
+	header, ok := i.blockchain.GetHeaderByNumber(num)
+	if !ok {
+		return errors.New("header not found")
+	}
+
+	validators, err := getNextValidators(header)
+	if err != nil {
+		return err
+	}
+
+	snap, err := i.getSnapshot(header.Number)
+	if err != nil {
+		return err
+	}
+
+	if snap == nil {
+		return fmt.Errorf("cannot find snapshot at %d", header.Number)
+	}
+
+	if !snap.Set.Equal(&validators) {
+		newSnap := snap.Copy()
+		newSnap.Set = validators
+		newSnap.Number = header.Number
+		newSnap.Hash = header.Hash.String()
+
+		if snap.Number != header.Number {
+			i.store.add(newSnap)
+		} else {
+			i.store.replace(newSnap)
+		}
+	}
+
+	return nil
+}
+
+// registerBLSKey adds to the snapshot the BLS public key registered in the header, if any
+func registerBLSKey(snap *Snapshot, header *types.Header, proposer types.Address) error {
+	extra, err := getIbftExtra(header)
+	if err != nil {
+		return err
+	}
+
+	if extra.BLSRegistration == nil {
+		return nil
+	}
+
+	if snap.BLSKeys == nil {
+		snap.BLSKeys = map[types.Address]string{}
+	}
+
+	snap.BLSKeys[proposer] = hex.EncodeToHex(extra.BLSRegistration.PublicKey)
+
+	return nil
+}
+
 // getSnapshotMetadata returns the latest snapshot metadata
 func (i *Ibft) getSnapshotMetadata() (*snapshotMetadata, error) {
 	meta := &snapshotMetadata{
@@ -255,12 +343,18 @@ type Snapshot struct {
 
 	// current set of validators
 	Set ValidatorSet
+
+	// BLS public keys registered by the validators, in the BLS mode
+	BLSKeys map[types.Address]string `json:",omitempty"`
 }
 
 // snapshotMetadata defines the metadata for the snapshot
 type snapshotMetadata struct {
 	// LastBlock represents the latest block in the snapshot
 	LastBlock uint64
+
+	// Snapshots are the block numbers of the snapshots kept in the storage
+	Snapshots []uint64 `json:",omitempty"`
 }
 
 // Equal checks if two snapshots are equal
@@ -277,6 +371,16 @@ func (s *Snapshot) Equal(ss *Snapshot) bool {
 		}
 	}
 
+	if len(s.BLSKeys) != len(ss.BLSKeys) {
+		return false
+	}
+
+	for addr, key := range s.BLSKeys {
+		if otherKey, ok := ss.BLSKeys[addr]; !ok || otherKey != key {
+			return false
+		}
+	}
+
 	return s.Set.Equal(&ss.Set)
 }
 
@@ -316,6 +420,13 @@ func (s *Snapshot) Copy() *Snapshot {
 
 	ss.Set = append(ss.Set, s.Set...)
 
+	if s.BLSKeys != nil {
+		ss.BLSKeys = make(map[types.Address]string, len(s.BLSKeys))
+		for addr, key := range s.BLSKeys {
+			ss.BLSKeys[addr] = key
+		}
+	}
+
 	return ss
 }
 
@@ -347,6 +458,15 @@ func (s *Snapshot) ToProto() *proto.Snapshot {
 	return resp
 }
 
+// snapshotStorage is the key-value storage persisting the snapshots
+type snapshotStorage interface {
+	WriteSnapshotByNumber(n uint64, blob []byte) error
+	ReadSnapshotByNumber(n uint64) ([]byte, bool)
+	DeleteSnapshotByNumber(n uint64) error
+	WriteSnapshotMetadata(blob []byte) error
+	ReadSnapshotMetadata() ([]byte, bool)
+}
+
 // snapshotStore defines the structure of the stored snapshots
 type snapshotStore struct {
 	// lastNumber is the latest block number stored
@@ -357,6 +477,10 @@ type snapshotStore struct {
 
 	// list represents the actual snapshot sorted list
 	list snapshotSortedList
+
+	// db persists every change of the snapshots, if set
+	db     snapshotStorage
+	logger hclog.Logger
 }
 
 // newSnapshotStore returns a new snapshot store
@@ -366,6 +490,89 @@ func newSnapshotStore() *snapshotStore {
 	}
 }
 
+// loadFromStorage loads the snapshots from the storage, which persists the later changes of the store
+func (s *snapshotStore) loadFromStorage(db snapshotStorage, l hclog.Logger) error {
+	s.db = db
+	s.logger = l
+
+	blob, ok := db.ReadSnapshotMetadata()
+	if !ok {
+		return nil
+	}
+
+	meta := &snapshotMetadata{}
+	if err := json.Unmarshal(blob, meta); err != nil {
+		return fmt.Errorf("invalid snapshot metadata, %w", err)
+	}
+
+	s.lastNumber = meta.LastBlock
+
+	for _, num := range meta.Snapshots {
+		blob, ok := db.ReadSnapshotByNumber(num)
+		if !ok {
+			return fmt.Errorf("snapshot at %d not found", num)
+		}
+
+		snap := &Snapshot{}
+		if err := json.Unmarshal(blob, snap); err != nil {
+			return fmt.Errorf("invalid snapshot at %d, %w", num, err)
+		}
+
+		s.list = append(s.list, snap)
+	}
+
+	sort.Sort(&s.list)
+
+	return nil
+}
+
+// migrateFromPath moves the snapshots of the files saved in the path by the previous versions
+// into the storage, and removes the files. The files are ignored once the storage has snapshots
+func (s *snapshotStore) migrateFromPath(path string, l hclog.Logger) error {
+	files := []string{filepath.Join(path, "metadata"), filepath.Join(path, "snapshots")}
+
+	found := false
+
+	for _, file := range files {
+		if _, err := os.Stat(file); err == nil {
+			found = true
+		}
+	}
+
+	if !found || s.db == nil {
+		return nil
+	}
+
+	if _, ok := s.db.ReadSnapshotMetadata(); !ok {
+		if err := s.loadFromPath(path, l); err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		for _, snap := range s.list {
+			if err := s.writeSnapshot(snap); err != nil {
+				return err
+			}
+		}
+
+		if err := s.writeMetadata(); err != nil {
+			return err
+		}
+
+		l.Info("migrated the snapshot store files to the storage", "snapshots", len(s.list))
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // loadFromPath loads a saved snapshot store from the specified file system path
 func (s *snapshotStore) loadFromPath(path string, l hclog.Logger) error {
 	// Load metadata
@@ -392,29 +599,61 @@ func (s *snapshotStore) loadFromPath(path string, l hclog.Logger) error {
 		l.Error("Removed invalid snapshot store file")
 	}
 
-	for _, snap := range snaps {
-		s.add(snap)
-	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list = append(s.list, snaps...)
+	sort.Sort(&s.list)
 
 	return nil
 }
 
-// saveToPath saves the snapshot store as a file to the specified path
-func (s *snapshotStore) saveToPath(path string) error {
-	// Write snapshots
-	if err := writeDataStore(filepath.Join(path, "snapshots"), s.list); err != nil {
+// writeSnapshot persists the snapshot, if the store has a storage. The lock must be held
+func (s *snapshotStore) writeSnapshot(snap *Snapshot) error {
+	if s.db == nil {
+		return nil
+	}
+
+	blob, err := json.Marshal(snap)
+	if err != nil {
 		return err
 	}
 
-	// Write metadata
+	return s.db.WriteSnapshotByNumber(snap.Number, blob)
+}
+
+// writeMetadata persists the latest block and the numbers of the snapshots,
+// if the store has a storage. The lock must be held
+func (s *snapshotStore) writeMetadata() error {
+	if s.db == nil {
+		return nil
+	}
+
 	meta := &snapshotMetadata{
-		LastBlock: s.lastNumber,
+		LastBlock: s.getLastBlock(),
+		Snapshots: make([]uint64, 0, len(s.list)),
 	}
-	if err := writeDataStore(filepath.Join(path, "metadata"), meta); err != nil {
+
+	for _, snap := range s.list {
+		// the list may have several snapshots of a block, the storage keeps the latest one
+		if n := len(meta.Snapshots); n == 0 || meta.Snapshots[n-1] != snap.Number {
+			meta.Snapshots = append(meta.Snapshots, snap.Number)
+		}
+	}
+
+	blob, err := json.Marshal(meta)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.db.WriteSnapshotMetadata(blob)
+}
+
+// logWriteError logs the failed write of the storage, the store keeps the snapshots in memory
+func (s *snapshotStore) logWriteError(err error) {
+	if err != nil {
+		s.logger.Error("failed to persist the snapshots", "err", err)
+	}
 }
 
 // getLastBlock returns the latest block number from the snapshot store. [Thread safe]
@@ -425,6 +664,13 @@ func (s *snapshotStore) getLastBlock() uint64 {
 // updateLastBlock sets the latest block number in the snapshot store. [Thread safe]
 func (s *snapshotStore) updateLastBlock(num uint64) {
 	atomic.StoreUint64(&s.lastNumber, num)
+
+	if s.db != nil {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		s.logWriteError(s.writeMetadata())
+	}
 }
 
 // deleteLower deletes snapshots that have a block number lower than the passed in parameter
@@ -435,6 +681,21 @@ func (s *snapshotStore) deleteLower(num uint64) {
 	i := sort.Search(len(s.list), func(i int) bool {
 		return s.list[i].Number >= num
 	})
+
+	if s.db != nil && i > 0 {
+		// the metadata is written first, so that it doesn't point to deleted snapshots
+		pruned := s.list[:i]
+		s.list = s.list[i:]
+
+		s.logWriteError(s.writeMetadata())
+
+		for _, snap := range pruned {
+			s.logWriteError(s.db.DeleteSnapshotByNumber(snap.Number))
+		}
+
+		return
+	}
+
 	s.list = s.list[i:]
 }
 
@@ -479,6 +740,10 @@ func (s *snapshotStore) add(snap *Snapshot) {
 	// append and sort the list
 	s.list = append(s.list, snap)
 	sort.Sort(&s.list)
+
+	// the snapshot is written before the metadata listing it
+	s.logWriteError(s.writeSnapshot(snap))
+	s.logWriteError(s.writeMetadata())
 }
 
 func (s *snapshotStore) replace(snap *Snapshot) {
@@ -489,6 +754,8 @@ func (s *snapshotStore) replace(snap *Snapshot) {
 		if sn.Number == snap.Number {
 			s.list[i] = snap
 
+			s.logWriteError(s.writeSnapshot(snap))
+
 			return
 		}
 	}
@@ -529,18 +796,3 @@ func readDataStore(path string, obj interface{}) error {
 
 	return nil
 }
-
-// writeDataStore attempts to write the specific file to file storage
-func writeDataStore(path string, obj interface{}) error {
-	data, err := json.Marshal(obj)
-	if err != nil {
-		return err
-	}
-
-	//nolint: gosec
-	if err := ioutil.WriteFile(path, data, 0755); err != nil {
-		return err
-	}
-
-	return nil
-}
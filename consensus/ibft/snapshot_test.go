@@ -2,12 +2,16 @@ package ibft
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/crypto"
@@ -220,7 +224,7 @@ func updateHashesInSnapshots(t *testing.T, b *blockchain.Blockchain, snapshots [
 	}
 }
 
-func saveSnapshots(t *testing.T, path string, snapshots []*Snapshot) {
+func saveSnapshots(t *testing.T, db snapshotStorage, snapshots []*Snapshot) {
 	t.Helper()
 
 	if snapshots == nil {
@@ -228,13 +232,11 @@ func saveSnapshots(t *testing.T, path string, snapshots []*Snapshot) {
 	}
 
 	store := newSnapshotStore()
+	assert.NoError(t, store.loadFromStorage(db, hclog.NewNullLogger()))
+
 	for _, snap := range snapshots {
 		store.add(snap)
 	}
-
-	err := store.saveToPath(path)
-
-	assert.NoError(t, err)
 }
 
 func TestSnapshot_setupSnapshot(t *testing.T) {
@@ -384,7 +386,6 @@ func TestSnapshot_setupSnapshot(t *testing.T) {
 		}
 
 		t.Run(c.name, func(t *testing.T) {
-			tmpDir := getTempDir(t)
 			// Build blockchain with headers
 			blockchain := blockchain.TestBlockchain(t, genesis)
 			initialHeaders := buildHeaders(pool, genesis, c.headers)
@@ -396,10 +397,9 @@ func TestSnapshot_setupSnapshot(t *testing.T) {
 			ibft := &Ibft{
 				epochSize:  epochSize,
 				blockchain: blockchain,
-				config: &consensus.Config{
-					Path: tmpDir,
-				},
-				logger: hclog.NewNullLogger(),
+				snapshotDB: blockchain.Storage(),
+				config:     &consensus.Config{},
+				logger:     hclog.NewNullLogger(),
 			}
 
 			initIbftMechanism(PoA, ibft)
@@ -407,7 +407,7 @@ func TestSnapshot_setupSnapshot(t *testing.T) {
 			// Write Hash to snapshots
 			updateHashesInSnapshots(t, blockchain, c.savedSnapshots)
 			updateHashesInSnapshots(t, blockchain, c.expectedResult.Snapshots)
-			saveSnapshots(t, tmpDir, c.savedSnapshots)
+			saveSnapshots(t, blockchain.Storage(), c.savedSnapshots)
 
 			assert.NoError(t, ibft.setupSnapshot())
 			assert.Equal(t, c.expectedResult.LastBlock, ibft.store.getLastBlock())
@@ -834,21 +834,110 @@ func TestSnapshot_PurgeSnapshots(t *testing.T) {
 	assert.Equal(t, len(ibft1.store.list), 21)
 }
 
+func newSnapshotTestStorage(t *testing.T) storage.Storage {
+	t.Helper()
+
+	db, err := memory.NewMemoryStorage(nil)
+	assert.NoError(t, err)
+
+	return db
+}
+
 func TestSnapshot_Store_SaveLoad(t *testing.T) {
-	tmpDir := getTempDir(t)
+	db := newSnapshotTestStorage(t)
+
+	// every change of the store is written to the storage
 	store0 := newSnapshotStore()
+	assert.NoError(t, store0.loadFromStorage(db, hclog.NewNullLogger()))
 
 	for i := 0; i < 10; i++ {
 		store0.add(&Snapshot{
 			Number: uint64(i),
 		})
 	}
-	assert.NoError(t, store0.saveToPath(tmpDir))
+
+	store0.replace(&Snapshot{
+		Number: 5,
+		Hash:   "0x5",
+	})
+	store0.updateLastBlock(12)
 
 	store1 := newSnapshotStore()
-	assert.NoError(t, store1.loadFromPath(tmpDir, hclog.NewNullLogger()))
+	assert.NoError(t, store1.loadFromStorage(db, hclog.NewNullLogger()))
+
+	assert.Equal(t, uint64(12), store1.getLastBlock())
+	assert.Equal(t, store0.list, store1.list)
+	assert.Equal(t, "0x5", store1.find(5).Hash)
+
+	// the pruned snapshots are deleted from the storage
+	store1.deleteLower(8)
+
+	for i := uint64(0); i < 10; i++ {
+		_, ok := db.ReadSnapshotByNumber(i)
+		assert.Equal(t, i >= 8, ok)
+	}
+
+	store2 := newSnapshotStore()
+	assert.NoError(t, store2.loadFromStorage(db, hclog.NewNullLogger()))
 
-	assert.Equal(t, store0, store1)
+	assert.Equal(t, store1.list, store2.list)
+	assert.Len(t, store2.list, 2)
+}
+
+func TestSnapshot_Store_MigrateFromPath(t *testing.T) {
+	writeFile := func(t *testing.T, path string, obj interface{}) {
+		t.Helper()
+
+		data, err := json.Marshal(obj)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(path, data, 0600))
+	}
+
+	snapshots := []*Snapshot{
+		{Number: 0, Hash: "0x0"},
+		{Number: 10, Hash: "0x10"},
+	}
+
+	t.Run("moves the files into the storage", func(t *testing.T) {
+		tmpDir := getTempDir(t)
+		writeFile(t, filepath.Join(tmpDir, "snapshots"), snapshots)
+		writeFile(t, filepath.Join(tmpDir, "metadata"), &snapshotMetadata{LastBlock: 15})
+
+		db := newSnapshotTestStorage(t)
+
+		store0 := newSnapshotStore()
+		assert.NoError(t, store0.loadFromStorage(db, hclog.NewNullLogger()))
+		assert.NoError(t, store0.migrateFromPath(tmpDir, hclog.NewNullLogger()))
+
+		assert.Equal(t, uint64(15), store0.getLastBlock())
+		assert.Equal(t, snapshotSortedList(snapshots), store0.list)
+
+		// the files are removed once migrated
+		assert.NoFileExists(t, filepath.Join(tmpDir, "snapshots"))
+		assert.NoFileExists(t, filepath.Join(tmpDir, "metadata"))
+
+		store1 := newSnapshotStore()
+		assert.NoError(t, store1.loadFromStorage(db, hclog.NewNullLogger()))
+
+		assert.Equal(t, store0.getLastBlock(), store1.getLastBlock())
+		assert.Equal(t, store0.list, store1.list)
+	})
+
+	t.Run("keeps the snapshots of the storage", func(t *testing.T) {
+		tmpDir := getTempDir(t)
+		writeFile(t, filepath.Join(tmpDir, "snapshots"), snapshots)
+
+		db := newSnapshotTestStorage(t)
+		saveSnapshots(t, db, []*Snapshot{{Number: 20}})
+
+		store := newSnapshotStore()
+		assert.NoError(t, store.loadFromStorage(db, hclog.NewNullLogger()))
+		assert.NoError(t, store.migrateFromPath(tmpDir, hclog.NewNullLogger()))
+
+		assert.Len(t, store.list, 1)
+		assert.Equal(t, uint64(20), store.list[0].Number)
+		assert.NoFileExists(t, filepath.Join(tmpDir, "snapshots"))
+	})
 }
 
 func TestSnapshot_Store_Find(t *testing.T) {
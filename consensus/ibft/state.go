@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 )
 
 type IbftState uint32
@@ -71,10 +72,29 @@ type currentState struct {
 	// Locked signals whether the proposal is locked
 	locked bool
 
+	// The latest block prepared by a quorum, sent along with the round change messages
+	latestPrepared *preparedBlock
+
+	// The round change messages of the quorum which started the current round,
+	// justifying the proposal of the round
+	roundChangeJustification []*proto.MessageReq
+
 	// Describes whether there has been an error during the computation
 	err error
 }
 
+// preparedBlock is a block prepared by a quorum of validators, along with its certificate
+type preparedBlock struct {
+	// view is the view in which the block was prepared
+	view *proto.View
+
+	// digest is the hash of the block
+	digest string
+
+	// certificate has the block and the prepare messages of the quorum
+	certificate *proto.PreparedCertificate
+}
+
 // newState creates a new state with reset round messages
 func newState() *currentState {
 	c := &currentState{}
@@ -153,6 +173,66 @@ func (c *currentState) cleanRound(round uint64) {
 	}
 }
 
+// setPrepared sets the locked block as the latest prepared block, with the prepare messages
+// received in the current view
+func (c *currentState) setPrepared() {
+	if c.block == nil {
+		return
+	}
+
+	if p := c.latestPrepared; p != nil && cmpView(p.view, c.view) >= 0 {
+		// already prepared in this view
+		return
+	}
+
+	prepares := make([]*proto.MessageReq, 0, len(c.prepared))
+	for _, msg := range c.prepared {
+		prepares = append(prepares, msg)
+	}
+
+	c.latestPrepared = &preparedBlock{
+		view:   c.view.Copy(),
+		digest: c.block.Hash().String(),
+		certificate: &proto.PreparedCertificate{
+			Proposal: &anypb.Any{
+				Value: c.block.MarshalRLP(),
+			},
+			PrepareMessages: prepares,
+		},
+	}
+}
+
+// getPrepared returns the latest prepared block of the current sequence, if any
+func (c *currentState) getPrepared() *preparedBlock {
+	if p := c.latestPrepared; p != nil && p.view.Sequence == c.view.Sequence && p.view.Round < c.view.Round {
+		return p
+	}
+
+	return nil
+}
+
+// setRoundChangeJustification keeps the round change messages of the round as the justification
+// of its proposal
+func (c *currentState) setRoundChangeJustification(round uint64) {
+	msgs := make([]*proto.MessageReq, 0, len(c.roundMessages[round]))
+	for _, msg := range c.roundMessages[round] {
+		msgs = append(msgs, msg)
+	}
+
+	c.roundChangeJustification = msgs
+}
+
+// getRoundChangeJustification returns the round change messages justifying the proposal of the current view
+func (c *currentState) getRoundChangeJustification() []*proto.MessageReq {
+	for _, msg := range c.roundChangeJustification {
+		if cmpView(msg.View, c.view) != 0 {
+			return nil
+		}
+	}
+
+	return c.roundChangeJustification
+}
+
 // AddRoundMessage adds a message to the round, and returns the round message size
 func (c *currentState) AddRoundMessage(msg *proto.MessageReq) int {
 	if msg.Type != proto.MessageReq_RoundChange {
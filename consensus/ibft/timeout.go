@@ -1,13 +1,29 @@
 package ibft
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
+	"sort"
 	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/helper/common"
 )
 
 const (
 	baseTimeout = 10 * time.Second
 	maxTimeout  = 300 * time.Second
+
+	// defaultTimeoutGrowth is the growth of the configured round timeouts, if not set
+	defaultTimeoutGrowth = 2
+)
+
+var (
+	ErrInvalidBaseTimeout   = errors.New("base timeout must be greater than 0")
+	ErrInvalidTimeoutGrowth = errors.New("timeout growth must be at least 1")
+	ErrInvalidMaxTimeout    = errors.New("max timeout must be at least the base timeout")
 )
 
 // exponentialTimeout calculates the timeout duration in seconds as exponential function
@@ -26,3 +42,129 @@ func exponentialTimeout(exponent uint64) time.Duration {
 
 	return timeout
 }
+
+// RoundTimeouts is the configuration of the round timeouts from a block on,
+// the timeout of the round r is Base * Growth^r, capped at Max
+type RoundTimeouts struct {
+	From   common.JSONNumber   `json:"from"`
+	Base   common.JSONDuration `json:"base"`
+	Growth float64             `json:"growth,omitempty"`
+	Max    common.JSONDuration `json:"max,omitempty"`
+}
+
+// GetRoundTimeouts returns the round timeouts of the IBFT config, sorted by the starting block.
+// The local overrides replace the fields of every configuration, or set a configuration from the genesis
+func GetRoundTimeouts(
+	ibftConfig map[string]interface{},
+	overrides *consensus.RoundTimeouts,
+) ([]*RoundTimeouts, error) {
+	timeouts := []*RoundTimeouts{}
+
+	if rawTimeouts, ok := ibftConfig["timeouts"]; ok {
+		bytes, err := json.Marshal(rawTimeouts)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(bytes, &timeouts); err != nil {
+			return nil, fmt.Errorf("invalid round timeouts, %w", err)
+		}
+	}
+
+	if overrides != nil && overrides.IsSet() {
+		if len(timeouts) == 0 {
+			timeouts = append(timeouts, &RoundTimeouts{
+				Base: common.JSONDuration{Value: baseTimeout},
+			})
+		}
+
+		for _, t := range timeouts {
+			t.override(overrides)
+		}
+	}
+
+	for _, t := range timeouts {
+		t.setDefaults()
+
+		if err := t.validate(); err != nil {
+			return nil, fmt.Errorf("invalid round timeouts from block %d, %w", t.From.Value, err)
+		}
+	}
+
+	sort.SliceStable(timeouts, func(i, j int) bool {
+		return timeouts[i].From.Value < timeouts[j].From.Value
+	})
+
+	return timeouts, nil
+}
+
+// override replaces the fields set in the local overrides
+func (t *RoundTimeouts) override(overrides *consensus.RoundTimeouts) {
+	if overrides.Base != 0 {
+		t.Base.Value = overrides.Base
+	}
+
+	if overrides.Growth != 0 {
+		t.Growth = overrides.Growth
+	}
+
+	if overrides.Max != 0 {
+		t.Max.Value = overrides.Max
+	}
+}
+
+// setDefaults sets the growth and the cap if they are not set
+func (t *RoundTimeouts) setDefaults() {
+	if t.Growth == 0 {
+		t.Growth = defaultTimeoutGrowth
+	}
+
+	if t.Max.Value == 0 {
+		t.Max.Value = maxTimeout
+	}
+}
+
+// validate checks the timeouts are positive and growing
+func (t *RoundTimeouts) validate() error {
+	if t.Base.Value <= 0 {
+		return ErrInvalidBaseTimeout
+	}
+
+	if t.Growth < 1 {
+		return ErrInvalidTimeoutGrowth
+	}
+
+	if t.Max.Value < t.Base.Value {
+		return ErrInvalidMaxTimeout
+	}
+
+	return nil
+}
+
+// timeout returns the timeout of the round
+func (t *RoundTimeouts) timeout(round uint64) time.Duration {
+	timeout := float64(t.Base.Value) * math.Pow(t.Growth, float64(round))
+	if timeout >= float64(t.Max.Value) {
+		return t.Max.Value
+	}
+
+	return time.Duration(timeout)
+}
+
+// roundTimeout returns the timeout of the round of the current sequence,
+// from the configured round timeouts or the legacy exponential timeout
+func (i *Ibft) roundTimeout(round uint64) time.Duration {
+	var current *RoundTimeouts
+
+	for _, t := range i.roundTimeouts {
+		if i.state.view.Sequence >= t.From.Value {
+			current = t
+		}
+	}
+
+	if current == nil {
+		return exponentialTimeout(round)
+	}
+
+	return current.timeout(round)
+}
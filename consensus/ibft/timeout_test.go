@@ -1,9 +1,13 @@
 package ibft
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestExponentialTimeout(t *testing.T) {
@@ -28,3 +32,127 @@ func TestExponentialTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundTimeouts_Timeout(t *testing.T) {
+	timeouts := &RoundTimeouts{
+		Base:   common.JSONDuration{Value: 500 * time.Millisecond},
+		Growth: 1.5,
+		Max:    common.JSONDuration{Value: 2 * time.Second},
+	}
+
+	assert.Equal(t, 500*time.Millisecond, timeouts.timeout(0))
+	assert.Equal(t, 750*time.Millisecond, timeouts.timeout(1))
+	assert.Equal(t, 1125*time.Millisecond, timeouts.timeout(2))
+	assert.Equal(t, 2*time.Second, timeouts.timeout(4))
+	assert.Equal(t, 2*time.Second, timeouts.timeout(10000))
+}
+
+func TestGetRoundTimeouts(t *testing.T) {
+	config := map[string]interface{}{
+		"timeouts": []interface{}{
+			map[string]interface{}{
+				"from":   "0x64",
+				"base":   "200ms",
+				"growth": 1.2,
+				"max":    "5s",
+			},
+			map[string]interface{}{
+				"from": "0x0",
+				"base": "2s",
+			},
+		},
+	}
+
+	testCases := []struct {
+		description string
+		config      map[string]interface{}
+		overrides   *consensus.RoundTimeouts
+		expected    []*RoundTimeouts
+		err         error
+	}{
+		{
+			"no timeouts uses the exponential timeout",
+			map[string]interface{}{},
+			&consensus.RoundTimeouts{},
+			[]*RoundTimeouts{},
+			nil,
+		},
+		{
+			"timeouts are sorted with the defaults",
+			config,
+			nil,
+			[]*RoundTimeouts{
+				{
+					From:   common.JSONNumber{Value: 0},
+					Base:   common.JSONDuration{Value: 2 * time.Second},
+					Growth: defaultTimeoutGrowth,
+					Max:    common.JSONDuration{Value: maxTimeout},
+				},
+				{
+					From:   common.JSONNumber{Value: 100},
+					Base:   common.JSONDuration{Value: 200 * time.Millisecond},
+					Growth: 1.2,
+					Max:    common.JSONDuration{Value: 5 * time.Second},
+				},
+			},
+			nil,
+		},
+		{
+			"overrides without timeouts in the config",
+			map[string]interface{}{},
+			&consensus.RoundTimeouts{Growth: 1.5},
+			[]*RoundTimeouts{
+				{
+					Base:   common.JSONDuration{Value: baseTimeout},
+					Growth: 1.5,
+					Max:    common.JSONDuration{Value: maxTimeout},
+				},
+			},
+			nil,
+		},
+		{
+			"invalid growth",
+			config,
+			&consensus.RoundTimeouts{Growth: 0.5},
+			nil,
+			ErrInvalidTimeoutGrowth,
+		},
+		{
+			"max timeout lower than the base timeout",
+			config,
+			&consensus.RoundTimeouts{Max: time.Second},
+			nil,
+			ErrInvalidMaxTimeout,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.description, func(t *testing.T) {
+			timeouts, err := GetRoundTimeouts(test.config, test.overrides)
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, timeouts)
+		})
+	}
+}
+
+func TestRoundTimeout_Fork(t *testing.T) {
+	i := &Ibft{
+		state: newState(),
+		roundTimeouts: []*RoundTimeouts{
+			{
+				From:   common.JSONNumber{Value: 10},
+				Base:   common.JSONDuration{Value: time.Second},
+				Growth: 2,
+				Max:    common.JSONDuration{Value: time.Minute},
+			},
+		},
+	}
+
+	// the exponential timeout before the fork
+	i.state.view = proto.ViewMsg(9, 1)
+	assert.Equal(t, 12*time.Second, i.roundTimeout(1))
+
+	i.state.view = proto.ViewMsg(10, 1)
+	assert.Equal(t, 2*time.Second, i.roundTimeout(1))
+}
@@ -0,0 +1,260 @@
+package ibft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TxOrdering defines the policy used for picking transactions
+// from the txpool when building a block
+type TxOrdering string
+
+const (
+	// PriceOrdering always picks the highest priced executable transaction
+	PriceOrdering TxOrdering = "price"
+
+	// RoundRobinOrdering picks at most one transaction per account in each pass
+	// over the txpool, so that a single heavy sender can't fill up the whole block
+	RoundRobinOrdering TxOrdering = "round-robin"
+
+	// PriorityOrdering picks the transactions sent by the configured priority accounts
+	// before any other, and falls back to price ordering for the rest
+	PriorityOrdering TxOrdering = "priority"
+)
+
+var (
+	ErrMissingPriorityAccounts = errors.New("priority tx ordering requires at least one priority account")
+)
+
+// txOrderings is the map used for easy string -> TxOrdering lookups
+var txOrderings = map[string]TxOrdering{
+	"price":       PriceOrdering,
+	"round-robin": RoundRobinOrdering,
+	"priority":    PriorityOrdering,
+}
+
+// String is a helper method for casting a TxOrdering to a string representation
+func (o TxOrdering) String() string {
+	return string(o)
+}
+
+// ParseTxOrdering converts an ordering string representation to a TxOrdering
+func ParseTxOrdering(ordering string) (TxOrdering, error) {
+	castOrdering, ok := txOrderings[ordering]
+	if !ok {
+		return castOrdering, fmt.Errorf("invalid tx ordering %s", ordering)
+	}
+
+	return castOrdering, nil
+}
+
+// TxOrderingConfig represents the block building settings in params.engine.ibft of genesis.json
+type TxOrderingConfig struct {
+	// Ordering is the policy used for picking transactions from the txpool
+	Ordering TxOrdering `json:"txOrdering,omitempty"`
+
+	// PriorityAccounts are the senders whose transactions are included first
+	// when the priority ordering is used
+	PriorityAccounts []types.Address `json:"txPriorityAccounts,omitempty"`
+
+	// MaxAccountTxs is the maximum number of transactions a single account
+	// can have included in one block (0 means no limit)
+	MaxAccountTxs uint64 `json:"maxAccountTxsPerBlock,omitempty"`
+}
+
+// GetTxOrderingConfig returns the block building configuration from chain config
+func GetTxOrderingConfig(ibftConfig map[string]interface{}) (*TxOrderingConfig, error) {
+	bytes, err := json.Marshal(ibftConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &TxOrderingConfig{}
+	if err := json.Unmarshal(bytes, config); err != nil {
+		return nil, err
+	}
+
+	if config.Ordering == "" {
+		config.Ordering = PriceOrdering
+	}
+
+	if config.Ordering, err = ParseTxOrdering(config.Ordering.String()); err != nil {
+		return nil, err
+	}
+
+	if config.Ordering == PriorityOrdering && len(config.PriorityAccounts) == 0 {
+		return nil, ErrMissingPriorityAccounts
+	}
+
+	return config, nil
+}
+
+// txSelector hands out the transactions from the txpool to the block builder,
+// following the configured ordering policy and the per-account limit
+type txSelector struct {
+	pool     txPoolInterface
+	ordering TxOrdering
+
+	// base fee of the block, transactions are sorted by the tip paid on top of it
+	baseFee uint64
+
+	// priority accounts (priority ordering only)
+	priority map[types.Address]struct{}
+
+	// maximum number of included transactions per account (0 means no limit)
+	maxAccountTxs uint64
+
+	// number of transactions included so far per account
+	included map[types.Address]uint64
+
+	// primaries taken out of the txpool which weren't handed out yet
+	pending []*types.Transaction
+}
+
+// newTxSelector creates a new txSelector for a single block.
+// A nil config falls back to price ordering without limits
+func newTxSelector(pool txPoolInterface, config *TxOrderingConfig, baseFee uint64) *txSelector {
+	s := &txSelector{
+		pool:     pool,
+		ordering: PriceOrdering,
+		baseFee:  baseFee,
+		priority: make(map[types.Address]struct{}),
+		included: make(map[types.Address]uint64),
+	}
+
+	if config == nil {
+		return s
+	}
+
+	if config.Ordering != "" {
+		s.ordering = config.Ordering
+	}
+
+	s.maxAccountTxs = config.MaxAccountTxs
+
+	for _, addr := range config.PriorityAccounts {
+		s.priority[addr] = struct{}{}
+	}
+
+	return s
+}
+
+// next returns the next transaction that should be written to the block,
+// or nil if there are none left
+func (s *txSelector) next() *types.Transaction {
+	switch s.ordering {
+	case RoundRobinOrdering:
+		return s.nextRoundRobin()
+	case PriorityOrdering:
+		return s.nextPriority()
+	default:
+		return s.nextPrice()
+	}
+}
+
+// markIncluded records that the given transaction was written to the block
+func (s *txSelector) markIncluded(tx *types.Transaction) {
+	s.included[tx.From]++
+}
+
+// isCapped checks if the sender of the given transaction
+// already reached the per-account limit for this block.
+// Capped transactions are just skipped, so they remain in the txpool
+// and the account is not considered again until the next block
+func (s *txSelector) isCapped(tx *types.Transaction) bool {
+	return s.maxAccountTxs != 0 && s.included[tx.From] >= s.maxAccountTxs
+}
+
+// nextPrice returns the highest priced transaction from the txpool
+func (s *txSelector) nextPrice() *types.Transaction {
+	for {
+		tx := s.pool.Peek()
+		if tx == nil || !s.isCapped(tx) {
+			return tx
+		}
+	}
+}
+
+// nextRoundRobin returns the transactions one account at a time.
+// Every pass takes all the current primaries out of the txpool (at most one per account,
+// highest priced first), and the accounts whose transaction got included
+// come back with their next primary in the following pass
+func (s *txSelector) nextRoundRobin() *types.Transaction {
+	for {
+		if len(s.pending) == 0 {
+			s.pending = s.drain()
+		}
+
+		if len(s.pending) == 0 {
+			return nil
+		}
+
+		tx := s.pending[0]
+		s.pending = s.pending[1:]
+
+		if !s.isCapped(tx) {
+			return tx
+		}
+	}
+}
+
+// nextPriority returns the transactions from the priority accounts first,
+// and then the rest ordered by price
+func (s *txSelector) nextPriority() *types.Transaction {
+	for _, tx := range s.drain() {
+		s.insertPending(tx)
+	}
+
+	for len(s.pending) > 0 {
+		tx := s.pending[0]
+		s.pending = s.pending[1:]
+
+		if !s.isCapped(tx) {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+// drain takes all the executable transactions currently available in the txpool
+func (s *txSelector) drain() []*types.Transaction {
+	var txs []*types.Transaction
+
+	for {
+		tx := s.pool.Peek()
+		if tx == nil {
+			return txs
+		}
+
+		txs = append(txs, tx)
+	}
+}
+
+// insertPending adds the transaction to the pending list,
+// keeping priority accounts first and the rest sorted by tip
+func (s *txSelector) insertPending(tx *types.Transaction) {
+	idx := sort.Search(len(s.pending), func(i int) bool {
+		return s.less(tx, s.pending[i])
+	})
+
+	s.pending = append(s.pending, nil)
+	copy(s.pending[idx+1:], s.pending[idx:])
+	s.pending[idx] = tx
+}
+
+// less checks if transaction a should be handed out before transaction b
+func (s *txSelector) less(a, b *types.Transaction) bool {
+	_, aPriority := s.priority[a.From]
+	_, bPriority := s.priority[b.From]
+
+	if aPriority != bPriority {
+		return aPriority
+	}
+
+	return a.EffectiveTip(s.baseFee).Cmp(b.EffectiveTip(s.baseFee)) > 0
+}
@@ -0,0 +1,262 @@
+package ibft
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addrA = types.StringToAddress("1")
+	addrB = types.StringToAddress("2")
+	addrC = types.StringToAddress("3")
+)
+
+// mockAccountTxPool mimics the account based behavior of the txpool:
+// Peek pops the best primary from the executables,
+// and Pop pushes the next transaction of the same account
+type mockAccountTxPool struct {
+	accounts    map[types.Address][]*types.Transaction
+	executables []*types.Transaction
+}
+
+func newMockAccountTxPool(txs ...*types.Transaction) *mockAccountTxPool {
+	p := &mockAccountTxPool{
+		accounts: make(map[types.Address][]*types.Transaction),
+	}
+
+	for _, tx := range txs {
+		p.accounts[tx.From] = append(p.accounts[tx.From], tx)
+	}
+
+	return p
+}
+
+func (p *mockAccountTxPool) push(tx *types.Transaction) {
+	p.executables = append(p.executables, tx)
+	sort.SliceStable(p.executables, func(i, j int) bool {
+		return p.executables[i].GasPrice.Cmp(p.executables[j].GasPrice) > 0
+	})
+}
+
+func (p *mockAccountTxPool) Prepare(baseFee uint64) {
+	p.executables = nil
+
+	for _, txs := range p.accounts {
+		if len(txs) > 0 {
+			p.push(txs[0])
+		}
+	}
+}
+
+func (p *mockAccountTxPool) Length() uint64 {
+	length := 0
+	for _, txs := range p.accounts {
+		length += len(txs)
+	}
+
+	return uint64(length)
+}
+
+func (p *mockAccountTxPool) PendingGas() uint64 {
+	gas := uint64(0)
+	for _, txs := range p.accounts {
+		for _, tx := range txs {
+			gas += tx.Gas
+		}
+	}
+
+	return gas
+}
+
+func (p *mockAccountTxPool) Peek() *types.Transaction {
+	if len(p.executables) == 0 {
+		return nil
+	}
+
+	tx := p.executables[0]
+	p.executables = p.executables[1:]
+
+	return tx
+}
+
+func (p *mockAccountTxPool) Pop(tx *types.Transaction) {
+	p.accounts[tx.From] = p.accounts[tx.From][1:]
+
+	if txs := p.accounts[tx.From]; len(txs) > 0 {
+		p.push(txs[0])
+	}
+}
+
+func (p *mockAccountTxPool) Demote(tx *types.Transaction) {}
+
+func (p *mockAccountTxPool) Drop(tx *types.Transaction) {
+	delete(p.accounts, tx.From)
+}
+
+func (p *mockAccountTxPool) ResetWithHeaders(headers ...*types.Header) {}
+
+func newOrderingTestTx(from types.Address, nonce uint64, price int64) *types.Transaction {
+	return &types.Transaction{
+		From:     from,
+		Nonce:    nonce,
+		GasPrice: big.NewInt(price),
+	}
+}
+
+func TestGetTxOrderingConfig(t *testing.T) {
+	testTable := []struct {
+		name           string
+		config         map[string]interface{}
+		expectedConfig *TxOrderingConfig
+		expectErr      bool
+	}{
+		{
+			"price ordering by default",
+			map[string]interface{}{
+				"type": "PoA",
+			},
+			&TxOrderingConfig{
+				Ordering: PriceOrdering,
+			},
+			false,
+		},
+		{
+			"round-robin ordering with account limit",
+			map[string]interface{}{
+				"type":                  "PoA",
+				"txOrdering":            "round-robin",
+				"maxAccountTxsPerBlock": 10,
+			},
+			&TxOrderingConfig{
+				Ordering:      RoundRobinOrdering,
+				MaxAccountTxs: 10,
+			},
+			false,
+		},
+		{
+			"priority ordering with priority accounts",
+			map[string]interface{}{
+				"txOrdering":         "priority",
+				"txPriorityAccounts": []string{addrA.String()},
+			},
+			&TxOrderingConfig{
+				Ordering:         PriorityOrdering,
+				PriorityAccounts: []types.Address{addrA},
+			},
+			false,
+		},
+		{
+			"priority ordering without priority accounts",
+			map[string]interface{}{
+				"txOrdering": "priority",
+			},
+			nil,
+			true,
+		},
+		{
+			"unknown ordering",
+			map[string]interface{}{
+				"txOrdering": "random",
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			config, err := GetTxOrderingConfig(testCase.config)
+
+			assert.Equal(t, testCase.expectErr, err != nil)
+			assert.Equal(t, testCase.expectedConfig, config)
+		})
+	}
+}
+
+func TestWriteTransactions_Ordering(t *testing.T) {
+	var (
+		a1 = newOrderingTestTx(addrA, 1, 30)
+		a2 = newOrderingTestTx(addrA, 2, 30)
+		a3 = newOrderingTestTx(addrA, 3, 30)
+		b1 = newOrderingTestTx(addrB, 1, 20)
+		b2 = newOrderingTestTx(addrB, 2, 20)
+		c1 = newOrderingTestTx(addrC, 1, 10)
+	)
+
+	testTable := []struct {
+		name             string
+		config           *TxOrderingConfig
+		expectedIncluded []*types.Transaction
+		expectedLength   uint64
+	}{
+		{
+			"price ordering",
+			nil,
+			[]*types.Transaction{a1, a2, a3, b1, b2, c1},
+			0,
+		},
+		{
+			"round-robin ordering",
+			&TxOrderingConfig{
+				Ordering: RoundRobinOrdering,
+			},
+			[]*types.Transaction{a1, b1, c1, a2, b2, a3},
+			0,
+		},
+		{
+			"priority ordering",
+			&TxOrderingConfig{
+				Ordering:         PriorityOrdering,
+				PriorityAccounts: []types.Address{addrC},
+			},
+			[]*types.Transaction{c1, a1, a2, a3, b1, b2},
+			0,
+		},
+		{
+			"price ordering with account limit",
+			&TxOrderingConfig{
+				Ordering:      PriceOrdering,
+				MaxAccountTxs: 1,
+			},
+			[]*types.Transaction{a1, b1, c1},
+			3,
+		},
+		{
+			"round-robin ordering with account limit",
+			&TxOrderingConfig{
+				Ordering:      RoundRobinOrdering,
+				MaxAccountTxs: 2,
+			},
+			[]*types.Transaction{a1, b1, c1, a2, b2},
+			1,
+		},
+		{
+			"priority ordering with account limit",
+			&TxOrderingConfig{
+				Ordering:         PriorityOrdering,
+				PriorityAccounts: []types.Address{addrB},
+				MaxAccountTxs:    1,
+			},
+			[]*types.Transaction{b1, a1, c1},
+			3,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := newMockIbft(t, []string{"A", "B", "C"}, "A")
+			pool := newMockAccountTxPool(a1, a2, a3, b1, b2, c1)
+			m.txpool = pool
+			m.txOrdering = testCase.config
+
+			included := m.writeTransactions(0, 1000, &mockTransition{})
+
+			assert.Equal(t, testCase.expectedIncluded, included)
+			assert.Equal(t, testCase.expectedLength, pool.Length())
+		})
+	}
+}
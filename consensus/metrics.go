@@ -18,6 +18,15 @@ type Metrics struct {
 
 	//Time between current block and the previous block in seconds
 	BlockInterval metrics.Gauge
+
+	// No.of blocks proposed, per validator
+	ValidatorProposals metrics.Counter
+	// No.of rounds in which the validator was the proposer, and the block was proposed by another one
+	ValidatorMissedProposals metrics.Counter
+	// No.of blocks with a committed seal, per validator
+	ValidatorCommittedSeals metrics.Counter
+	// No.of blocks without a committed seal, per validator
+	ValidatorMissedSeals metrics.Counter
 }
 
 // GetPrometheusMetrics return the consensus metrics instance
@@ -28,6 +37,9 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 		labels = append(labels, labelsWithValues[i])
 	}
 
+	// the validator metrics are labeled with the validator address
+	validatorLabels := append(append([]string{}, labels...), "validator")
+
 	return &Metrics{
 		Validators: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
@@ -54,6 +66,31 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 			Name:      "block_interval",
 			Help:      "Time between current block and the previous block in seconds.",
 		}, labels).With(labelsWithValues...),
+
+		ValidatorProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "consensus",
+			Name:      "validator_proposals",
+			Help:      "Number of blocks proposed by the validator.",
+		}, validatorLabels).With(labelsWithValues...),
+		ValidatorMissedProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "consensus",
+			Name:      "validator_missed_proposals",
+			Help:      "Number of rounds in which the validator failed to get its proposal committed.",
+		}, validatorLabels).With(labelsWithValues...),
+		ValidatorCommittedSeals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "consensus",
+			Name:      "validator_committed_seals",
+			Help:      "Number of blocks with a committed seal of the validator.",
+		}, validatorLabels).With(labelsWithValues...),
+		ValidatorMissedSeals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "consensus",
+			Name:      "validator_missed_seals",
+			Help:      "Number of blocks without a committed seal of the validator.",
+		}, validatorLabels).With(labelsWithValues...),
 	}
 }
 
@@ -64,5 +101,10 @@ func NilMetrics() *Metrics {
 		Rounds:        discard.NewGauge(),
 		NumTxs:        discard.NewGauge(),
 		BlockInterval: discard.NewGauge(),
+
+		ValidatorProposals:       discard.NewCounter(),
+		ValidatorMissedProposals: discard.NewCounter(),
+		ValidatorCommittedSeals:  discard.NewCounter(),
+		ValidatorMissedSeals:     discard.NewCounter(),
 	}
 }
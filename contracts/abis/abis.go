@@ -5,4 +5,5 @@ import (
 )
 
 var StakingABI = abi.MustNewABI(StakingJSONABI)
+var GovernanceABI = abi.MustNewABI(GovernanceJSONABI)
 var StressTestABI = abi.MustNewABI(StressTestJSONABI)
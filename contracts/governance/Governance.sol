@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.7;
+
+// Governance is the predeployed contract of the PoA validators, after the PoA governance fork.
+// A validator votes on adding or removing a candidate, which is applied once the majority
+// of the validators voted for the same proposal
+contract Governance {
+    // Properties
+    address[] private _validators;
+    mapping(address => bool) private _addressToIsValidator;
+    mapping(address => uint256) private _addressToValidatorIndex;
+    uint256 private _proposalNonce;
+    mapping(bytes32 => uint256) private _proposalVotes;
+    mapping(bytes32 => mapping(address => bool)) private _hasVoted;
+
+    // Events
+    event ProposalVoted(address indexed voter, address indexed candidate, bool add);
+    event ValidatorAdded(address indexed account);
+    event ValidatorRemoved(address indexed account);
+
+    // Modifiers
+    modifier onlyValidator() {
+        require(_addressToIsValidator[msg.sender]);
+        _;
+    }
+
+    // View functions
+    function validators() public view returns (address[] memory) {
+        return _validators;
+    }
+
+    function isValidator(address account) public view returns (bool) {
+        return _addressToIsValidator[account];
+    }
+
+    function proposalVotes(address candidate, bool add) public view returns (uint256) {
+        return _proposalVotes[_proposalId(candidate, add)];
+    }
+
+    // Public functions
+
+    // propose votes on adding or removing the candidate.
+    // All the pending votes are discarded once a proposal is applied
+    function propose(address candidate, bool add) public onlyValidator {
+        require(candidate != address(0));
+        require(_addressToIsValidator[candidate] != add);
+
+        // the last validator can't be removed
+        require(add || _validators.length > 1);
+
+        bytes32 id = _proposalId(candidate, add);
+        require(!_hasVoted[id][msg.sender]);
+
+        _hasVoted[id][msg.sender] = true;
+        uint256 votes = ++_proposalVotes[id];
+
+        emit ProposalVoted(msg.sender, candidate, add);
+
+        if (votes * 2 <= _validators.length) {
+            return;
+        }
+
+        _proposalNonce++;
+
+        if (add) {
+            _appendToValidatorSet(candidate);
+        } else {
+            _deleteFromValidators(candidate);
+        }
+    }
+
+    // Private functions
+    function _proposalId(address candidate, bool add) private view returns (bytes32) {
+        return keccak256(abi.encode(candidate, add, _proposalNonce));
+    }
+
+    function _appendToValidatorSet(address account) private {
+        _validators.push(account);
+        _addressToValidatorIndex[account] = _validators.length - 1;
+        _addressToIsValidator[account] = true;
+
+        emit ValidatorAdded(account);
+    }
+
+    function _deleteFromValidators(address account) private {
+        uint256 index = _addressToValidatorIndex[account];
+        uint256 lastIndex = _validators.length - 1;
+
+        if (index != lastIndex) {
+            // exchange between the element and last to pop for delete
+            address lastAddr = _validators[lastIndex];
+            _validators[index] = lastAddr;
+            _addressToValidatorIndex[lastAddr] = index;
+        }
+
+        _validators.pop();
+        _addressToIsValidator[account] = false;
+        _addressToValidatorIndex[account] = 0;
+
+        emit ValidatorRemoved(account);
+    }
+}
@@ -0,0 +1,45 @@
+package governance
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// governance contract address
+	AddrGovernanceContract = types.StringToAddress("1002")
+
+	// Gas limit used when querying the validator set
+	queryGasLimit uint64 = 100000
+)
+
+// QueryValidators returns the validator set of the governance contract
+func QueryValidators(t staking.TxQueryHandler, from types.Address) ([]types.Address, error) {
+	method, ok := abis.GovernanceABI.Methods["validators"]
+	if !ok {
+		return nil, errors.New("validators method doesn't exist in Governance contract ABI")
+	}
+
+	res, err := t.Apply(&types.Transaction{
+		From:     from,
+		To:       &AddrGovernanceContract,
+		Value:    big.NewInt(0),
+		Input:    method.ID(),
+		GasPrice: big.NewInt(0),
+		Gas:      queryGasLimit,
+		Nonce:    t.GetNonce(from),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	return staking.DecodeValidators(method, res.ReturnValue)
+}
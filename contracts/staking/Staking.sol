@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.7;
+
+// Staking is the predeployed contract of the PoS validators,
+// based on https://github.com/0xPolygon/staking-contracts.
+// The consensus slashes and jails the misbehaving validators through the system calls
+contract Staking {
+    // Parameters
+    uint128 public constant VALIDATOR_THRESHOLD = 1 ether;
+
+    // SYSTEM is the sender of the calls made by the consensus
+    address private constant SYSTEM = 0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE;
+
+    // Properties
+    address[] public _validators;
+    mapping(address => bool) public _addressToIsValidator;
+    mapping(address => uint256) public _addressToStakedAmount;
+    mapping(address => uint256) public _addressToValidatorIndex;
+    uint256 public _stakedAmount;
+    uint256 public _minimumNumValidators;
+    uint256 public _maximumNumValidators;
+    mapping(address => uint256) private _addressToJailedUntil;
+    mapping(bytes32 => bool) private _slashedEvidence;
+
+    // Events
+    event Staked(address indexed account, uint256 amount);
+    event Unstaked(address indexed account, uint256 amount);
+    event Slashed(address indexed account, uint256 amount);
+    event Jailed(address indexed account, uint256 until);
+
+    // Modifiers
+    modifier onlyEOA() {
+        require(!_isContract(msg.sender), "Only EOA can call function");
+        _;
+    }
+
+    modifier onlyStaker() {
+        require(_addressToStakedAmount[msg.sender] > 0, "Only staker can call function");
+        _;
+    }
+
+    modifier onlySystem() {
+        require(msg.sender == SYSTEM, "Only system can call function");
+        _;
+    }
+
+    constructor(uint256 minNumValidators, uint256 maxNumValidators) {
+        require(
+            minNumValidators <= maxNumValidators,
+            "Min validators num can not be greater than max num of validators"
+        );
+        _minimumNumValidators = minNumValidators;
+        _maximumNumValidators = maxNumValidators;
+    }
+
+    // View functions
+    function stakedAmount() public view returns (uint256) {
+        return _stakedAmount;
+    }
+
+    function validators() public view returns (address[] memory) {
+        return _validators;
+    }
+
+    function isValidator(address addr) public view returns (bool) {
+        return _addressToIsValidator[addr];
+    }
+
+    function accountStake(address addr) public view returns (uint256) {
+        return _addressToStakedAmount[addr];
+    }
+
+    function minimumNumValidators() public view returns (uint256) {
+        return _minimumNumValidators;
+    }
+
+    function maximumNumValidators() public view returns (uint256) {
+        return _maximumNumValidators;
+    }
+
+    function jailedUntil(address addr) public view returns (uint256) {
+        return _addressToJailedUntil[addr];
+    }
+
+    function isEvidenceSlashed(bytes32 evidence) public view returns (bool) {
+        return _slashedEvidence[evidence];
+    }
+
+    // Public functions
+    receive() external payable onlyEOA {
+        _stake();
+    }
+
+    function stake() public payable onlyEOA {
+        _stake();
+    }
+
+    function unstake() public onlyEOA onlyStaker {
+        _unstake();
+    }
+
+    // System functions
+
+    // slash burns the percentage of the stake of the account, for the evidence of a misbehaviour.
+    // The account is removed from the validators if its stake drops below the threshold,
+    // unless the validators would drop below their minimum number
+    function slash(
+        address account,
+        uint256 percentage,
+        bytes32 evidence
+    ) public onlySystem returns (uint256) {
+        require(percentage <= 100, "Invalid slash percentage");
+        require(!_slashedEvidence[evidence], "Evidence already slashed");
+
+        uint256 accountStakedAmount = _addressToStakedAmount[account];
+        require(accountStakedAmount > 0, "Account has no stake");
+
+        uint256 amount = (accountStakedAmount * percentage) / 100;
+
+        _slashedEvidence[evidence] = true;
+        _addressToStakedAmount[account] = accountStakedAmount - amount;
+        _stakedAmount -= amount;
+
+        if (
+            _isValidator(account) &&
+            _addressToStakedAmount[account] < VALIDATOR_THRESHOLD &&
+            _validators.length > _minimumNumValidators
+        ) {
+            _deleteFromValidators(account);
+        }
+
+        // the slashed stake is burned
+        payable(address(0)).transfer(amount);
+
+        emit Slashed(account, amount);
+
+        return amount;
+    }
+
+    // jail removes the account from the validators until the given block.
+    // The account isn't jailed, and false is returned, if the validators would drop below their minimum number
+    function jail(address account, uint256 until) public onlySystem returns (bool) {
+        if (_isValidator(account)) {
+            if (_validators.length <= _minimumNumValidators) {
+                return false;
+            }
+
+            _deleteFromValidators(account);
+        }
+
+        if (until > _addressToJailedUntil[account]) {
+            _addressToJailedUntil[account] = until;
+        }
+
+        emit Jailed(account, until);
+
+        return true;
+    }
+
+    // Private functions
+    function _stake() private {
+        _stakedAmount += msg.value;
+        _addressToStakedAmount[msg.sender] += msg.value;
+
+        if (_canBecomeValidator(msg.sender)) {
+            _appendToValidatorSet(msg.sender);
+        }
+
+        emit Staked(msg.sender, msg.value);
+    }
+
+    function _unstake() private {
+        uint256 amount = _addressToStakedAmount[msg.sender];
+
+        _addressToStakedAmount[msg.sender] = 0;
+        _stakedAmount -= amount;
+
+        if (_isValidator(msg.sender)) {
+            _deleteFromValidators(msg.sender);
+        }
+
+        payable(msg.sender).transfer(amount);
+        emit Unstaked(msg.sender, amount);
+    }
+
+    function _deleteFromValidators(address staker) private {
+        require(
+            _validators.length > _minimumNumValidators,
+            "Validators can't be less than the minimum required validator num"
+        );
+
+        require(
+            _addressToValidatorIndex[staker] < _validators.length,
+            "index out of range"
+        );
+
+        // index of removed address
+        uint256 index = _addressToValidatorIndex[staker];
+        uint256 lastIndex = _validators.length - 1;
+
+        if (index != lastIndex) {
+            // exchange between the element and last to pop for delete
+            address lastAddr = _validators[lastIndex];
+            _validators[index] = lastAddr;
+            _addressToValidatorIndex[lastAddr] = index;
+        }
+
+        _addressToIsValidator[staker] = false;
+        _addressToValidatorIndex[staker] = 0;
+        _validators.pop();
+    }
+
+    function _appendToValidatorSet(address newValidator) private {
+        require(
+            _validators.length < _maximumNumValidators,
+            "Validator set has reached full capacity"
+        );
+
+        _addressToIsValidator[newValidator] = true;
+        _addressToValidatorIndex[newValidator] = _validators.length;
+        _validators.push(newValidator);
+    }
+
+    function _isContract(address account) private view returns (bool) {
+        return account.code.length > 0;
+    }
+
+    function _isValidator(address account) private view returns (bool) {
+        return _addressToIsValidator[account];
+    }
+
+    function _canBecomeValidator(address account) private view returns (bool) {
+        return
+            !_isValidator(account) &&
+            _addressToStakedAmount[account] >= VALIDATOR_THRESHOLD;
+    }
+}
@@ -0,0 +1,142 @@
+package staking
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/abi"
+)
+
+var (
+	// Gas limit of the system calls slashing and jailing the validators
+	systemCallGasLimit uint64 = 1000000
+)
+
+// SystemCaller calls the staking contract as the system, outside of a transaction
+type SystemCaller interface {
+	Call2(caller types.Address, to types.Address, input []byte, value *big.Int, gas uint64) *runtime.ExecutionResult
+}
+
+// Slash burns the percentage of the stake of the account for the evidence of a misbehaviour,
+// and returns the slashed amount
+func Slash(t SystemCaller, account types.Address, percentage uint64, evidence types.Hash) (*big.Int, error) {
+	res, err := systemCall(t, "slash", map[string]interface{}{
+		"account":    account,
+		"percentage": new(big.Int).SetUint64(percentage),
+		"evidence":   evidence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount, ok := res["0"].(*big.Int)
+	if !ok {
+		return nil, errors.New("failed type assertion from results[0] to *big.Int")
+	}
+
+	return amount, nil
+}
+
+// Jail removes the account from the validators until the given block.
+// It returns false if the account can't be jailed, since the validators would drop below their minimum number
+func Jail(t SystemCaller, account types.Address, until uint64) (bool, error) {
+	res, err := systemCall(t, "jail", map[string]interface{}{
+		"account": account,
+		"until":   new(big.Int).SetUint64(until),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	jailed, ok := res["0"].(bool)
+	if !ok {
+		return false, errors.New("failed type assertion from results[0] to bool")
+	}
+
+	return jailed, nil
+}
+
+// QueryJailedUntil returns the block until which the account is jailed
+func QueryJailedUntil(t TxQueryHandler, from types.Address, account types.Address) (uint64, error) {
+	method, ok := abis.StakingABI.Methods["jailedUntil"]
+	if !ok {
+		return 0, errors.New("jailedUntil method doesn't exist in Staking contract ABI")
+	}
+
+	input, err := method.Encode([]interface{}{account})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := t.Apply(&types.Transaction{
+		From:     from,
+		To:       &AddrStakingContract,
+		Value:    big.NewInt(0),
+		Input:    input,
+		GasPrice: big.NewInt(0),
+		Gas:      queryGasLimit,
+		Nonce:    t.GetNonce(from),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if res.Failed() {
+		return 0, res.Err
+	}
+
+	until, err := decodeOutput(method, res.ReturnValue)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := until["0"].(*big.Int)
+	if !ok {
+		return 0, errors.New("failed type assertion from results[0] to *big.Int")
+	}
+
+	return value.Uint64(), nil
+}
+
+// systemCall calls the method of the staking contract from the system address,
+// and returns the decoded outputs
+func systemCall(t SystemCaller, name string, args map[string]interface{}) (map[string]interface{}, error) {
+	method, ok := abis.StakingABI.Methods[name]
+	if !ok {
+		return nil, fmt.Errorf("%s method doesn't exist in Staking contract ABI", name)
+	}
+
+	input, err := method.Encode(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res := t.Call2(types.SystemAddress, AddrStakingContract, input, big.NewInt(0), systemCallGasLimit)
+	if res.Failed() {
+		if reason, ok := runtime.DecodeRevert(res.ReturnValue); ok {
+			return nil, fmt.Errorf("%w, reason: %s", res.Err, reason)
+		}
+
+		return nil, res.Err
+	}
+
+	return decodeOutput(method, res.ReturnValue)
+}
+
+func decodeOutput(method *abi.Method, returnValue []byte) (map[string]interface{}, error) {
+	decodedResults, err := method.Outputs.Decode(returnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results, ok := decodedResults.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("failed type assertion from decodedResults to map")
+	}
+
+	return results, nil
+}
@@ -0,0 +1,219 @@
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validatorStake = new(big.Int).Mul(big.NewInt(10), big.NewInt(1000000000000000000))
+	evidence1      = types.StringToHash("1")
+	evidence2      = types.StringToHash("2")
+)
+
+// newStakingTransition returns a transition on the state of the predeployed staking SC
+func newStakingTransition(t *testing.T, validators []types.Address, minValidators uint64) *state.Transition {
+	t.Helper()
+
+	executor := state.NewExecutor(
+		&chain.Params{Forks: chain.AllForksEnabled},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	executor.SetRuntime(evm.NewEVM())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	contract, err := stakingHelper.PredeployStakingSC(validators, stakingHelper.PredeployParams{
+		MinValidatorCount: minValidators,
+		MaxValidatorCount: 10,
+	})
+	assert.NoError(t, err)
+
+	root := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		AddrStakingContract: contract,
+	})
+
+	transition, err := executor.BeginTxn(root, &types.Header{Number: 1, GasLimit: 10000000}, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	return transition
+}
+
+// callerMock calls the staking SC from the given caller
+type callerMock struct {
+	transition *state.Transition
+	caller     types.Address
+}
+
+func (m *callerMock) Call2(
+	_ types.Address,
+	to types.Address,
+	input []byte,
+	value *big.Int,
+	gas uint64,
+) *runtime.ExecutionResult {
+	return m.transition.Call2(m.caller, to, input, value, gas)
+}
+
+func queryValidators(t *testing.T, transition *state.Transition) []types.Address {
+	t.Helper()
+
+	validators, err := QueryValidators(transition, addr1)
+	assert.NoError(t, err)
+
+	return validators
+}
+
+func TestSlash(t *testing.T) {
+	t.Run("burns the percentage of the stake", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 1)
+
+		amount, err := Slash(transition, addr1, 10, evidence1)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(1000000000000000000), amount)
+
+		assert.Equal(t, amount, transition.GetBalance(types.ZeroAddress))
+		assert.Equal(
+			t,
+			new(big.Int).Sub(new(big.Int).Mul(big.NewInt(2), validatorStake), amount),
+			transition.GetBalance(AddrStakingContract),
+		)
+
+		// the stake is still above the threshold
+		assert.Equal(t, []types.Address{addr1, addr2}, queryValidators(t, transition))
+	})
+
+	t.Run("removes the validator below the threshold", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 1)
+
+		_, err := Slash(transition, addr1, 100, evidence1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []types.Address{addr2}, queryValidators(t, transition))
+	})
+
+	t.Run("keeps the minimum number of validators", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 2)
+
+		_, err := Slash(transition, addr1, 100, evidence1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []types.Address{addr1, addr2}, queryValidators(t, transition))
+	})
+
+	cases := []struct {
+		name       string
+		caller     types.Address
+		account    types.Address
+		percentage uint64
+		evidence   types.Hash
+		reason     string
+	}{
+		{
+			name:       "not called by the system",
+			caller:     addr1,
+			account:    addr1,
+			percentage: 10,
+			evidence:   evidence1,
+			reason:     "Only system can call function",
+		},
+		{
+			name:       "invalid percentage",
+			caller:     types.SystemAddress,
+			account:    addr1,
+			percentage: 101,
+			evidence:   evidence1,
+			reason:     "Invalid slash percentage",
+		},
+		{
+			name:       "evidence already slashed",
+			caller:     types.SystemAddress,
+			account:    addr2,
+			percentage: 10,
+			evidence:   evidence2,
+			reason:     "Evidence already slashed",
+		},
+		{
+			name:       "account without stake",
+			caller:     types.SystemAddress,
+			account:    types.StringToAddress("3"),
+			percentage: 10,
+			evidence:   evidence1,
+			reason:     "Account has no stake",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			transition := newStakingTransition(t, []types.Address{addr1, addr2}, 1)
+
+			_, err := Slash(transition, addr2, 10, evidence2)
+			assert.NoError(t, err)
+
+			_, err = Slash(&callerMock{transition, c.caller}, c.account, c.percentage, c.evidence)
+			assert.ErrorIs(t, err, runtime.ErrExecutionReverted)
+			assert.ErrorContains(t, err, c.reason)
+		})
+	}
+}
+
+func TestJail(t *testing.T) {
+	t.Run("jails the validator", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 1)
+
+		jailed, err := Jail(transition, addr1, 10)
+		assert.NoError(t, err)
+		assert.True(t, jailed)
+
+		assert.Equal(t, []types.Address{addr2}, queryValidators(t, transition))
+
+		until, err := QueryJailedUntil(transition, addr2, addr1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10), until)
+
+		// the jail isn't shortened
+		jailed, err = Jail(transition, addr1, 5)
+		assert.NoError(t, err)
+		assert.True(t, jailed)
+
+		until, err = QueryJailedUntil(transition, addr2, addr1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10), until)
+	})
+
+	t.Run("keeps the minimum number of validators", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 2)
+
+		jailed, err := Jail(transition, addr1, 10)
+		assert.NoError(t, err)
+		assert.False(t, jailed)
+
+		assert.Equal(t, []types.Address{addr1, addr2}, queryValidators(t, transition))
+
+		until, err := QueryJailedUntil(transition, addr2, addr1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), until)
+	})
+
+	t.Run("not called by the system", func(t *testing.T) {
+		transition := newStakingTransition(t, []types.Address{addr1, addr2}, 1)
+
+		_, err := Jail(&callerMock{transition, addr1}, addr1, 10)
+		assert.ErrorIs(t, err, runtime.ErrExecutionReverted)
+		assert.ErrorContains(t, err, "Only system can call function")
+	})
+}
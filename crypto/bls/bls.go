@@ -0,0 +1,235 @@
+// Package bls implements BLS signatures over the BLS12-381 curve, on top of github.com/kilic/bls12-381,
+// with the public keys in G2 and the signatures in G1 so the aggregated seals stay small.
+//
+// It follows the proof of possession scheme of the minimal signature size variant of the IETF BLS signatures
+// (draft-irtf-cfrg-bls-signature-05), the messages are hashed to G1 with the hash to curve suite of RFC 9380.
+// The keys and the signatures use the standard compressed encodings.
+// The rogue key attacks on the aggregated signatures are prevented by the proof of possession,
+// which has to be checked before a public key is aggregated
+package bls
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+const (
+	// SecretKeySize is the size of an encoded secret key
+	SecretKeySize = 32
+
+	// PublicKeySize is the size of an encoded public key
+	PublicKeySize = 96
+
+	// SignatureSize is the size of an encoded signature
+	SignatureSize = 48
+)
+
+var (
+	// signatureDomain is the domain of the signed messages, in the ciphersuite BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_
+	signatureDomain = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+
+	// possessionDomain is the domain of the proofs of possession of the same ciphersuite
+	possessionDomain = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+
+	// order is the order r of the subgroups
+	order = bls12381.NewG1().Q()
+)
+
+var (
+	ErrInvalidSecretKey = errors.New("invalid BLS secret key")
+	ErrInvalidPublicKey = errors.New("invalid BLS public key")
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+	ErrNoSignatures     = errors.New("no signatures to aggregate")
+	ErrNoPublicKeys     = errors.New("no public keys to aggregate")
+)
+
+// The groups of the library hold their own buffers, so they are instantiated for each operation.
+// The points are kept in affine coordinates, so reading them doesn't modify them
+
+// SecretKey is a BLS secret key, a non zero scalar of the subgroups
+type SecretKey struct {
+	s *big.Int
+}
+
+// PublicKey is a BLS public key, a point of G2
+type PublicKey struct {
+	point *bls12381.PointG2
+}
+
+// Signature is a BLS signature or an aggregated signature, a point of G1
+type Signature struct {
+	point *bls12381.PointG1
+}
+
+// GenerateSecretKey generates a random secret key
+func GenerateSecretKey() (*SecretKey, error) {
+	// 64 bytes are reduced modulo r, the bias is negligible
+	buf := make([]byte, 64)
+
+	for {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+
+		s := new(big.Int).SetBytes(buf)
+		if s.Mod(s, order).Sign() != 0 {
+			return &SecretKey{s: s}, nil
+		}
+	}
+}
+
+// UnmarshalSecretKey decodes a big endian secret key
+func UnmarshalSecretKey(buf []byte) (*SecretKey, error) {
+	if len(buf) != SecretKeySize {
+		return nil, fmt.Errorf("%w, expected %d bytes but got %d", ErrInvalidSecretKey, SecretKeySize, len(buf))
+	}
+
+	s := new(big.Int).SetBytes(buf)
+	if s.Sign() == 0 || s.Cmp(order) >= 0 {
+		return nil, ErrInvalidSecretKey
+	}
+
+	return &SecretKey{s: s}, nil
+}
+
+// Marshal encodes the secret key in big endian
+func (k *SecretKey) Marshal() []byte {
+	return k.s.FillBytes(make([]byte, SecretKeySize))
+}
+
+// PublicKey returns the public key of the secret key
+func (k *SecretKey) PublicKey() *PublicKey {
+	g2 := bls12381.NewG2()
+
+	return &PublicKey{point: g2.Affine(g2.MulScalarBig(g2.New(), g2.One(), k.s))}
+}
+
+// Sign signs the message
+func (k *SecretKey) Sign(msg []byte) *Signature {
+	return k.sign(signatureDomain, msg)
+}
+
+// ProvePossession returns the proof of possession of the secret key, a signature of the public key
+// in the domain of the proofs
+func (k *SecretKey) ProvePossession() *Signature {
+	return k.sign(possessionDomain, k.PublicKey().Marshal())
+}
+
+func (k *SecretKey) sign(domain, msg []byte) *Signature {
+	g1 := bls12381.NewG1()
+
+	return &Signature{point: g1.Affine(g1.MulScalarBig(g1.New(), hashToG1(g1, domain, msg), k.s))}
+}
+
+// UnmarshalPublicKey decodes a compressed public key, checking it is a valid point of G2
+func UnmarshalPublicKey(buf []byte) (*PublicKey, error) {
+	g2 := bls12381.NewG2()
+
+	point, err := g2.FromCompressed(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidPublicKey, err)
+	}
+
+	if g2.IsZero(point) {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return &PublicKey{point: point}, nil
+}
+
+// Marshal returns the compressed encoding of the public key
+func (k *PublicKey) Marshal() []byte {
+	return bls12381.NewG2().ToCompressed(k.point)
+}
+
+// Equal checks the public keys are the same
+func (k *PublicKey) Equal(other *PublicKey) bool {
+	return bls12381.NewG2().Equal(k.point, other.point)
+}
+
+// AggregatePublicKeys returns the public key of the signatures aggregated from the keys
+func AggregatePublicKeys(keys []*PublicKey) (*PublicKey, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoPublicKeys
+	}
+
+	g2 := bls12381.NewG2()
+
+	point := g2.Zero()
+	for _, key := range keys {
+		g2.Add(point, point, key.point)
+	}
+
+	return &PublicKey{point: g2.Affine(point)}, nil
+}
+
+// UnmarshalSignature decodes a compressed signature, checking it is a valid point of G1
+func UnmarshalSignature(buf []byte) (*Signature, error) {
+	point, err := bls12381.NewG1().FromCompressed(buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidSignature, err)
+	}
+
+	return &Signature{point: point}, nil
+}
+
+// Marshal returns the compressed encoding of the signature
+func (s *Signature) Marshal() []byte {
+	return bls12381.NewG1().ToCompressed(s.point)
+}
+
+// Verify checks the signature of the message by the public key,
+// which can be an aggregated key if the signature is aggregated from signatures of the same message
+func (s *Signature) Verify(key *PublicKey, msg []byte) bool {
+	return s.verify(key, signatureDomain, msg)
+}
+
+// VerifyPossession checks the signature is a proof of possession of the secret key of the public key
+func (s *Signature) VerifyPossession(key *PublicKey) bool {
+	return s.verify(key, possessionDomain, key.Marshal())
+}
+
+// verify checks e(signature, g2) = e(hash, key)
+func (s *Signature) verify(key *PublicKey, domain, msg []byte) bool {
+	engine := bls12381.NewEngine()
+
+	if engine.G1.IsZero(s.point) || engine.G2.IsZero(key.point) {
+		return false
+	}
+
+	engine.AddPairInv(s.point, engine.G2.One())
+	engine.AddPair(hashToG1(engine.G1, domain, msg), key.point)
+
+	return engine.Check()
+}
+
+// AggregateSignatures aggregates the signatures in a single one
+func AggregateSignatures(signatures []*Signature) (*Signature, error) {
+	if len(signatures) == 0 {
+		return nil, ErrNoSignatures
+	}
+
+	g1 := bls12381.NewG1()
+
+	point := g1.Zero()
+	for _, signature := range signatures {
+		g1.Add(point, point, signature.point)
+	}
+
+	return &Signature{point: g1.Affine(point)}, nil
+}
+
+// hashToG1 hashes the message to a point of G1, with the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite
+func hashToG1(g1 *bls12381.G1, domain, msg []byte) *bls12381.PointG1 {
+	point, err := g1.HashToCurve(msg, domain)
+	if err != nil {
+		// it only fails for domains longer than 255 bytes
+		panic(fmt.Errorf("unable to hash to G1, %w", err))
+	}
+
+	return point
+}
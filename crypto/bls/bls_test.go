@@ -0,0 +1,159 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateKeys(t *testing.T, n int) ([]*SecretKey, []*PublicKey) {
+	t.Helper()
+
+	secretKeys := make([]*SecretKey, n)
+	publicKeys := make([]*PublicKey, n)
+
+	for i := range secretKeys {
+		key, err := GenerateSecretKey()
+		assert.NoError(t, err)
+
+		secretKeys[i] = key
+		publicKeys[i] = key.PublicKey()
+	}
+
+	return secretKeys, publicKeys
+}
+
+func TestEncoding_Generator(t *testing.T) {
+	// the standard compressed encoding of the generator of G2
+	one, err := UnmarshalSecretKey(append(make([]byte, SecretKeySize-1), 1))
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"0x93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e"+
+			"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8",
+		hex.EncodeToHex(one.PublicKey().Marshal()),
+	)
+}
+
+func TestEncoding_RoundTrip(t *testing.T) {
+	secretKeys, publicKeys := generateKeys(t, 3)
+
+	for i, secretKey := range secretKeys {
+		decodedSecret, err := UnmarshalSecretKey(secretKey.Marshal())
+		assert.NoError(t, err)
+		assert.Equal(t, secretKey.s, decodedSecret.s)
+
+		decodedPublic, err := UnmarshalPublicKey(publicKeys[i].Marshal())
+		assert.NoError(t, err)
+		assert.True(t, publicKeys[i].Equal(decodedPublic))
+
+		signature := secretKey.Sign([]byte("message"))
+		decodedSignature, err := UnmarshalSignature(signature.Marshal())
+		assert.NoError(t, err)
+		assert.Equal(t, signature.Marshal(), decodedSignature.Marshal())
+		assert.True(t, decodedSignature.Verify(decodedPublic, []byte("message")))
+	}
+}
+
+func TestEncoding_Errors(t *testing.T) {
+	_, publicKeys := generateKeys(t, 1)
+	valid := publicKeys[0].Marshal()
+
+	uncompressed := append([]byte{}, valid...)
+	uncompressed[0] &^= 0x80
+
+	infinity := make([]byte, PublicKeySize)
+	infinity[0] = 0xc0
+
+	// x = 0 is not on the twist since 4(u + 1) is not a square
+	notOnCurve := make([]byte, PublicKeySize)
+	notOnCurve[0] = 0x80
+
+	cases := []struct {
+		name string
+		buf  []byte
+	}{
+		{"short", valid[1:]},
+		{"uncompressed", uncompressed},
+		{"infinity", infinity},
+		{"not on curve", notOnCurve},
+	}
+
+	for _, c := range cases {
+		_, err := UnmarshalPublicKey(c.buf)
+		assert.ErrorIs(t, err, ErrInvalidPublicKey, c.name)
+	}
+
+	_, err := UnmarshalSecretKey(make([]byte, SecretKeySize))
+	assert.ErrorIs(t, err, ErrInvalidSecretKey)
+
+	_, err = UnmarshalSignature(make([]byte, SignatureSize))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestSignature_Verify(t *testing.T) {
+	secretKeys, publicKeys := generateKeys(t, 2)
+	msg := []byte("message")
+
+	signature := secretKeys[0].Sign(msg)
+
+	assert.True(t, signature.Verify(publicKeys[0], msg))
+	assert.False(t, signature.Verify(publicKeys[1], msg))
+	assert.False(t, signature.Verify(publicKeys[0], []byte("other message")))
+
+	// the proof of possession isn't a signature of the public key
+	proof := secretKeys[0].ProvePossession()
+
+	assert.True(t, proof.VerifyPossession(publicKeys[0]))
+	assert.False(t, proof.VerifyPossession(publicKeys[1]))
+	assert.False(t, proof.Verify(publicKeys[0], publicKeys[0].Marshal()))
+	assert.False(t, secretKeys[0].Sign(publicKeys[0].Marshal()).VerifyPossession(publicKeys[0]))
+}
+
+func TestSignature_Aggregate(t *testing.T) {
+	secretKeys, publicKeys := generateKeys(t, 4)
+	msg := []byte("message")
+
+	signatures := make([]*Signature, len(secretKeys))
+	for i, key := range secretKeys {
+		signatures[i] = key.Sign(msg)
+	}
+
+	aggregated, err := AggregateSignatures(signatures[:3])
+	assert.NoError(t, err)
+
+	aggregatedKey, err := AggregatePublicKeys(publicKeys[:3])
+	assert.NoError(t, err)
+	assert.True(t, aggregated.Verify(aggregatedKey, msg))
+
+	// a missing or an extra signer breaks the aggregate
+	otherKey, err := AggregatePublicKeys(publicKeys[1:])
+	assert.NoError(t, err)
+	assert.False(t, aggregated.Verify(otherKey, msg))
+
+	_, err = AggregateSignatures(nil)
+	assert.ErrorIs(t, err, ErrNoSignatures)
+
+	_, err = AggregatePublicKeys(nil)
+	assert.ErrorIs(t, err, ErrNoPublicKeys)
+}
+
+func TestHashToG1(t *testing.T) {
+	// the test vector of the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite in RFC 9380, for the empty message
+	g1 := bls12381.NewG1()
+	point := hashToG1(g1, []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_"), []byte{})
+
+	assert.Equal(
+		t,
+		"0x052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1"+
+			"08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265",
+		hex.EncodeToHex(g1.ToUncompressed(point)),
+	)
+
+	assert.False(t, g1.Equal(
+		hashToG1(g1, signatureDomain, []byte("message")),
+		hashToG1(g1, possessionDomain, []byte("message")),
+	))
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/helper/keystore"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -291,6 +292,36 @@ func GenerateAndEncodePrivateKey() (*ecdsa.PrivateKey, []byte, error) {
 	return privateKey, keyBuff, nil
 }
 
+// GenerateAndEncodeBLSSecretKey returns a newly generated BLS secret key and its hex encoding
+func GenerateAndEncodeBLSSecretKey() (*bls.SecretKey, []byte, error) {
+	key, err := bls.GenerateSecretKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, []byte(hex.EncodeToString(key.Marshal())), nil
+}
+
+// BytesToBLSSecretKey decodes the hex encoded BLS secret key
+func BytesToBLSSecretKey(input []byte) (*bls.SecretKey, error) {
+	decoded, err := hex.DecodeString(string(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.UnmarshalSecretKey(decoded)
+}
+
+// ReadConsensusBLSKey reads the BLS secret key of the validator from the secrets manager
+func ReadConsensusBLSKey(manager secrets.SecretsManager) (*bls.SecretKey, error) {
+	validatorKey, err := manager.GetSecret(secrets.ValidatorBLSKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return BytesToBLSSecretKey(validatorKey)
+}
+
 func ReadConsensusKey(manager secrets.SecretsManager) (*ecdsa.PrivateKey, error) {
 	validatorKey, err := manager.GetSecret(secrets.ValidatorKey)
 	if err != nil {
@@ -255,3 +255,15 @@ func TestPrivateKeyGeneration(t *testing.T) {
 	assert.True(t, writtenKey.Equal(readKey))
 	assert.Equal(t, writtenAddress.String(), readAddress.String())
 }
+
+func TestBLSSecretKeyEncoding(t *testing.T) {
+	key, encoded, err := GenerateAndEncodeBLSSecretKey()
+	assert.NoError(t, err)
+
+	decoded, err := BytesToBLSSecretKey(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, key.Marshal(), decoded.Marshal())
+
+	_, err = BytesToBLSSecretKey(encoded[2:])
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -40,6 +41,10 @@ func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
 	return signer
 }
 
+var (
+	ErrInvalidChainID = errors.New("invalid chain id for signer")
+)
+
 type FrontierSigner struct {
 }
 
@@ -77,6 +82,40 @@ func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 	return types.BytesToHash(hash)
 }
 
+// calcTypedTxHash calculates the signing hash of a typed transaction (EIP-2718),
+// the keccak256 hash of the type followed by the RLP payload without the signature values
+func calcTypedTxHash(tx *types.Transaction) types.Hash {
+	a := signerPool.Get()
+
+	v := a.NewArray()
+	v.Set(a.NewBigInt(tx.ChainID))
+	v.Set(a.NewUint(tx.Nonce))
+
+	if tx.IsDynamicFee() {
+		v.Set(a.NewBigInt(tx.GasTipCap))
+	}
+
+	v.Set(a.NewBigInt(tx.GasPrice))
+	v.Set(a.NewUint(tx.Gas))
+
+	if tx.To == nil {
+		v.Set(a.NewNull())
+	} else {
+		v.Set(a.NewCopyBytes((*tx.To).Bytes()))
+	}
+
+	v.Set(a.NewBigInt(tx.Value))
+	v.Set(a.NewCopyBytes(tx.Input))
+	v.Set(tx.AccessList.MarshalRLPWith(a))
+
+	payload := v.MarshalTo([]byte{byte(tx.Type)})
+	hash := keccak.Keccak256(nil, payload)
+
+	signerPool.Put(a)
+
+	return types.BytesToHash(hash)
+}
+
 // Hash is a wrapper function for the calcTxHash, with chainID 0
 func (f *FrontierSigner) Hash(tx *types.Transaction) types.Hash {
 	return calcTxHash(tx, 0)
@@ -90,6 +129,10 @@ var (
 
 // Sender decodes the signature and returns the sender of the transaction
 func (f *FrontierSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.IsTyped() {
+		return types.Address{}, types.ErrTxTypeNotSupported
+	}
+
 	refV := big.NewInt(0)
 	if tx.V != nil {
 		refV.SetBytes(tx.V.Bytes())
@@ -117,6 +160,10 @@ func (f *FrontierSigner) SignTx(
 	tx *types.Transaction,
 	privateKey *ecdsa.PrivateKey,
 ) (*types.Transaction, error) {
+	if tx.IsTyped() {
+		return nil, types.ErrTxTypeNotSupported
+	}
+
 	tx = tx.Copy()
 
 	h := f.Hash(tx)
@@ -150,13 +197,22 @@ type EIP155Signer struct {
 	chainID uint64
 }
 
-// Hash is a wrapper function that calls calcTxHash with the EIP155Signer's chainID
+// Hash is a wrapper function that calls calcTxHash with the EIP155Signer's chainID.
+// Typed transactions carry their own chainID
 func (e *EIP155Signer) Hash(tx *types.Transaction) types.Hash {
+	if tx.IsTyped() {
+		return calcTypedTxHash(tx)
+	}
+
 	return calcTxHash(tx, e.chainID)
 }
 
 // Sender returns the transaction sender
 func (e *EIP155Signer) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.IsTyped() {
+		return e.typedSender(tx)
+	}
+
 	protected := true
 
 	// Check if v value conforms to an earlier standard (before EIP155)
@@ -194,13 +250,48 @@ func (e *EIP155Signer) Sender(tx *types.Transaction) (types.Address, error) {
 	return types.BytesToAddress(buf), nil
 }
 
-// SignTx signs the transaction using the passed in private key
+// typedSender returns the sender of a typed transaction,
+// whose V value is the signature y-parity
+func (e *EIP155Signer) typedSender(tx *types.Transaction) (types.Address, error) {
+	if tx.Type != types.AccessListTx && tx.Type != types.DynamicFeeTx {
+		return types.Address{}, types.ErrTxTypeNotSupported
+	}
+
+	if tx.ChainID == nil || !tx.ChainID.IsUint64() || tx.ChainID.Uint64() != e.chainID {
+		return types.Address{}, ErrInvalidChainID
+	}
+
+	if tx.V == nil || !tx.V.IsUint64() || tx.V.Uint64() > 1 {
+		return types.Address{}, fmt.Errorf("invalid txn signature")
+	}
+
+	sig, err := encodeSignature(tx.R, tx.S, byte(tx.V.Uint64()))
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(e.Hash(tx).Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
+// SignTx signs the transaction using the passed in private key.
+// Typed transactions without a chainID are signed for the signer's chainID
 func (e *EIP155Signer) SignTx(
 	tx *types.Transaction,
 	privateKey *ecdsa.PrivateKey,
 ) (*types.Transaction, error) {
 	tx = tx.Copy()
 
+	if tx.IsTyped() && tx.ChainID == nil {
+		tx.ChainID = new(big.Int).SetUint64(e.chainID)
+	}
+
 	h := e.Hash(tx)
 
 	sig, err := Sign(privateKey, h[:])
@@ -210,7 +301,12 @@ func (e *EIP155Signer) SignTx(
 
 	tx.R = new(big.Int).SetBytes(sig[:32])
 	tx.S = new(big.Int).SetBytes(sig[32:64])
-	tx.V = new(big.Int).SetBytes(e.CalculateV(sig[64]))
+
+	if tx.IsTyped() {
+		tx.V = new(big.Int).SetUint64(uint64(sig[64]))
+	} else {
+		tx.V = new(big.Int).SetBytes(e.CalculateV(sig[64]))
+	}
 
 	return tx, nil
 }
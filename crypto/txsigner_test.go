@@ -143,3 +143,90 @@ func TestEIP155Signer_ChainIDMismatch(t *testing.T) {
 		}
 	}
 }
+
+func TestEIP155Signer_AccessListTx(t *testing.T) {
+	t.Parallel()
+
+	toAddress := types.StringToAddress("1")
+
+	key, err := GenerateKey()
+	assert.NoError(t, err)
+
+	txn := &types.Transaction{
+		Type:     types.AccessListTx,
+		To:       &toAddress,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(0),
+		AccessList: types.TxAccessList{
+			{
+				Address:     toAddress,
+				StorageKeys: []types.Hash{types.StringToHash("1")},
+			},
+		},
+	}
+
+	signer := NewEIP155Signer(100)
+
+	signedTx, err := signer.SignTx(txn, key)
+	assert.NoError(t, err)
+
+	// the chain ID is set by the signer and V is the signature y-parity
+	assert.Equal(t, uint64(100), signedTx.ChainID.Uint64())
+	assert.True(t, signedTx.V.Uint64() <= 1)
+
+	sender, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), sender)
+
+	// the access list is part of the signed payload
+	tamperedTx := signedTx.Copy()
+	tamperedTx.AccessList[0].StorageKeys[0] = types.StringToHash("2")
+
+	tamperedSender, err := signer.Sender(tamperedTx)
+	if err == nil {
+		assert.NotEqual(t, sender, tamperedSender)
+	}
+
+	// other chains reject the transaction
+	_, err = NewEIP155Signer(1).Sender(signedTx)
+	assert.ErrorIs(t, err, ErrInvalidChainID)
+
+	// frontier signer does not support typed transactions
+	_, err = (&FrontierSigner{}).Sender(signedTx)
+	assert.ErrorIs(t, err, types.ErrTxTypeNotSupported)
+}
+
+func TestEIP155Signer_DynamicFeeTx(t *testing.T) {
+	t.Parallel()
+
+	toAddress := types.StringToAddress("1")
+
+	key, err := GenerateKey()
+	assert.NoError(t, err)
+
+	txn := &types.Transaction{
+		Type:      types.DynamicFeeTx,
+		To:        &toAddress,
+		Value:     big.NewInt(1),
+		GasPrice:  big.NewInt(10),
+		GasTipCap: big.NewInt(2),
+	}
+
+	signer := NewEIP155Signer(100)
+
+	signedTx, err := signer.SignTx(txn, key)
+	assert.NoError(t, err)
+
+	sender, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), sender)
+
+	// the tip is part of the signed payload
+	tamperedTx := signedTx.Copy()
+	tamperedTx.GasTipCap = big.NewInt(3)
+
+	tamperedSender, err := signer.Sender(tamperedTx)
+	if err == nil {
+		assert.NotEqual(t, sender, tamperedSender)
+	}
+}
@@ -48,6 +48,7 @@ type TestServerConfig struct {
 	Signer                  *crypto.EIP155Signer // Signer used for transactions
 	MinValidatorCount       uint64               // Min validator count
 	MaxValidatorCount       uint64               // Max validator count
+	DowntimeThreshold       uint64               // Missed proposals in an epoch for which a PoS validator is jailed
 	BlockTime               uint64               // Minimum block generation time (in s)
 }
 
@@ -171,3 +172,9 @@ func (t *TestServerConfig) SetMinValidatorCount(val uint64) {
 func (t *TestServerConfig) SetMaxValidatorCount(val uint64) {
 	t.MaxValidatorCount = val
 }
+
+// SetDowntimeThreshold sets the number of missed proposals in an epoch
+// for which a PoS validator is jailed
+func (t *TestServerConfig) SetDowntimeThreshold(threshold uint64) {
+	t.DowntimeThreshold = threshold
+}
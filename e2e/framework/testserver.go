@@ -296,6 +296,10 @@ func (t *TestServer) GenerateGenesis() error {
 
 		args = append(args, "--min-validator-count", strconv.FormatUint(t.Config.MinValidatorCount, 10))
 		args = append(args, "--max-validator-count", strconv.FormatUint(t.Config.MaxValidatorCount, 10))
+
+		if t.Config.DowntimeThreshold != 0 {
+			args = append(args, "--pos-downtime-threshold", strconv.FormatUint(t.Config.DowntimeThreshold, 10))
+		}
 	}
 
 	// add block gas limit
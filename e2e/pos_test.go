@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/e2e/framework"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -880,3 +881,203 @@ func TestSnapshotUpdating(t *testing.T) {
 		)
 	}
 }
+
+func TestPoS_DowntimeJailing(t *testing.T) {
+	stakerKey, stakerAddr := tests.GenerateKeyAndAddr(t)
+	defaultBalance := framework.EthToWei(100)
+	stakeAmount := framework.EthToWei(5)
+
+	// every validator proposes at least once in the blocks of an epoch
+	epochSize := uint64(10)
+
+	numGenesisValidators := IBFTMinNodes
+	ibftManager := framework.NewIBFTServersManager(
+		t,
+		numGenesisValidators,
+		IBFTDirPrefix,
+		func(i int, config *framework.TestServerConfig) {
+			config.SetSeal(true)
+			config.SetEpochSize(epochSize)
+			config.PremineValidatorBalance(defaultBalance)
+			config.Premine(stakerAddr, defaultBalance)
+			config.SetIBFTPoS(true)
+			config.SetDowntimeThreshold(1)
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	ibftManager.StartServers(ctx)
+
+	srv := ibftManager.GetServer(0)
+	client := srv.JSONRPC()
+
+	// The staker becomes a validator, but doesn't run a node
+	if stakeError := framework.StakeAmount(
+		stakerAddr,
+		stakerKey,
+		stakeAmount,
+		srv,
+	); stakeError != nil {
+		t.Fatalf("Unable to stake amount, %v", stakeError)
+	}
+
+	validateValidatorSet(t, stakerAddr, client, true, numGenesisValidators+1)
+
+	blockNumber, err := client.Eth().BlockNumber()
+	if err != nil {
+		t.Fatalf("Unable to fetch block number, %v", err)
+	}
+
+	// The staker joins the validators at the next epoch, and misses its turns
+	// to propose during the following one
+	jailBlock := getNextEpochBlock(blockNumber, epochSize) + epochSize
+
+	servers := make([]*framework.TestServer, 0, numGenesisValidators)
+	for i := 0; i < numGenesisValidators; i++ {
+		servers = append(servers, ibftManager.GetServer(i))
+	}
+
+	if waitErrors := framework.WaitForServersToSeal(servers, jailBlock); len(waitErrors) != 0 {
+		t.Fatalf("Unable to wait for all nodes to seal blocks, %v", waitErrors)
+	}
+
+	// The offline validator is jailed and removed from the validators, but keeps its stake
+	validateValidatorSet(t, stakerAddr, client, false, numGenesisValidators)
+
+	stakedAmount, stakedAmountErr := framework.GetStakedAmount(stakerAddr, client)
+	if stakedAmountErr != nil {
+		t.Fatalf("Unable to get staked amount, %v", stakedAmountErr)
+	}
+
+	expectedStake := big.NewInt(0).Mul(
+		getBigDefaultStakedBalance(t),
+		big.NewInt(int64(numGenesisValidators)),
+	)
+	expectedStake.Add(expectedStake, stakeAmount)
+
+	assert.Equal(t, expectedStake.String(), stakedAmount.String())
+}
+
+// signedEquivocationMsg returns a commit message of the view, signed by the validator key
+func signedEquivocationMsg(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	sequence uint64,
+	digest string,
+) *ibftOp.MessageReq {
+	t.Helper()
+
+	msg := &ibftOp.MessageReq{
+		Type:   ibftOp.MessageReq_Commit,
+		View:   ibftOp.ViewMsg(sequence, 0),
+		Digest: digest,
+	}
+
+	payload, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatalf("Unable to get the message payload, %v", err)
+	}
+
+	signature, err := crypto.Sign(key, crypto.Keccak256(payload))
+	if err != nil {
+		t.Fatalf("Unable to sign the message, %v", err)
+	}
+
+	msg.Signature = hex.EncodeToHex(signature)
+
+	return msg
+}
+
+func TestPoS_EquivocationSlashing(t *testing.T) {
+	numGenesisValidators := IBFTMinNodes
+	ibftManager := framework.NewIBFTServersManager(
+		t,
+		numGenesisValidators,
+		IBFTDirPrefix,
+		func(i int, config *framework.TestServerConfig) {
+			config.SetSeal(true)
+			config.SetEpochSize(100)
+			config.SetIBFTPoS(true)
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	ibftManager.StartServers(ctx)
+
+	srv := ibftManager.GetServer(0)
+	client := srv.JSONRPC()
+
+	servers := make([]*framework.TestServer, 0, numGenesisValidators)
+	for i := 0; i < numGenesisValidators; i++ {
+		servers = append(servers, ibftManager.GetServer(i))
+	}
+
+	if waitErrors := framework.WaitForServersToSeal(servers, 2); len(waitErrors) != 0 {
+		t.Fatalf("Unable to wait for all nodes to seal blocks, %v", waitErrors)
+	}
+
+	// The offender signed two commits for different proposals of the first block
+	offender := ibftManager.GetServer(numGenesisValidators - 1)
+
+	offenderKey, err := offender.Config.PrivateKey()
+	if err != nil {
+		t.Fatalf("Unable to read the validator key, %v", err)
+	}
+
+	offenderAddr := crypto.PubKeyToAddress(&offenderKey.PublicKey)
+
+	evidence := &ibftOp.EquivocationReq{
+		First:  signedEquivocationMsg(t, offenderKey, 1, "digest1"),
+		Second: signedEquivocationMsg(t, offenderKey, 1, "digest2"),
+	}
+
+	// The evidence is reported by the next proposer, whichever node it is
+	for _, server := range servers {
+		if _, err := server.IBFTOperator().ReportEquivocation(ctx, evidence); err != nil {
+			t.Fatalf("Unable to report the equivocation, %v", err)
+		}
+	}
+
+	blockNumber, err := client.Eth().BlockNumber()
+	if err != nil {
+		t.Fatalf("Unable to fetch block number, %v", err)
+	}
+
+	if waitErrors := framework.WaitForServersToSeal(servers, blockNumber+2); len(waitErrors) != 0 {
+		t.Fatalf("Unable to wait for all nodes to seal blocks, %v", waitErrors)
+	}
+
+	// The offender is jailed and removed from the validators
+	validateValidatorSet(t, offenderAddr, client, false, numGenesisValidators-1)
+
+	// 10% of its stake is burned
+	slashed := big.NewInt(0).Div(getBigDefaultStakedBalance(t), big.NewInt(10))
+
+	stakedAmount, stakedAmountErr := framework.GetStakedAmount(offenderAddr, client)
+	if stakedAmountErr != nil {
+		t.Fatalf("Unable to get staked amount, %v", stakedAmountErr)
+	}
+
+	expectedStake := big.NewInt(0).Mul(
+		getBigDefaultStakedBalance(t),
+		big.NewInt(int64(numGenesisValidators)),
+	)
+	expectedStake.Sub(expectedStake, slashed)
+
+	assert.Equal(t, expectedStake.String(), stakedAmount.String())
+	assert.Equal(t, slashed.String(), framework.GetAccountBalance(t, types.ZeroAddress, client).String())
+
+	// The evidence left in the pool of the other nodes isn't slashed again
+	lastBlock := blockNumber + 2 + uint64(numGenesisValidators)
+
+	if waitErrors := framework.WaitForServersToSeal(servers, lastBlock); len(waitErrors) != 0 {
+		t.Fatalf("Unable to wait for all nodes to seal blocks, %v", waitErrors)
+	}
+
+	stakedAmount, stakedAmountErr = framework.GetStakedAmount(offenderAddr, client)
+	if stakedAmountErr != nil {
+		t.Fatalf("Unable to get staked amount, %v", stakedAmountErr)
+	}
+
+	assert.Equal(t, expectedStake.String(), stakedAmount.String())
+}
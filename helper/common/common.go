@@ -9,6 +9,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -143,6 +144,35 @@ func (d *JSONNumber) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// JSONDuration is the duration represented as a string in json, such as "500ms" or "10s"
+type JSONDuration struct {
+	Value time.Duration
+}
+
+func (d *JSONDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Value.String())
+}
+
+func (d *JSONDuration) UnmarshalJSON(data []byte) error {
+	var rawValue string
+	if err := json.Unmarshal(data, &rawValue); err != nil {
+		return err
+	}
+
+	val, err := time.ParseDuration(rawValue)
+	if err != nil {
+		return err
+	}
+
+	if val < 0 {
+		return errors.New("must be positive value")
+	}
+
+	d.Value = val
+
+	return nil
+}
+
 // GetTerminationSignalCh returns a channel to emit signals by ctrl + c
 func GetTerminationSignalCh() <-chan os.Signal {
 	// wait for the user to quit with ctrl-c
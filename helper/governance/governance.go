@@ -0,0 +1,92 @@
+package governance
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrNoValidators        = errors.New("the governance contract needs at least one validator")
+	ErrDuplicatedValidator = errors.New("duplicated validator")
+)
+
+// Slot definitions for SC storage, the proposals are empty when predeploying
+var (
+	validatorsSlot              = int64(0) // Slot 0
+	addressToIsValidatorSlot    = int64(1) // Slot 1
+	addressToValidatorIndexSlot = int64(2) // Slot 2
+)
+
+// GovernanceSCBytecode is the runtime code of contracts/governance/Governance.sol,
+// which manages the PoA validators. Its storage follows the Solidity layout of the contract
+const (
+	//nolint: lll
+	GovernanceSCBytecode = "0x3461003f576004361061003f5760003560e01c8063ca1e781914610044578063facd743b1461008857806342c936a9146100ca57806389b3bc8414610126575b600080fd5b602061040052600054806104205260008052602060002060005b8281101561007b578082015481602002610440015260010161005e565b5050602002604001610400f35b6024361061003f576004358073ffffffffffffffffffffffffffffffffffffffff1681141561003f576000526001602052604060002054151560005260206000f35b6044361061003f576004358073ffffffffffffffffffffffffffffffffffffffff1681141561003f576080526024358060011061003f5760a05260035460c0526060608020600052600460205260406000205460005260206000f35b6044361061003f576004358073ffffffffffffffffffffffffffffffffffffffff1681141561003f576080526024358060011061003f5760a0523360005260016020526040600020541561003f576080511561003f576080516000526001602052604060002054151560a0511461003f5760a0516101a95760026000541061003f575b60035460c0526060608020806101005260005260056020526040600020602052336000526040600020805461003f576001905561010051600052600460205260406000208054600101809155608051337fbbcca6f8778ffd7765644921c17ed2de4add7a9b4ca1a29614a6295edd4a2089602060a0a36002026000541061022c57005b60035460010160035560a05161030b5760008052602060002061016052608051600052600260205260406000205461012052600160005403610140526101405161012051146102a85761014051610160510154610180526101805161012051610160510155610120516101805160005260026020526040600020555b6000610140516101605101556000608051600052600160205260406000205560006080516000526002602052604060002055610140516000556080517fe1434e25d6611e0db941968fdc97811c982ac1602e951637d206f5fdda9dd8f1600080a2005b6000546101405260805161014051600080526020600020015561014051608051600052600260205260406000205560016080516000526001602052604060002055610140516001016000556080517fe366c1c0452ed8eec96861e9e54141ebff23c9ec89fe27b996b45f5ec3884987600080a200"
+)
+
+// getAddressMapping returns the key for the SC storage mapping (address => something)
+func getAddressMapping(address types.Address, slot int64) []byte {
+	return keccak.Keccak256(
+		nil,
+		append(
+			common.PadLeftOrTrim(address.Bytes(), 32),
+			common.PadLeftOrTrim(big.NewInt(slot).Bytes(), 32)...,
+		),
+	)
+}
+
+// getValidatorsIndex returns the storage index of the validator at the position in the validators array
+func getValidatorsIndex(index int64) []byte {
+	base := new(big.Int).SetBytes(
+		keccak.Keccak256(nil, common.PadLeftOrTrim(big.NewInt(validatorsSlot).Bytes(), 32)),
+	)
+
+	return base.Add(base, big.NewInt(index)).Bytes()
+}
+
+// PredeployGovernanceSC is a helper method for setting up the governance smart contract account,
+// using the passed in validators as the initial validator set
+func PredeployGovernanceSC(validators []types.Address) (*chain.GenesisAccount, error) {
+	if len(validators) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	scHex, _ := hex.DecodeHex(GovernanceSCBytecode)
+	storageMap := make(map[types.Hash]types.Hash)
+	bigTrueValue := big.NewInt(1)
+
+	for indx, validator := range validators {
+		isValidatorIndex := types.BytesToHash(getAddressMapping(validator, addressToIsValidatorSlot))
+
+		if _, ok := storageMap[isValidatorIndex]; ok {
+			return nil, fmt.Errorf("%w %s", ErrDuplicatedValidator, validator)
+		}
+
+		// Set the value for the validators array
+		storageMap[types.BytesToHash(getValidatorsIndex(int64(indx)))] = types.BytesToHash(validator.Bytes())
+
+		// Set the value for the address -> is validator mapping
+		storageMap[isValidatorIndex] = types.BytesToHash(bigTrueValue.Bytes())
+
+		// Set the value for the address -> validator index mapping
+		storageMap[types.BytesToHash(getAddressMapping(validator, addressToValidatorIndexSlot))] =
+			types.StringToHash(hex.EncodeUint64(uint64(indx)))
+	}
+
+	// Set the value for the size of the validators array
+	storageMap[types.BytesToHash(big.NewInt(validatorsSlot).Bytes())] =
+		types.StringToHash(hex.EncodeUint64(uint64(len(validators))))
+
+	return &chain.GenesisAccount{
+		Code:    scHex,
+		Storage: storageMap,
+		Balance: big.NewInt(0),
+	}, nil
+}
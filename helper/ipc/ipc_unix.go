@@ -10,6 +10,11 @@ import (
 	"time"
 )
 
+// DefaultPath returns the default IPC path, a socket in the data directory
+func DefaultPath(dataDir, name string) string {
+	return filepath.Join(dataDir, name)
+}
+
 // Dial dials an IPC path
 func Dial(path string) (net.Conn, error) {
 	return net.Dial("unix", path)
@@ -26,7 +31,8 @@ func Listen(path string) (net.Listener, error) {
 		return nil, err
 	}
 
-	if removeErr := os.Remove(path); removeErr != nil {
+	// remove the socket left behind by a previous run
+	if removeErr := os.Remove(path); removeErr != nil && !os.IsNotExist(removeErr) {
 		return nil, removeErr
 	}
 
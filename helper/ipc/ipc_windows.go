@@ -10,6 +10,12 @@ import (
 	"gopkg.in/natefinch/npipe.v2"
 )
 
+// DefaultPath returns the default IPC path, a named pipe
+// as sockets in the data directory are not available
+func DefaultPath(_, name string) string {
+	return `\\.\pipe\` + name
+}
+
 // Dial dials an IPC path
 func Dial(path string) (net.Conn, error) {
 	return npipe.Dial(path)
@@ -120,10 +120,16 @@ func (pw *ProgressionWrapper) UpdateHighestProgression(highestBlock uint64) {
 	pw.progression.HighestBlock = highestBlock
 }
 
-// GetProgression returns the latest sync progression
+// GetProgression returns a copy of the latest sync progression, nil if no sync is in progress
 func (pw *ProgressionWrapper) GetProgression() *Progression {
 	pw.lock.RLock()
 	defer pw.lock.RUnlock()
 
-	return pw.progression
+	if pw.progression == nil {
+		return nil
+	}
+
+	progression := *pw.progression
+
+	return &progression
 }
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// bucketIdleTimeout is how long a bucket is kept after its last request.
+// A bucket idle for more than a second is full again, so dropping it loses nothing
+const bucketIdleTimeout = time.Minute
+
+// tokenBucket keeps track of the requests of a single key
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// Limiter rate limits the requests of each key (client, peer...) using a token bucket
+type Limiter struct {
+	sync.Mutex
+
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+
+	// current time source, replaceable in tests
+	now func() time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets:   make(map[string]*tokenBucket),
+		lastPrune: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Allow checks if the key is within the limit of requests per second (0 means unlimited),
+// and consumes one token if it is. A burst of up to one second worth of requests is allowed
+func (l *Limiter) Allow(key string, limit uint64) bool {
+	if limit == 0 {
+		return true
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{
+			tokens:     float64(limit),
+			lastRefill: now,
+		}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.lastRefill).Seconds() * float64(limit)
+	b.lastRefill = now
+
+	if b.tokens > float64(limit) {
+		b.tokens = float64(limit)
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// Remove drops the bucket of the key
+func (l *Limiter) Remove(key string) {
+	l.Lock()
+	defer l.Unlock()
+
+	delete(l.buckets, key)
+}
+
+// prune drops the buckets of the keys that have been idle for a while.
+// Should be called with the lock held
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < bucketIdleTimeout {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastRefill) >= bucketIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastPrune = now
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := NewLimiter()
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	// the initial burst is allowed
+	assert.True(t, limiter.Allow("client", 2))
+	assert.True(t, limiter.Allow("client", 2))
+	assert.False(t, limiter.Allow("client", 2))
+
+	// other clients have their own limit
+	assert.True(t, limiter.Allow("other-client", 2))
+
+	// tokens are refilled over time
+	now = now.Add(500 * time.Millisecond)
+
+	assert.True(t, limiter.Allow("client", 2))
+	assert.False(t, limiter.Allow("client", 2))
+
+	// no limit is applied when the rate limit is 0
+	for i := 0; i < 1000; i++ {
+		assert.True(t, limiter.Allow("client", 0))
+	}
+
+	// a removed client starts again with a full bucket
+	limiter.Remove("client")
+
+	assert.True(t, limiter.Allow("client", 2))
+	assert.True(t, limiter.Allow("client", 2))
+}
+
+func TestLimiter_Prune(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter()
+	now := time.Now()
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	assert.True(t, limiter.Allow("idle-client", 1))
+
+	now = now.Add(bucketIdleTimeout / 2)
+
+	assert.True(t, limiter.Allow("client", 1))
+	assert.Len(t, limiter.buckets, 2)
+
+	// only the buckets idle for longer than the timeout are dropped
+	now = now.Add(bucketIdleTimeout / 2)
+
+	assert.True(t, limiter.Allow("client", 1))
+	assert.Len(t, limiter.buckets, 1)
+	assert.Contains(t, limiter.buckets, "client")
+}
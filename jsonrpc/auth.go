@@ -0,0 +1,162 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+const (
+	// JWTSecretLength is the length of the secret shared with the JWT issuers
+	JWTSecretLength = 32
+
+	// jwtIssuedAtTolerance is the maximum drift of the issued-at claim of
+	// a token from the local time, as in the Engine API authentication
+	jwtIssuedAtTolerance = 60 * time.Second
+)
+
+var (
+	errMissingAuth       = errors.New("missing bearer token")
+	errInvalidAuth       = errors.New("invalid bearer token")
+	errInvalidJWTSecret  = fmt.Errorf("JWT secret should be %d hex encoded bytes", JWTSecretLength)
+	errUnsupportedJWTAlg = errors.New("unsupported JWT algorithm")
+	errStaleJWT          = errors.New("stale JWT issued-at claim")
+)
+
+// AuthConfig are the credentials accepted by a listener.
+// Requests should carry either a JWT signed with the secret
+// or the static token in the "Authorization: Bearer" header
+type AuthConfig struct {
+	// JWTSecret is the HS256 secret the tokens are signed with
+	JWTSecret []byte
+	// BearerToken is a static token accepted as is
+	BearerToken string
+}
+
+// authenticator checks the credentials of the requests
+type authenticator struct {
+	config *AuthConfig
+
+	// current time source, replaceable in tests
+	now func() time.Time
+}
+
+func newAuthenticator(config *AuthConfig) *authenticator {
+	return &authenticator{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// authenticate checks the bearer token of the request
+func (a *authenticator) authenticate(req *http.Request) error {
+	header := req.Header.Get("Authorization")
+	if header == "" {
+		return errMissingAuth
+	}
+
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header {
+		return errMissingAuth
+	}
+
+	if a.config.BearerToken != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(a.config.BearerToken)) == 1 {
+		return nil
+	}
+
+	if len(a.config.JWTSecret) != 0 {
+		return a.verifyJWT(token)
+	}
+
+	return errInvalidAuth
+}
+
+// verifyJWT checks the JWT is signed with the secret using HS256 and was issued recently
+func (a *authenticator) verifyJWT(token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errInvalidAuth
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return err
+	}
+
+	if header.Alg != "HS256" {
+		return errUnsupportedJWTAlg
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errInvalidAuth
+	}
+
+	mac := hmac.New(sha256.New, a.config.JWTSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errInvalidAuth
+	}
+
+	var claims struct {
+		IssuedAt *int64 `json:"iat"`
+	}
+
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return err
+	}
+
+	if claims.IssuedAt == nil {
+		return errStaleJWT
+	}
+
+	drift := a.now().Sub(time.Unix(*claims.IssuedAt, 0))
+	if drift > jwtIssuedAtTolerance || drift < -jwtIssuedAtTolerance {
+		return errStaleJWT
+	}
+
+	return nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return errInvalidAuth
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return errInvalidAuth
+	}
+
+	return nil
+}
+
+// ReadJWTSecret reads the hex encoded JWT secret from the file
+func ReadJWTSecret(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := hex.DecodeHex(strings.TrimSpace(string(data)))
+	if err != nil || len(secret) != JWTSecretLength {
+		return nil, errInvalidJWTSecret
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,148 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testJWTSecret = []byte(strings.Repeat("s", JWTSecretLength))
+
+// signJWT creates a HS256 token with the header and claims
+func signJWT(secret []byte, header, claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	unsigned := encode([]byte(header)) + "." + encode([]byte(claims))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + encode(mac.Sum(nil))
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1650000000, 0)
+	hs256 := `{"alg":"HS256","typ":"JWT"}`
+	issuedAt := func(offset time.Duration) string {
+		return fmt.Sprintf(`{"iat":%d}`, now.Add(offset).Unix())
+	}
+
+	testTable := []struct {
+		name   string
+		header string
+		err    error
+	}{
+		{
+			"valid JWT",
+			"Bearer " + signJWT(testJWTSecret, hs256, issuedAt(0)),
+			nil,
+		},
+		{
+			"JWT issued within the tolerance",
+			"Bearer " + signJWT(testJWTSecret, hs256, issuedAt(-jwtIssuedAtTolerance)),
+			nil,
+		},
+		{
+			"static bearer token",
+			"Bearer static-token",
+			nil,
+		},
+		{
+			"missing header",
+			"",
+			errMissingAuth,
+		},
+		{
+			"not a bearer token",
+			"Basic dXNlcjpwYXNz",
+			errMissingAuth,
+		},
+		{
+			"JWT signed with another secret",
+			"Bearer " + signJWT([]byte("other"), hs256, issuedAt(0)),
+			errInvalidAuth,
+		},
+		{
+			"JWT with an unsupported algorithm",
+			"Bearer " + signJWT(testJWTSecret, `{"alg":"none"}`, issuedAt(0)),
+			errUnsupportedJWTAlg,
+		},
+		{
+			"JWT issued too long ago",
+			"Bearer " + signJWT(testJWTSecret, hs256, issuedAt(-2*jwtIssuedAtTolerance)),
+			errStaleJWT,
+		},
+		{
+			"JWT issued in the future",
+			"Bearer " + signJWT(testJWTSecret, hs256, issuedAt(2*jwtIssuedAtTolerance)),
+			errStaleJWT,
+		},
+		{
+			"JWT without issued-at claim",
+			"Bearer " + signJWT(testJWTSecret, hs256, `{}`),
+			errStaleJWT,
+		},
+		{
+			"malformed JWT",
+			"Bearer a.b",
+			errInvalidAuth,
+		},
+	}
+
+	auth := newAuthenticator(&AuthConfig{
+		JWTSecret:   testJWTSecret,
+		BearerToken: "static-token",
+	})
+	auth.now = func() time.Time {
+		return now
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest("POST", "/", nil)
+			if testCase.header != "" {
+				req.Header.Set("Authorization", testCase.header)
+			}
+
+			assert.ErrorIs(t, auth.authenticate(req), testCase.err)
+		})
+	}
+}
+
+func TestReadJWTSecret(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+		return path
+	}
+
+	secret, err := ReadJWTSecret(write("valid", "0x"+strings.Repeat("ab", JWTSecretLength)+"\n"))
+	assert.NoError(t, err)
+	assert.Len(t, secret, JWTSecretLength)
+
+	_, err = ReadJWTSecret(write("short", strings.Repeat("ab", JWTSecretLength-1)))
+	assert.ErrorIs(t, err, errInvalidJWTSecret)
+
+	_, err = ReadJWTSecret(write("invalid", strings.Repeat("zz", JWTSecretLength)))
+	assert.ErrorIs(t, err, errInvalidJWTSecret)
+}
@@ -94,13 +94,22 @@ func (e *ObjectError) Error() string {
 }
 
 const (
-	PendingBlockNumber  = BlockNumber(-3)
-	LatestBlockNumber   = BlockNumber(-2)
-	EarliestBlockNumber = BlockNumber(-1)
+	SafeBlockNumber      = BlockNumber(-5)
+	FinalizedBlockNumber = BlockNumber(-4)
+	PendingBlockNumber   = BlockNumber(-3)
+	LatestBlockNumber    = BlockNumber(-2)
+	EarliestBlockNumber  = BlockNumber(-1)
 )
 
 type BlockNumber int64
 
+// isLatest checks if the block number refers to the latest block.
+// The blocks are final once written, since IBFT seals them with the committed seals of a quorum,
+// so the finalized and safe blocks are the latest one
+func (b BlockNumber) isLatest() bool {
+	return b == LatestBlockNumber || b == FinalizedBlockNumber || b == SafeBlockNumber
+}
+
 type BlockNumberOrHash struct {
 	BlockNumber *BlockNumber `json:"blockNumber,omitempty"`
 	BlockHash   *types.Hash  `json:"blockHash,omitempty"`
@@ -109,7 +118,7 @@ type BlockNumberOrHash struct {
 // UnmarshalJSON will try to extract the filter's data.
 // Here are the possible input formats :
 //
-// 1 - "latest", "pending" or "earliest"	- self-explaining keywords
+// 1 - "latest", "pending", "earliest", "finalized", "safe"	- self-explaining keywords
 // 2 - "0x2"								- block number #2 (EIP-1898 backward compatible)
 // 3 - {blockNumber:	"0x2"}				- EIP-1898 compliant block number #2
 // 4 - {blockHash:		"0xe0e..."}			- EIP-1898 compliant block hash 0xe0e...
@@ -154,6 +163,10 @@ func stringToBlockNumber(str string) (BlockNumber, error) {
 		return LatestBlockNumber, nil
 	case "earliest":
 		return EarliestBlockNumber, nil
+	case "finalized":
+		return FinalizedBlockNumber, nil
+	case "safe":
+		return SafeBlockNumber, nil
 	}
 
 	n, err := types.ParseUint64orHex(&str)
@@ -206,6 +219,11 @@ func NewRPCResponse(id interface{}, jsonrpcver string, reply []byte, err Error)
 		response = &SuccessResponse{JSONRPC: jsonrpcver, ID: id, Result: reply}
 	default:
 		response = NewRPCErrorResponse(id, err.ErrorCode(), err.Error(), jsonrpcver)
+
+		if withData, ok := err.(DataError); ok {
+			//nolint:forcetypeassert
+			response.(*ErrorResponse).Error.Data = withData.ErrorData()
+		}
 	}
 
 	return response
@@ -0,0 +1,306 @@
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	conformanceSender   = types.StringToAddress("0x1000000000000000000000000000000000000001")
+	conformanceContract = types.StringToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// conformanceStore is a small chain with the genesis block and a block
+// with a legacy and a dynamic fee transaction, on top of a real state trie
+type conformanceStore struct {
+	JSONRPCStore
+
+	state    *itrie.State
+	blocks   []*types.Block
+	receipts map[types.Hash][]*types.Receipt
+}
+
+func newConformanceStore(t *testing.T) *conformanceStore {
+	t.Helper()
+
+	st := itrie.NewState(itrie.NewMemoryStorage())
+
+	_, root := st.NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  conformanceSender,
+			Balance:  big.NewInt(1000000000000000000),
+			Nonce:    2,
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+		{
+			Address:   conformanceContract,
+			Balance:   big.NewInt(0),
+			Nonce:     1,
+			CodeHash:  types.BytesToHash(keccak.Keccak256(nil, []byte{0x0})),
+			Root:      types.EmptyRootHash,
+			DirtyCode: true,
+			Code:      []byte{0x0},
+			Storage: []*state.StorageObject{
+				{Key: types.ZeroHash.Bytes(), Val: []byte{0x2a}},
+			},
+		},
+	})
+
+	genesis := &types.Header{
+		Number:     0,
+		GasLimit:   30000000,
+		Sha3Uncles: types.EmptyUncleHash,
+		StateRoot:  types.EmptyRootHash,
+		BaseFee:    1000,
+	}
+	genesis.ComputeHash()
+
+	legacyTx := &types.Transaction{
+		Nonce:    0,
+		GasPrice: big.NewInt(2000),
+		Gas:      21000,
+		To:       &conformanceContract,
+		Value:    big.NewInt(1),
+		Input:    []byte{},
+		V:        big.NewInt(27),
+		R:        big.NewInt(1),
+		S:        big.NewInt(2),
+		From:     conformanceSender,
+	}
+	legacyTx.ComputeHash()
+
+	dynamicTx := &types.Transaction{
+		Type:       types.DynamicFeeTx,
+		ChainID:    big.NewInt(100),
+		Nonce:      1,
+		GasPrice:   big.NewInt(3000),
+		GasTipCap:  big.NewInt(500),
+		Gas:        50000,
+		To:         &conformanceContract,
+		Value:      big.NewInt(0),
+		Input:      []byte{0x1, 0x2},
+		AccessList: types.TxAccessList{},
+		V:          big.NewInt(1),
+		R:          big.NewInt(3),
+		S:          big.NewInt(4),
+		From:       conformanceSender,
+	}
+	dynamicTx.ComputeHash()
+
+	header := &types.Header{
+		ParentHash: genesis.Hash,
+		Number:     1,
+		GasLimit:   30000000,
+		GasUsed:    45000,
+		Timestamp:  1000,
+		Sha3Uncles: types.EmptyUncleHash,
+		StateRoot:  types.BytesToHash(root),
+		BaseFee:    1000,
+	}
+	header.ComputeHash()
+
+	block := &types.Block{
+		Header:       header,
+		Transactions: []*types.Transaction{legacyTx, dynamicTx},
+	}
+
+	success := types.ReceiptSuccess
+
+	return &conformanceStore{
+		state: st,
+		blocks: []*types.Block{
+			{Header: genesis},
+			block,
+		},
+		receipts: map[types.Hash][]*types.Receipt{
+			header.Hash: {
+				{
+					CumulativeGasUsed: 21000,
+					Status:            &success,
+					GasUsed:           21000,
+					TxHash:            legacyTx.Hash,
+				},
+				{
+					CumulativeGasUsed: 45000,
+					Status:            &success,
+					TransactionType:   types.DynamicFeeTx,
+					GasUsed:           24000,
+					TxHash:            dynamicTx.Hash,
+					Logs: []*types.Log{
+						{Address: conformanceContract, Topics: []types.Hash{types.ZeroHash}, Data: []byte{0x1}},
+						{Address: conformanceContract, Topics: []types.Hash{}, Data: []byte{0x2}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *conformanceStore) Header() *types.Header {
+	return s.blocks[len(s.blocks)-1].Header
+}
+
+func (s *conformanceStore) SubscribeEvents() blockchain.Subscription {
+	return blockchain.NewMockSubscription()
+}
+
+func (s *conformanceStore) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	block, ok := s.GetBlockByNumber(number, false)
+	if !ok {
+		return nil, false
+	}
+
+	return block.Header, true
+}
+
+func (s *conformanceStore) GetBlockByNumber(number uint64, full bool) (*types.Block, bool) {
+	if number >= uint64(len(s.blocks)) {
+		return nil, false
+	}
+
+	return s.blocks[number], true
+}
+
+func (s *conformanceStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	for _, block := range s.blocks {
+		if block.Hash() == hash {
+			return block, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *conformanceStore) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
+	for _, block := range s.blocks {
+		for _, txn := range block.Transactions {
+			if txn.Hash == hash {
+				return block.Hash(), true
+			}
+		}
+	}
+
+	return types.ZeroHash, false
+}
+
+func (s *conformanceStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return s.receipts[hash], nil
+}
+
+func (s *conformanceStore) CalculateBaseFee(parent *types.Header) uint64 {
+	return parent.BaseFee
+}
+
+func (s *conformanceStore) GetAccount(root types.Hash, addr types.Address) (*state.Account, error) {
+	snap, err := s.state.NewSnapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := snap.Get(keccak.Keccak256(nil, addr.Bytes()))
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	var account state.Account
+	if err := account.UnmarshalRlp(data); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (s *conformanceStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	account, err := s.GetAccount(root, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := s.state.NewSnapshotAt(account.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := snap.Get(keccak.Keccak256(nil, slot.Bytes()))
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return data, nil
+}
+
+func (s *conformanceStore) GetProof(root types.Hash, key []byte) ([][]byte, error) {
+	return s.state.Prove(root, keccak.Keccak256(nil, key))
+}
+
+// readConformanceFixture reads a recorded exchange, the request
+// is in the line starting with ">>" and the response in the one starting with "<<"
+func readConformanceFixture(t *testing.T, path string) ([]byte, []byte) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var request, response []byte
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, ">> "):
+			request = []byte(strings.TrimPrefix(line, ">> "))
+		case strings.HasPrefix(line, "<< "):
+			response = []byte(strings.TrimPrefix(line, "<< "))
+		}
+	}
+
+	if request == nil || response == nil {
+		t.Fatalf("fixture %s requires a request and a response", path)
+	}
+
+	return request, response
+}
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "conformance", "*", "*.io"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, fixtures)
+
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newConformanceStore(t), &dispatcherParams{chainID: 100})
+
+	for _, fixture := range fixtures {
+		fixture := fixture
+		name := strings.TrimSuffix(strings.TrimPrefix(fixture, filepath.Join("testdata", "conformance")+"/"), ".io")
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request, expected := readConformanceFixture(t, fixture)
+
+			response, err := dispatcher.Handle(request)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(expected), string(response))
+		})
+	}
+}
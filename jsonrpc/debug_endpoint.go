@@ -0,0 +1,89 @@
+package jsonrpc
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// debugStore provides access to the methods needed by debug endpoint
+type debugStore interface {
+	// ReadTxLookup returns a block hash in which a given txn was mined
+	ReadTxLookup(txnHash types.Hash) (types.Hash, bool)
+
+	// GetBlockByHash gets a block using the provided hash
+	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
+
+	// TraceTxn re-executes the block up to the given transaction, which is traced
+	TraceTxn(block *types.Block, txHash types.Hash, tracer runtime.Tracer) error
+
+	// TraceCall executes the transaction on top of the state of the header and traces it
+	TraceCall(header *types.Header, txn *types.Transaction, tracer runtime.Tracer) error
+}
+
+// Debug is the debug jsonrpc endpoint
+type Debug struct {
+	store debugStore
+	// eth resolves the blocks and decodes the calls the same way eth_call does
+	eth *Eth
+}
+
+// TraceTransaction re-executes a mined transaction and returns its trace
+func (d *Debug) TraceTransaction(hash types.Hash, config *tracer.Config) (interface{}, error) {
+	blockHash, ok := d.store.ReadTxLookup(hash)
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	block, ok := d.store.GetBlockByHash(blockHash, true)
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blockHash)
+	}
+
+	t, err := tracer.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.store.TraceTxn(block, hash, t); err != nil {
+		return nil, err
+	}
+
+	return t.GetResult()
+}
+
+// TraceCall executes a call on top of the given block and returns its trace
+func (d *Debug) TraceCall(arg *txnArgs, filter BlockNumberOrHash, config *tracer.Config) (interface{}, error) {
+	// The filter is empty, use the latest block by default
+	if filter.BlockNumber == nil && filter.BlockHash == nil {
+		filter.BlockNumber, _ = createBlockNumberPointer("latest")
+	}
+
+	header, err := d.eth.getHeaderFromBlockNumberOrHash(&filter)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := d.eth.decodeTxn(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	t, err := tracer.New(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.store.TraceCall(callHeader(header, transaction), transaction, t); err != nil {
+		return nil, err
+	}
+
+	return t.GetResult()
+}
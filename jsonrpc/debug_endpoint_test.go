@@ -0,0 +1,173 @@
+package jsonrpc
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDebugStore struct {
+	ethStore
+
+	header *types.Header
+	block  *types.Block
+
+	// tracedCall is the last traced call
+	tracedCall *types.Transaction
+}
+
+func (m *mockDebugStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockDebugStore) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
+	for _, txn := range m.block.Transactions {
+		if txn.Hash == hash {
+			return m.block.Hash(), true
+		}
+	}
+
+	return types.ZeroHash, false
+}
+
+func (m *mockDebugStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	if hash != m.block.Hash() {
+		return nil, false
+	}
+
+	return m.block, true
+}
+
+// traceTopLevelCall sends the events of a call without nested calls to the tracer
+func traceTopLevelCall(txn *types.Transaction, tracer runtime.Tracer) {
+	tracer.CaptureStart(txn.From, *txn.To, false, txn.Input, txn.Gas, txn.Value)
+	tracer.CaptureState(&runtime.TraceStep{OpName: "STOP", Gas: txn.Gas, Depth: 1})
+	tracer.CaptureStateEnd(0, nil)
+	tracer.CaptureEnd(nil, 21000, nil)
+}
+
+func (m *mockDebugStore) TraceTxn(block *types.Block, hash types.Hash, tracer runtime.Tracer) error {
+	for _, txn := range block.Transactions {
+		if txn.Hash == hash {
+			traceTopLevelCall(txn, tracer)
+
+			return nil
+		}
+	}
+
+	return errors.New("not found")
+}
+
+func (m *mockDebugStore) TraceCall(header *types.Header, txn *types.Transaction, tracer runtime.Tracer) error {
+	m.tracedCall = txn
+
+	traceTopLevelCall(txn, tracer)
+
+	return nil
+}
+
+func newTestDebugEndpoint(store *mockDebugStore) *Debug {
+	return &Debug{store, &Eth{store: store, chainID: 100}}
+}
+
+func newMockDebugStore() *mockDebugStore {
+	to := types.StringToAddress("2")
+
+	txn := &types.Transaction{
+		From:     types.StringToAddress("1"),
+		To:       &to,
+		Gas:      50000,
+		GasPrice: big.NewInt(1),
+		Value:    big.NewInt(0),
+	}
+	txn.ComputeHash()
+
+	header := &types.Header{Number: 1, GasLimit: 100000}
+	header.ComputeHash()
+
+	return &mockDebugStore{
+		header: header,
+		block: &types.Block{
+			Header:       header,
+			Transactions: []*types.Transaction{txn},
+		},
+	}
+}
+
+func TestDebug_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	store := newMockDebugStore()
+	debug := newTestDebugEndpoint(store)
+
+	txn := store.block.Transactions[0]
+
+	t.Run("should return the struct logs of the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := debug.TraceTransaction(txn.Hash, nil)
+		assert.NoError(t, err)
+
+		result, ok := res.(*tracer.StructLoggerResult)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(21000), result.Gas)
+		assert.False(t, result.Failed)
+		assert.Len(t, result.StructLogs, 1)
+		assert.Equal(t, "STOP", result.StructLogs[0].Op)
+	})
+
+	t.Run("should return the calls of the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := debug.TraceTransaction(txn.Hash, &tracer.Config{Tracer: tracer.CallTracerName})
+		assert.NoError(t, err)
+
+		frame, ok := res.(*tracer.CallFrame)
+		assert.True(t, ok)
+		assert.Equal(t, "CALL", frame.Type)
+		assert.Equal(t, "0x5208", frame.GasUsed)
+	})
+
+	t.Run("should fail for an unknown transaction", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := debug.TraceTransaction(types.StringToHash("1"), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail for an unknown tracer", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := debug.TraceTransaction(txn.Hash, &tracer.Config{Tracer: "unknown"})
+		assert.ErrorIs(t, err, tracer.ErrUnknownTracer)
+	})
+}
+
+func TestDebug_TraceCall(t *testing.T) {
+	t.Parallel()
+
+	store := newMockDebugStore()
+	debug := newTestDebugEndpoint(store)
+
+	from := types.StringToAddress("1")
+	to := types.StringToAddress("2")
+
+	res, err := debug.TraceCall(&txnArgs{
+		From:  &from,
+		To:    &to,
+		Nonce: argUintPtr(0),
+	}, BlockNumberOrHash{}, &tracer.Config{Tracer: tracer.CallTracerName})
+	assert.NoError(t, err)
+
+	frame, ok := res.(*tracer.CallFrame)
+	assert.True(t, ok)
+	assert.Equal(t, "CALL", frame.Type)
+
+	// the call gets the gas limit of the block by default
+	assert.Equal(t, store.header.GasLimit, store.tracedCall.Gas)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -34,6 +35,8 @@ type endpoints struct {
 	Web3   *Web3
 	Net    *Net
 	TxPool *TxPool
+	Debug  *Debug
+	Ibft   *Ibft
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -43,17 +46,35 @@ type Dispatcher struct {
 	serviceMap    map[string]*serviceData
 	filterManager *FilterManager
 	endpoints     endpoints
-	chainID       uint64
+	params        *dispatcherParams
 }
 
-func newDispatcher(logger hclog.Logger, store JSONRPCStore, chainID uint64) *Dispatcher {
+// dispatcherParams are the chain parameters and the limits applied by the dispatcher
+type dispatcherParams struct {
+	chainID uint64
+
+	// maximum number of requests in a batch (0 means unlimited)
+	batchLengthLimit uint64
+	// methods that can be called, by namespace
+	methodFilters map[string]*MethodFilter
+	// limits of the log queries
+	logQueryLimits LogQueryLimits
+	// send queues of the subscription connections
+	subscriptionQueue SubscriptionQueueConfig
+	// maximum gas of the calls and the gas estimations (0 means unlimited)
+	gasCap uint64
+	// serve the debug namespace
+	debug bool
+}
+
+func newDispatcher(logger hclog.Logger, store JSONRPCStore, params *dispatcherParams) *Dispatcher {
 	d := &Dispatcher{
-		logger:  logger.Named("dispatcher"),
-		chainID: chainID,
+		logger: logger.Named("dispatcher"),
+		params: params,
 	}
 
 	if store != nil {
-		d.filterManager = NewFilterManager(logger, store)
+		d.filterManager = NewFilterManager(logger, store, params.logQueryLimits, params.subscriptionQueue)
 		go d.filterManager.Run()
 	}
 
@@ -63,15 +84,29 @@ func newDispatcher(logger hclog.Logger, store JSONRPCStore, chainID uint64) *Dis
 }
 
 func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
-	d.endpoints.Eth = &Eth{d.logger, store, d.chainID, d.filterManager}
-	d.endpoints.Net = &Net{store, d.chainID}
+	d.endpoints.Eth = &Eth{d.logger, store, d.params.chainID, d.filterManager, d.params.gasCap}
+	d.endpoints.Net = &Net{store, d.params.chainID}
 	d.endpoints.Web3 = &Web3{}
 	d.endpoints.TxPool = &TxPool{store}
+	d.endpoints.Debug = &Debug{store, d.endpoints.Eth}
+	d.endpoints.Ibft = &Ibft{store, d.endpoints.Eth}
 
 	d.registerService("eth", d.endpoints.Eth)
 	d.registerService("net", d.endpoints.Net)
 	d.registerService("web3", d.endpoints.Web3)
 	d.registerService("txpool", d.endpoints.TxPool)
+	d.registerService("ibft", d.endpoints.Ibft)
+
+	// the debug methods re-execute transactions, so they are only served once enabled
+	if d.params.debug {
+		d.registerService("debug", d.endpoints.Debug)
+	}
+
+	for namespace := range d.params.methodFilters {
+		if _, ok := d.serviceMap[namespace]; !ok {
+			d.logger.Warn("method filter for an unknown namespace", "namespace", namespace)
+		}
+	}
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -93,11 +128,31 @@ func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error)
 		return nil, nil, NewMethodNotFoundError(req.Method)
 	}
 
+	if !d.isMethodAllowed(req.Method) {
+		return nil, nil, NewMethodNotAllowedError(req.Method)
+	}
+
 	return service, fd, nil
 }
 
+// isMethodAllowed checks the method against the filter of its namespace
+func (d *Dispatcher) isMethodAllowed(method string) bool {
+	callName := strings.SplitN(method, "_", 2)
+	if len(callName) != 2 {
+		return true
+	}
+
+	filter, ok := d.params.methodFilters[callName[0]]
+	if !ok {
+		return true
+	}
+
+	return filter.isAllowed(callName[1])
+}
+
 type wsConn interface {
 	WriteMessage(messageType int, data []byte) error
+	Close() error
 }
 
 // as per https://www.jsonrpc.org/specification, the `id` in JSON-RPC 2.0
@@ -118,35 +173,54 @@ func formatFilterResponse(id interface{}, resp string) (string, Error) {
 		return "", NewInvalidRequestError("Invalid json request")
 	}
 }
-func (d *Dispatcher) handleSubscribe(req Request, conn wsConn) (string, Error) {
+
+// handleSubscribe creates the filter of the subscription. Logs subscriptions with a past
+// fromBlock first replay the logs from that block, which are only sent once the
+// subscription is released, so the client gets the subscription ID beforehand
+func (d *Dispatcher) handleSubscribe(req Request, conn wsConn) (string, bool, Error) {
 	var params []interface{}
 	if err := json.Unmarshal(req.Params, &params); err != nil {
-		return "", NewInvalidRequestError("Invalid json request")
+		return "", false, NewInvalidRequestError("Invalid json request")
 	}
 
 	if len(params) == 0 {
-		return "", NewInvalidParamsError("Invalid params")
+		return "", false, NewInvalidParamsError("Invalid params")
 	}
 
 	subscribeMethod, ok := params[0].(string)
 	if !ok {
-		return "", NewSubscriptionNotFoundError(subscribeMethod)
+		return "", false, NewSubscriptionNotFoundError(subscribeMethod)
 	}
 
-	var filterID string
+	var (
+		filterID string
+		replay   bool
+	)
+
 	if subscribeMethod == "newHeads" {
 		filterID = d.filterManager.NewBlockFilter(conn)
 	} else if subscribeMethod == "logs" {
 		logQuery, err := decodeLogQueryFromInterface(params[1])
 		if err != nil {
-			return "", NewInternalError(err.Error())
+			return "", false, NewInternalError(err.Error())
+		}
+
+		if logQuery.fromBlock.isLatest() {
+			filterID = d.filterManager.NewLogFilter(logQuery, conn)
+		} else {
+			if filterID, err = d.filterManager.NewReplayLogFilter(logQuery, conn); err != nil {
+				return "", false, NewInvalidParamsError(err.Error())
+			}
+
+			replay = true
 		}
-		filterID = d.filterManager.NewLogFilter(logQuery, conn)
+	} else if subscribeMethod == "syncing" {
+		filterID = d.filterManager.NewSyncingFilter(conn)
 	} else {
-		return "", NewSubscriptionNotFoundError(subscribeMethod)
+		return "", false, NewSubscriptionNotFoundError(subscribeMethod)
 	}
 
-	return filterID, nil
+	return filterID, replay, nil
 }
 
 func (d *Dispatcher) handleUnsubscribe(req Request) (bool, Error) {
@@ -167,16 +241,22 @@ func (d *Dispatcher) handleUnsubscribe(req Request) (bool, Error) {
 	return d.filterManager.Uninstall(filterID), nil
 }
 
+// HandleWs handles a request of a client with a persistent connection, which can subscribe to events.
+// The response is nil if it was already written to the connection
 func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 	var req Request
 	if err := json.Unmarshal(reqBody, &req); err != nil {
 		return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
+	if !d.isMethodAllowed(req.Method) {
+		return NewRPCResponse(req.ID, "2.0", nil, NewMethodNotAllowedError(req.Method)).Bytes()
+	}
+
 	// if the request method is eth_subscribe we need to create a
 	// new filter with ws connection
 	if req.Method == "eth_subscribe" {
-		filterID, err := d.handleSubscribe(req, conn)
+		filterID, replay, err := d.handleSubscribe(req, conn)
 		if err != nil {
 			return NewRPCResponse(req.ID, "2.0", nil, err).Bytes()
 		}
@@ -184,9 +264,25 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		resp, err := formatFilterResponse(req.ID, filterID)
 
 		if err != nil {
+			d.filterManager.Uninstall(filterID)
+
 			return NewRPCResponse(req.ID, "2.0", nil, err).Bytes()
 		}
 
+		if replay {
+			// the replayed logs have to follow the subscription ID,
+			// so the response is written before releasing them
+			if writeErr := conn.WriteMessage(websocket.TextMessage, []byte(resp)); writeErr != nil {
+				d.filterManager.Uninstall(filterID)
+
+				return nil, writeErr
+			}
+
+			d.filterManager.ReleaseReplayLogFilter(filterID)
+
+			return nil, nil
+		}
+
 		return []byte(resp), nil
 	}
 
@@ -245,6 +341,15 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 		return NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
+	if limit := d.params.batchLengthLimit; limit != 0 && uint64(len(requests)) > limit {
+		return NewRPCResponse(
+			nil,
+			"2.0",
+			nil,
+			NewLimitExceededError(fmt.Sprintf("batch of %d requests exceeds the limit of %d", len(requests), limit)),
+		).Bytes()
+	}
+
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
@@ -297,6 +402,12 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	if err := getError(output[1]); err != nil {
 		d.logInternalError(req.Method, err)
 
+		// the errors with data, like the reverts, keep their code and data
+		var withData DataError
+		if errors.As(err, &withData) {
+			return nil, &dataError{msg: err.Error(), code: withData.ErrorCode(), data: withData.ErrorData()}
+		}
+
 		return nil, NewInvalidRequestError(err.Error())
 	}
 
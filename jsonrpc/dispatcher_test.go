@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +65,7 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 		t.Parallel()
 
 		store := newMockStore()
-		dispatcher := newDispatcher(hclog.NewNullLogger(), store, 0)
+		dispatcher := newDispatcher(hclog.NewNullLogger(), store, &dispatcherParams{})
 
 		mockConnection := &mockWsConn{
 			msgCh: make(chan []byte, 1),
@@ -92,11 +95,47 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 			t.Fatal("\"newHeads\" event not received in 2 seconds")
 		}
 	})
+
+	t.Run("clients should be able to receive \"syncing\" events thru eth_subscribe", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockStore()
+		dispatcher := newDispatcher(hclog.NewNullLogger(), store, &dispatcherParams{})
+
+		mockConnection := &mockWsConn{
+			msgCh: make(chan []byte, 1),
+		}
+
+		req := []byte(`{
+		"method": "eth_subscribe",
+		"params": ["syncing"]
+	}`)
+
+		res, err := dispatcher.HandleWs(req, mockConnection)
+		assert.NoError(t, err)
+
+		var filterID string
+		assert.NoError(t, expectJSONResult(res, &filterID))
+		assert.NotEmpty(t, filterID)
+
+		store.setSyncProgression(&progress.Progression{
+			SyncType:     progress.ChainSyncBulk,
+			CurrentBlock: 1,
+			HighestBlock: 10,
+		})
+
+		select {
+		case msg := <-mockConnection.msgCh:
+			assert.Contains(t, string(msg), `"syncing":true`)
+		case <-time.After(3 * time.Second):
+			t.Fatal("\"syncing\" event not received in 3 seconds")
+		}
+	})
 }
 
 func TestDispatcher_WebsocketConnection_RequestFormats(t *testing.T) {
 	store := newMockStore()
-	dispatcher := newDispatcher(hclog.NewNullLogger(), store, 0)
+	dispatcher := newDispatcher(hclog.NewNullLogger(), store, &dispatcherParams{})
 
 	mockConnection := &mockWsConn{
 		msgCh: make(chan []byte, 1),
@@ -197,10 +236,17 @@ func (m *mockService) Filter(f LogQuery) (interface{}, error) {
 	return nil, nil
 }
 
+func (m *mockService) Revert(data argBytes) (interface{}, error) {
+	return nil, constructErrorFromRevert(&runtime.ExecutionResult{
+		ReturnValue: data,
+		Err:         runtime.ErrExecutionReverted,
+	})
+}
+
 func TestDispatcherFuncDecode(t *testing.T) {
 	srv := &mockService{msgCh: make(chan interface{}, 10)}
 
-	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 	dispatcher.registerService("mock", srv)
 
 	handleReq := func(typ string, msg string) interface{} {
@@ -265,8 +311,28 @@ func TestDispatcherFuncDecode(t *testing.T) {
 	}
 }
 
+func TestDispatcher_ErrorData(t *testing.T) {
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+	dispatcher.registerService("mock", &mockService{})
+
+	// Panic(0x11), the revert data of an arithmetic overflow
+	revertData := "0x4e487b71" + strings.Repeat("0", 62) + "11"
+
+	resp, err := dispatcher.Handle([]byte(`{"id":1,"jsonrpc":"2.0","method":"mock_revert","params":["` + revertData + `"]}`))
+	assert.NoError(t, err)
+
+	var res ErrorResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &res))
+	assert.Equal(t, &ObjectError{
+		Code:    3,
+		Message: "execution was reverted: panic: arithmetic underflow or overflow (0x11)",
+		Data:    revertData,
+	}, res.Error)
+}
+
 func TestDispatcherBatchRequest(t *testing.T) {
-	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), 0)
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
 
 	// test with leading whitespace ("  \t\n\n\r")
 	leftBytes := []byte{0x20, 0x20, 0x09, 0x0A, 0x0A, 0x0D}
@@ -288,3 +354,110 @@ func TestDispatcherBatchRequest(t *testing.T) {
 	assert.Equal(t, res[0].Error, jsonerr)
 	assert.Nil(t, res[3].Error)
 }
+
+func TestDispatcherBatchRequest_LengthLimit(t *testing.T) {
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		batchLengthLimit: 2,
+	})
+
+	batch := func(length int) []byte {
+		requests := make([]string, length)
+		for i := range requests {
+			requests[i] = `{"id":1,"jsonrpc":"2.0","method":"web3_clientVersion","params":[]}`
+		}
+
+		return []byte("[" + strings.Join(requests, ",") + "]")
+	}
+
+	resp, err := dispatcher.Handle(batch(2))
+	assert.NoError(t, err)
+
+	var res []SuccessResponse
+
+	assert.NoError(t, expectBatchJSONResult(resp, &res))
+	assert.Len(t, res, 2)
+
+	resp, err = dispatcher.Handle(batch(3))
+	assert.NoError(t, err)
+
+	var errorResp SuccessResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &errorResp))
+	assert.Equal(t, -32005, errorResp.Error.Code)
+}
+
+func TestDispatcher_MethodFilters(t *testing.T) {
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		methodFilters: map[string]*MethodFilter{
+			"web3": {
+				Allow: []string{"clientVersion"},
+			},
+			"net": {
+				Deny: []string{"*"},
+			},
+			"eth": {
+				Deny: []string{"subscribe"},
+			},
+		},
+	})
+
+	testTable := []struct {
+		name    string
+		method  string
+		allowed bool
+	}{
+		{"method in the allow list", "web3_clientVersion", true},
+		{"method not in the allow list", "web3_sha3", false},
+		{"namespace denied", "net_version", false},
+		{"method not denied", "eth_chainId", true},
+		{"namespace without filter", "txpool_status", true},
+	}
+
+	for _, testCase := range testTable {
+		req := []byte(`{"id":1,"jsonrpc":"2.0","method":"` + testCase.method + `","params":["0x00"]}`)
+
+		resp, err := dispatcher.Handle(req)
+		assert.NoError(t, err)
+
+		var res SuccessResponse
+
+		assert.NoError(t, json.Unmarshal(resp, &res))
+
+		if testCase.allowed {
+			assert.Nil(t, res.Error, testCase.name)
+		} else {
+			assert.Equal(t, NewMethodNotAllowedError(testCase.method).Error(), res.Error.Message, testCase.name)
+		}
+	}
+
+	// subscriptions are filtered as well
+	resp, err := dispatcher.HandleWs(
+		[]byte(`{"id":1,"jsonrpc":"2.0","method":"eth_subscribe","params":["newHeads"]}`),
+		&mockWsConn{},
+	)
+	assert.NoError(t, err)
+
+	var res SuccessResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &res))
+	assert.Equal(t, NewMethodNotAllowedError("eth_subscribe").Error(), res.Error.Message)
+}
+
+func TestDispatcher_DebugNamespace(t *testing.T) {
+	// the debug namespace isn't served unless enabled
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+	assert.NotContains(t, dispatcher.serviceMap, "debug")
+
+	resp, err := dispatcher.Handle([]byte(`{"id":1,"jsonrpc":"2.0","method":"debug_traceTransaction","params":[]}`))
+	assert.NoError(t, err)
+
+	var res SuccessResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &res))
+	assert.Equal(t, NewMethodNotFoundError("debug_traceTransaction").Error(), res.Error.Message)
+
+	dispatcher = newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		debug: true,
+	})
+	assert.Contains(t, dispatcher.serviceMap, "debug")
+}
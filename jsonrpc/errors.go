@@ -3,8 +3,9 @@ package jsonrpc
 import (
 	"errors"
 	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
-	"github.com/umbracle/ethgo/abi"
 )
 
 var (
@@ -15,6 +16,12 @@ type Error interface {
 	Error() string
 	ErrorCode() int
 }
+
+// DataError is an error sent along with additional data in the data field of the error response
+type DataError interface {
+	Error
+	ErrorData() interface{}
+}
 type invalidParamsError struct {
 	err string
 }
@@ -75,9 +82,29 @@ func (e *methodNotFoundError) ErrorCode() int {
 	return -32601
 }
 
+type limitExceededError struct {
+	err string
+}
+
+func (e *limitExceededError) Error() string {
+	return e.err
+}
+
+func (e *limitExceededError) ErrorCode() int {
+	return -32005
+}
+
 func NewMethodNotFoundError(method string) *methodNotFoundError {
 	return &methodNotFoundError{fmt.Sprintf("the method %s does not exist/is not available", method)}
 }
+
+func NewMethodNotAllowedError(method string) *methodNotFoundError {
+	return &methodNotFoundError{fmt.Sprintf("the method %s is not allowed", method)}
+}
+
+func NewLimitExceededError(msg string) *limitExceededError {
+	return &limitExceededError{msg}
+}
 func NewInvalidRequestError(msg string) *invalidRequestError {
 	return &invalidRequestError{msg}
 }
@@ -93,11 +120,55 @@ func NewSubscriptionNotFoundError(method string) *subscriptionNotFoundError {
 	return &subscriptionNotFoundError{fmt.Sprintf("subscribe method %s not found", method)}
 }
 
+// revertError is the error of a reverted execution, the revert data is sent in the data field
+// so the clients can decode the custom errors as well
+type revertError struct {
+	err  error
+	data []byte
+}
+
+func (e *revertError) Error() string {
+	return e.err.Error()
+}
+
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+func (e *revertError) ErrorData() interface{} {
+	return hex.EncodeToHex(e.data)
+}
+
+func (e *revertError) Unwrap() error {
+	return e.err
+}
+
+// dataError is a wrapped error carrying data, sent with the message of the wrapping error
+type dataError struct {
+	msg  string
+	code int
+	data interface{}
+}
+
+func (e *dataError) Error() string {
+	return e.msg
+}
+
+func (e *dataError) ErrorCode() int {
+	return e.code
+}
+
+func (e *dataError) ErrorData() interface{} {
+	return e.data
+}
+
+// constructErrorFromRevert returns the error of a reverted execution,
+// along with the decoded reason of the Error(string) and Panic(uint256) revert data
 func constructErrorFromRevert(result *runtime.ExecutionResult) error {
-	revertErrMsg, unpackErr := abi.UnpackRevertError(result.ReturnValue)
-	if unpackErr != nil {
-		return result.Err
+	err := result.Err
+	if reason, ok := runtime.DecodeRevert(result.ReturnValue); ok {
+		err = fmt.Errorf("%w: %s", result.Err, reason)
 	}
 
-	return fmt.Errorf("%w: %s", result.Err, revertErrMsg)
+	return &revertError{err: err, data: result.ReturnValue}
 }
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -29,6 +30,8 @@ func TestEth_Block_GetBlockByNumber(t *testing.T) {
 	}{
 		{"should be able to get the latest block number", LatestBlockNumber, true, false},
 		{"should be able to get the earliest block number", EarliestBlockNumber, true, false},
+		{"should be able to get the finalized block number", FinalizedBlockNumber, true, false},
+		{"should be able to get the safe block number", SafeBlockNumber, true, false},
 		{"should not be able to get block with negative number", BlockNumber(-50), false, true},
 		{"should be able to get block with number 0", BlockNumber(0), true, false},
 		{"should be able to get block with number 2", BlockNumber(2), true, false},
@@ -96,7 +99,102 @@ func TestEth_Block_GetBlockTransactionCountByNumber(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, res, "expected to return block, but got nil")
-	assert.Equal(t, res, 10)
+	assert.Equal(t, argUintPtr(10), res)
+}
+
+func TestEth_Block_GetBlockTransactionCountByHash(t *testing.T) {
+	store := &mockBlockStore{}
+	block := newTestBlock(1, hash1)
+
+	for i := 0; i < 10; i++ {
+		block.Transactions = append(block.Transactions, []*types.Transaction{{Nonce: 0, From: addr0}}...)
+	}
+	store.add(block)
+
+	eth := newTestEthEndpoint(store)
+
+	res, err := eth.GetBlockTransactionCountByHash(hash1)
+	assert.NoError(t, err)
+	assert.Equal(t, argUintPtr(10), res)
+
+	res, err = eth.GetBlockTransactionCountByHash(hash2)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestEth_GetTransactionByBlockNumberAndIndex(t *testing.T) {
+	store := &mockBlockStore{}
+	block := newTestBlock(1, hash1)
+
+	for i := 0; i < 3; i++ {
+		block.Transactions = append(block.Transactions, newTestTransaction(uint64(i), addr0))
+	}
+	store.add(newTestBlock(0, hash2), block)
+
+	eth := newTestEthEndpoint(store)
+
+	cases := []struct {
+		description string
+		index       argUint64
+		found       bool
+	}{
+		{"should return the first transaction", 0, true},
+		{"should return the last transaction", 2, true},
+		{"should return nil for an index out of range", 3, false},
+	}
+	for _, c := range cases {
+		res, err := eth.GetTransactionByBlockNumberAndIndex(BlockNumber(1), c.index)
+		assert.NoError(t, err)
+
+		if !c.found {
+			assert.Nil(t, res, c.description)
+
+			continue
+		}
+
+		// nolint:forcetypeassert
+		txn := res.(*transaction)
+		assert.Equal(t, block.Transactions[c.index].Hash, txn.Hash, c.description)
+		assert.Equal(t, c.index, *txn.TxIndex, c.description)
+	}
+}
+
+func TestEth_GetBlockReceipts(t *testing.T) {
+	store := newMockBlockStore()
+	block := newTestBlock(1, hash1)
+
+	status := types.ReceiptSuccess
+
+	for i := 0; i < 2; i++ {
+		txn := newTestTransaction(uint64(i), addr0)
+		block.Transactions = append(block.Transactions, txn)
+
+		store.receipts[hash1] = append(store.receipts[hash1], &types.Receipt{
+			Status: &status,
+			TxHash: txn.Hash,
+			Logs:   []*types.Log{{}, {}},
+		})
+	}
+	store.add(block)
+
+	eth := newTestEthEndpoint(store)
+
+	res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash1})
+	assert.NoError(t, err)
+
+	// nolint:forcetypeassert
+	receipts := res.([]*receipt)
+	assert.Len(t, receipts, 2)
+
+	// the logs are indexed by their position in the block
+	for i, receipt := range receipts {
+		assert.Equal(t, argUint64(i), receipt.TxIndex)
+
+		for j, log := range receipt.Logs {
+			assert.Equal(t, argUint64(i), log.TxIndex)
+			assert.Equal(t, argUint64(2*i+j), log.LogIndex)
+		}
+	}
 }
 
 func TestEth_GetTransactionByHash(t *testing.T) {
@@ -261,6 +359,91 @@ func TestEth_GasPrice(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("0x%x", store.averageGasPrice), response)
 }
 
+func newFeeHistoryStore() *mockBlockStore {
+	store := newMockBlockStore()
+
+	dynamicTx := &types.Transaction{
+		Type:      types.DynamicFeeTx,
+		GasPrice:  big.NewInt(20),
+		GasTipCap: big.NewInt(5),
+		Hash:      types.StringToHash("1"),
+	}
+	legacyTx := &types.Transaction{
+		GasPrice: big.NewInt(12),
+		Hash:     types.StringToHash("2"),
+	}
+	cheapTx := &types.Transaction{
+		GasPrice: big.NewInt(9),
+		Hash:     types.StringToHash("3"),
+	}
+
+	blocks := []*types.Block{
+		{
+			Header: &types.Header{Number: 0, Hash: hash1, GasLimit: 100},
+		},
+		{
+			Header:       &types.Header{Number: 1, Hash: hash2, GasLimit: 100, GasUsed: 60, BaseFee: 10},
+			Transactions: []*types.Transaction{dynamicTx, legacyTx},
+		},
+		{
+			Header:       &types.Header{Number: 2, Hash: hash3, GasLimit: 100, GasUsed: 50, BaseFee: 8},
+			Transactions: []*types.Transaction{cheapTx},
+		},
+	}
+
+	store.add(blocks...)
+	store.receipts[hash2] = []*types.Receipt{{GasUsed: 20}, {GasUsed: 40}}
+	store.receipts[hash3] = []*types.Receipt{{GasUsed: 50}}
+
+	return store
+}
+
+func TestEth_FeeHistory(t *testing.T) {
+	t.Parallel()
+
+	eth := newTestEthEndpoint(newFeeHistoryStore())
+
+	res, err := eth.FeeHistory(10, LatestBlockNumber, []float64{0, 50, 100})
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(res)
+	assert.NoError(t, err)
+
+	// the tips are weighted by the gas used by each transaction,
+	// and the base fee of the next block is included
+	assert.JSONEq(t, `{
+		"oldestBlock": "0x0",
+		"baseFeePerGas": ["0x0", "0xa", "0x8", "0x9"],
+		"gasUsedRatio": [0, 0.6, 0.5],
+		"reward": [["0x0", "0x0", "0x0"], ["0x2", "0x2", "0x5"], ["0x1", "0x1", "0x1"]]
+	}`, string(data))
+
+	// the range ends with the newest block
+	res, err = eth.FeeHistory(1, BlockNumber(1), nil)
+	assert.NoError(t, err)
+
+	data, err = json.Marshal(res)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"oldestBlock": "0x1",
+		"baseFeePerGas": ["0xa", "0xb"],
+		"gasUsedRatio": [0.6]
+	}`, string(data))
+
+	_, err = eth.FeeHistory(1, LatestBlockNumber, []float64{50, 10})
+	assert.ErrorIs(t, err, ErrInvalidRewardPercentile)
+}
+
+func TestEth_MaxPriorityFeePerGas(t *testing.T) {
+	t.Parallel()
+
+	eth := newTestEthEndpoint(newFeeHistoryStore())
+
+	res, err := eth.MaxPriorityFeePerGas()
+	assert.NoError(t, err)
+	assert.Equal(t, argBigPtr(big.NewInt(2)), res)
+}
+
 func TestEth_Call(t *testing.T) {
 	t.Parallel()
 
@@ -281,7 +464,7 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{})
+		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil, nil)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), store.ethCallError.Error())
@@ -305,7 +488,7 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{})
+		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
@@ -321,6 +504,9 @@ type mockBlockStore struct {
 	isSyncing       bool
 	averageGasPrice int64
 	ethCallError    error
+
+	// logIndexTail is the first block with the logs served by MatchLogBlocks
+	logIndexTail uint64
 }
 
 func newMockBlockStore() *mockBlockStore {
@@ -456,6 +642,43 @@ func (m *mockBlockStore) GetBlockByHash(hash types.Hash, full bool) (*types.Bloc
 	return nil, false
 }
 
+func (m *mockBlockStore) LogIndexTail() uint64 {
+	return m.logIndexTail
+}
+
+func (m *mockBlockStore) MatchLogBlocks(
+	from, to uint64,
+	addresses []types.Address,
+	topics [][]types.Hash,
+) ([]uint64, error) {
+	query := &LogQuery{Addresses: addresses, Topics: topics}
+	matches := []uint64{}
+
+	for _, b := range m.blocks {
+		if b.Number() < from || b.Number() > to || b.Number() < m.logIndexTail {
+			continue
+		}
+
+		if m.hasMatchingLog(b.Hash(), query) {
+			matches = append(matches, b.Number())
+		}
+	}
+
+	return matches, nil
+}
+
+func (m *mockBlockStore) hasMatchingLog(hash types.Hash, query *LogQuery) bool {
+	for _, receipt := range m.receipts[hash] {
+		for _, log := range receipt.Logs {
+			if query.Match(log) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (m *mockBlockStore) Header() *types.Header {
 	return m.blocks[len(m.blocks)-1].Header
 }
@@ -499,10 +722,18 @@ func (m *mockBlockStore) GetAvgGasPrice() *big.Int {
 	return big.NewInt(m.averageGasPrice)
 }
 
-func (m *mockBlockStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+func (m *mockBlockStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	override *types.CallOverride,
+) (*runtime.ExecutionResult, error) {
 	return &runtime.ExecutionResult{Err: m.ethCallError}, nil
 }
 
+func (m *mockBlockStore) CalculateBaseFee(parent *types.Header) uint64 {
+	return parent.BaseFee + 1
+}
+
 func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
@@ -31,6 +32,8 @@ type ethStateStore interface {
 	GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error)
 	GetForksInTime(blockNumber uint64) chain.ForksInTime
 	GetCode(hash types.Hash) ([]byte, error)
+	// GetProof returns the merkle proof of the key in the trie with the given root
+	GetProof(root types.Hash, key []byte) ([][]byte, error)
 }
 
 type ethBlockchainStore interface {
@@ -55,11 +58,14 @@ type ethBlockchainStore interface {
 	// GetAvgGasPrice returns the average gas price
 	GetAvgGasPrice() *big.Int
 
-	// ApplyTxn applies a transaction object to the blockchain
-	ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	// ApplyTxn applies a transaction object to the blockchain, with the hypothetical state and block context if any
+	ApplyTxn(header *types.Header, txn *types.Transaction, override *types.CallOverride) (*runtime.ExecutionResult, error)
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
+
+	// CalculateBaseFee returns the base fee of the block following the given parent
+	CalculateBaseFee(parent *types.Header) uint64
 }
 
 // ethStore provides access to the methods needed by eth endpoint
@@ -75,11 +81,22 @@ type Eth struct {
 	store         ethStore
 	chainID       uint64
 	filterManager *FilterManager
+	gasCap        uint64
 }
 
 var (
-	ErrInsufficientFunds = errors.New("insufficient funds for execution")
-	ErrGasCapOverflow    = errors.New("unable to apply transaction for the highest gas limit")
+	ErrInsufficientFunds       = errors.New("insufficient funds for execution")
+	ErrGasCapOverflow          = errors.New("unable to apply transaction for the highest gas limit")
+	ErrInvalidRewardPercentile = errors.New("reward percentiles must be increasing values between 0 and 100")
+)
+
+const (
+	// maxFeeHistoryBlocks is the maximum number of blocks returned by eth_feeHistory
+	maxFeeHistoryBlocks = 1024
+
+	// tipSuggestionBlocks is the number of recent blocks looked at
+	// for suggesting the max priority fee per gas
+	tipSuggestionBlocks = 20
 )
 
 // ChainId returns the chain id of the client
@@ -114,12 +131,7 @@ func (e *Eth) getHeaderFromBlockNumberOrHash(bnh *BlockNumberOrHash) (*types.Hea
 func (e *Eth) Syncing() (interface{}, error) {
 	if syncProgression := e.store.GetSyncProgression(); syncProgression != nil {
 		// Node is bulk syncing, return the status
-		return progression{
-			Type:          string(syncProgression.SyncType),
-			StartingBlock: hex.EncodeUint64(syncProgression.StartingBlock),
-			CurrentBlock:  hex.EncodeUint64(syncProgression.CurrentBlock),
-			HighestBlock:  hex.EncodeUint64(syncProgression.HighestBlock),
-		}, nil
+		return newProgression(syncProgression), nil
 	}
 
 	// Node is not bulk syncing
@@ -127,14 +139,14 @@ func (e *Eth) Syncing() (interface{}, error) {
 }
 
 func GetNumericBlockNumber(number BlockNumber, e *Eth) (uint64, error) {
-	switch number {
-	case LatestBlockNumber:
+	switch {
+	case number.isLatest():
 		return e.store.Header().Number, nil
 
-	case EarliestBlockNumber:
+	case number == EarliestBlockNumber:
 		return 0, nil
 
-	case PendingBlockNumber:
+	case number == PendingBlockNumber:
 		return 0, fmt.Errorf("fetching the pending header is not supported")
 
 	default:
@@ -184,7 +196,82 @@ func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{},
 		return nil, nil
 	}
 
-	return len(block.Transactions), nil
+	return argUintPtr(uint64(len(block.Transactions))), nil
+}
+
+// GetBlockTransactionCountByHash returns the number of transactions in the block with the given hash
+func (e *Eth) GetBlockTransactionCountByHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUintPtr(uint64(len(block.Transactions))), nil
+}
+
+// GetTransactionByBlockNumberAndIndex returns the transaction at the given index of the block
+func (e *Eth) GetTransactionByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
+	num, err := GetNumericBlockNumber(number, e)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return transactionAtIndex(block, index), nil
+}
+
+// GetTransactionByBlockHashAndIndex returns the transaction at the given index of the block
+func (e *Eth) GetTransactionByBlockHashAndIndex(hash types.Hash, index argUint64) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return transactionAtIndex(block, index), nil
+}
+
+func transactionAtIndex(block *types.Block, index argUint64) *transaction {
+	if uint64(index) >= uint64(len(block.Transactions)) {
+		return nil
+	}
+
+	idx := int(index)
+
+	return toTransaction(
+		block.Transactions[idx],
+		argUintPtr(block.Number()),
+		argHashPtr(block.Hash()),
+		&idx,
+	)
+}
+
+// GetUncleCountByBlockNumber returns the number of uncles of the block, always 0 with instant finality
+func (e *Eth) GetUncleCountByBlockNumber(number BlockNumber) (interface{}, error) {
+	num, err := GetNumericBlockNumber(number, e)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByNumber(num, false)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUintPtr(uint64(len(block.Uncles))), nil
+}
+
+// GetUncleCountByBlockHash returns the number of uncles of the block, always 0 with instant finality
+func (e *Eth) GetUncleCountByBlockHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, false)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUintPtr(uint64(len(block.Uncles))), nil
 }
 
 // BlockNumber returns current block number
@@ -204,6 +291,16 @@ func (e *Eth) SendRawTransaction(input string) (interface{}, error) {
 		return nil, fmt.Errorf("unable to decode input, %w", decodeErr)
 	}
 
+	tx, err := e.sendRawTransaction(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// sendRawTransaction decodes the RLP encoded transaction and adds it to the pool
+func (e *Eth) sendRawTransaction(buf []byte) (*types.Transaction, error) {
 	tx := &types.Transaction{}
 	if err := tx.UnmarshalRLP(buf); err != nil {
 		return nil, err
@@ -215,7 +312,7 @@ func (e *Eth) SendRawTransaction(input string) (interface{}, error) {
 		return nil, err
 	}
 
-	return tx.Hash.String(), nil
+	return tx, nil
 }
 
 // Reject eth_sendTransaction json-rpc call as we don't support wallet management
@@ -342,38 +439,119 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		return nil, nil
 	}
 
-	txn := block.Transactions[indx]
-	raw := receipts[indx]
-
-	logs := make([]*Log, len(raw.Logs))
-	for indx, elem := range raw.Logs {
-		logs[indx] = &Log{
-			Address:     elem.Address,
-			Topics:      elem.Topics,
-			Data:        argBytes(elem.Data),
-			BlockHash:   block.Hash(),
-			BlockNumber: argUint64(block.Number()),
-			TxHash:      txn.Hash,
-			TxIndex:     argUint64(indx),
-			LogIndex:    argUint64(indx),
-			Removed:     false,
-		}
-	}
-
-	res := &receipt{
-		Root:              raw.Root,
-		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
-		LogsBloom:         raw.LogsBloom,
-		Status:            argUint64(*raw.Status),
-		TxHash:            txn.Hash,
-		TxIndex:           argUint64(indx),
-		BlockHash:         block.Hash(),
-		BlockNumber:       argUint64(block.Number()),
-		GasUsed:           argUint64(raw.GasUsed),
-		ContractAddress:   raw.ContractAddress,
-		FromAddr:          txn.From,
-		ToAddr:            txn.To,
-		Logs:              logs,
+	// the logs are indexed by their position in the block
+	logIndex := 0
+	for _, raw := range receipts[:indx] {
+		logIndex += len(raw.Logs)
+	}
+
+	return toReceipt(receipts[indx], block.Transactions[indx], uint64(indx), block, uint64(logIndex)), nil
+}
+
+// GetBlockReceipts returns the receipts of all the transactions in the block
+func (e *Eth) GetBlockReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	// The filter is empty, use the latest block by default
+	if filter.BlockNumber == nil && filter.BlockHash == nil {
+		filter.BlockNumber, _ = createBlockNumberPointer("latest")
+	}
+
+	header, err := e.getHeaderFromBlockNumberOrHash(&filter)
+	if err != nil {
+		// unknown blocks have no receipts
+		return nil, nil
+	}
+
+	block, ok := e.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		// Receipts not written yet on the db
+		return nil, nil
+	}
+
+	res := make([]*receipt, len(receipts))
+	logIndex := uint64(0)
+
+	for i, raw := range receipts {
+		res[i] = toReceipt(raw, block.Transactions[i], uint64(i), block, logIndex)
+		logIndex += uint64(len(raw.Logs))
+	}
+
+	return res, nil
+}
+
+// Accounts returns the accounts owned by the client, which doesn't manage any wallet
+func (e *Eth) Accounts() (interface{}, error) {
+	return []types.Address{}, nil
+}
+
+// GetProof returns the merkle proofs of the account and of the given storage slots (EIP-1186)
+func (e *Eth) GetProof(
+	address types.Address,
+	storageKeys []types.Hash,
+	filter BlockNumberOrHash,
+) (interface{}, error) {
+	// The filter is empty, use the latest block by default
+	if filter.BlockNumber == nil && filter.BlockHash == nil {
+		filter.BlockNumber, _ = createBlockNumberPointer("latest")
+	}
+
+	header, err := e.getHeaderFromBlockNumberOrHash(&filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get header from block hash or block number")
+	}
+
+	proof, err := e.store.GetProof(header.StateRoot, address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// accounts not in the state are proven empty
+	acc := &state.Account{
+		Balance:  big.NewInt(0),
+		Root:     types.EmptyRootHash,
+		CodeHash: types.EmptyCodeHash.Bytes(),
+	}
+
+	if found, err := e.store.GetAccount(header.StateRoot, address); err == nil {
+		acc = found
+	} else if !errors.Is(err, ErrStateNotFound) {
+		return nil, err
+	}
+
+	res := &accountProof{
+		Address:      address,
+		AccountProof: toProof(proof),
+		Balance:      argBig(*acc.Balance),
+		CodeHash:     types.BytesToHash(acc.CodeHash),
+		Nonce:        argUint64(acc.Nonce),
+		StorageHash:  acc.Root,
+		StorageProof: make([]*storageProof, len(storageKeys)),
+	}
+
+	for i, key := range storageKeys {
+		proof, err := e.store.GetProof(acc.Root, key.Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := e.getStorage(header.StateRoot, address, key)
+		if err != nil {
+			return nil, err
+		}
+
+		res.StorageProof[i] = &storageProof{
+			Key:   key,
+			Value: argBig(*new(big.Int).SetBytes(value)),
+			Proof: toProof(proof),
+		}
 	}
 
 	return res, nil
@@ -400,11 +578,21 @@ func (e *Eth) GetStorageAt(
 		return nil, fmt.Errorf("failed to get header from block hash or block number")
 	}
 
+	data, err := e.getStorage(header.StateRoot, address, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return argBytesPtr(data), nil
+}
+
+// getStorage returns the value of the storage slot in the given state, zero if it is not set
+func (e *Eth) getStorage(root types.Hash, address types.Address, index types.Hash) ([]byte, error) {
 	// Get the storage for the passed in location
-	result, err := e.store.GetStorage(header.StateRoot, address, index)
+	result, err := e.store.GetStorage(root, address, index)
 	if err != nil {
 		if errors.As(err, &ErrStateNotFound) {
-			return argBytesPtr(types.ZeroHash[:]), nil
+			return types.ZeroHash[:], nil
 		}
 
 		return nil, err
@@ -414,16 +602,16 @@ func (e *Eth) GetStorageAt(
 	v, err := p.Parse(result)
 
 	if err != nil {
-		return argBytesPtr(types.ZeroHash[:]), nil
+		return types.ZeroHash[:], nil
 	}
 
 	data, err := v.Bytes()
 
 	if err != nil {
-		return argBytesPtr(types.ZeroHash[:]), nil
+		return types.ZeroHash[:], nil
 	}
 
-	return argBytesPtr(data), nil
+	return data, nil
 }
 
 // GasPrice returns the average gas price based on the last x blocks
@@ -434,8 +622,180 @@ func (e *Eth) GasPrice() (interface{}, error) {
 	return avgGasPrice, nil
 }
 
-// Call executes a smart contract call using the transaction object data
-func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+// MaxPriorityFeePerGas returns a suggestion for the tip of dynamic fee transactions,
+// the median of the tips paid in the last blocks
+func (e *Eth) MaxPriorityFeePerGas() (interface{}, error) {
+	return argBigPtr(e.suggestTip()), nil
+}
+
+// suggestTip returns the median of the tips paid in the last blocks
+func (e *Eth) suggestTip() *big.Int {
+	header := e.store.Header()
+
+	var tips []*big.Int
+
+	for i := uint64(0); i < tipSuggestionBlocks && i <= header.Number; i++ {
+		block, ok := e.store.GetBlockByNumber(header.Number-i, true)
+		if !ok {
+			break
+		}
+
+		for _, txn := range block.Transactions {
+			tips = append(tips, effectiveTip(txn, block.Header.BaseFee))
+		}
+	}
+
+	if len(tips) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].Cmp(tips[j]) < 0
+	})
+
+	return tips[len(tips)/2]
+}
+
+// FeeHistory returns the base fees, the gas used ratios and the requested percentiles
+// of the tips paid in a range of blocks ending with the newest block
+func (e *Eth) FeeHistory(
+	blockCount argUint64,
+	newestBlock BlockNumber,
+	rewardPercentiles []float64,
+) (interface{}, error) {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 || (i > 0 && p < rewardPercentiles[i-1]) {
+			return nil, ErrInvalidRewardPercentile
+		}
+	}
+
+	newest, err := GetNumericBlockNumber(newestBlock, e)
+	if err != nil {
+		return nil, err
+	}
+
+	count := uint64(blockCount)
+	if count > maxFeeHistoryBlocks {
+		count = maxFeeHistoryBlocks
+	}
+
+	if count > newest+1 {
+		count = newest + 1
+	}
+
+	res := &feeHistory{
+		OldestBlock:  argUint64(newest + 1 - count),
+		BaseFee:      []argUint64{},
+		GasUsedRatio: []float64{},
+	}
+
+	if count == 0 {
+		return res, nil
+	}
+
+	var last *types.Header
+
+	for num := newest + 1 - count; num <= newest; num++ {
+		block, ok := e.store.GetBlockByNumber(num, true)
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", num)
+		}
+
+		res.BaseFee = append(res.BaseFee, argUint64(block.Header.BaseFee))
+
+		ratio := float64(0)
+		if block.Header.GasLimit != 0 {
+			ratio = float64(block.Header.GasUsed) / float64(block.Header.GasLimit)
+		}
+
+		res.GasUsedRatio = append(res.GasUsedRatio, ratio)
+
+		if len(rewardPercentiles) != 0 {
+			reward, err := e.blockRewards(block, rewardPercentiles)
+			if err != nil {
+				return nil, err
+			}
+
+			res.Reward = append(res.Reward, reward)
+		}
+
+		last = block.Header
+	}
+
+	// the history includes the base fee of the block following the newest one
+	res.BaseFee = append(res.BaseFee, argUint64(e.store.CalculateBaseFee(last)))
+
+	return res, nil
+}
+
+// blockRewards returns the tips paid in the block at the given percentiles,
+// weighted by the gas used by each transaction
+func (e *Eth) blockRewards(block *types.Block, percentiles []float64) ([]argBig, error) {
+	reward := make([]argBig, len(percentiles))
+	if len(block.Transactions) == 0 {
+		return reward, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		return nil, fmt.Errorf("receipts for block %d not found", block.Number())
+	}
+
+	type txTip struct {
+		tip     *big.Int
+		gasUsed uint64
+	}
+
+	tips := make([]txTip, len(block.Transactions))
+	for i, txn := range block.Transactions {
+		tips[i] = txTip{
+			tip:     effectiveTip(txn, block.Header.BaseFee),
+			gasUsed: receipts[i].GasUsed,
+		}
+	}
+
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].tip.Cmp(tips[j].tip) < 0
+	})
+
+	idx := 0
+	sumGasUsed := tips[0].gasUsed
+
+	for i, p := range percentiles {
+		threshold := uint64(float64(block.Header.GasUsed) * p / 100)
+		for sumGasUsed < threshold && idx < len(tips)-1 {
+			idx++
+			sumGasUsed += tips[idx].gasUsed
+		}
+
+		reward[i] = argBig(*tips[idx].tip)
+	}
+
+	return reward, nil
+}
+
+// effectiveTip returns the tip paid by an included transaction, which is never negative
+func effectiveTip(txn *types.Transaction, baseFee uint64) *big.Int {
+	tip := txn.EffectiveTip(baseFee)
+	if tip.Sign() < 0 {
+		return tip.SetUint64(0)
+	}
+
+	return tip
+}
+
+// Call executes a smart contract call using the transaction object data,
+// on top of the overridden accounts and block context if any
+func (e *Eth) Call(
+	arg *txnArgs,
+	filter BlockNumberOrHash,
+	stateOverride *stateOverride,
+	blockOverride *blockOverride,
+) (interface{}, error) {
 	var (
 		header *types.Header
 		err    error
@@ -456,13 +816,22 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 	if err != nil {
 		return nil, err
 	}
+
+	override := toCallOverride(stateOverride, blockOverride)
+	header = overrideHeader(header, override)
+
 	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
 	if transaction.Gas == 0 {
 		transaction.Gas = header.GasLimit
 	}
 
+	// The gas of the call can't exceed the gas cap of the RPC (if any)
+	if e.gasCap != 0 && transaction.Gas > e.gasCap {
+		transaction.Gas = e.gasCap
+	}
+
 	// The return value of the execution is saved in the transition (returnValue field)
-	result, err := e.store.ApplyTxn(header, transaction)
+	result, err := e.store.ApplyTxn(callHeader(header, transaction), transaction, override)
 	if err != nil {
 		return nil, err
 	}
@@ -480,7 +849,12 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 }
 
 // EstimateGas estimates the gas needed to execute a transaction
-func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
+func (e *Eth) EstimateGas(
+	arg *txnArgs,
+	rawNum *BlockNumber,
+	stateOverride *stateOverride,
+	blockOverride *blockOverride,
+) (interface{}, error) {
 	transaction, err := e.decodeTxn(arg)
 	if err != nil {
 		return nil, err
@@ -491,13 +865,30 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		number = *rawNum
 	}
 
+	gas, err := e.estimateGas(transaction, number, toCallOverride(stateOverride, blockOverride))
+	if err != nil {
+		return 0, err
+	}
+
+	return hex.EncodeUint64(gas), nil
+}
+
+// estimateGas returns the lowest gas limit the transaction can be executed with, at the given block
+// and on top of the overrides if any
+func (e *Eth) estimateGas(
+	transaction *types.Transaction,
+	number BlockNumber,
+	override *types.CallOverride,
+) (uint64, error) {
 	// Fetch the requested header
 	header, err := e.getBlockHeader(number)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	forksInTime := e.store.GetForksInTime(uint64(number))
+	header = overrideHeader(header, override)
+	forksInTime := e.store.GetForksInTime(header.Number)
+	header = callHeader(header, transaction)
 
 	var standardGas uint64
 	if transaction.IsContractCreation() && forksInTime.Homestead {
@@ -535,13 +926,17 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 
 		if err != nil && !errors.As(err, &ErrStateNotFound) {
 			// An unrelated error occurred, return it
-			return nil, err
+			return 0, err
 		} else if err == nil {
 			// No error when fetching the account,
 			// read the balance from state
 			accountBalance = acc.Balance
 		}
 
+		if balance := overrideBalance(override, transaction.From); balance != nil {
+			accountBalance = balance
+		}
+
 		availableBalance = new(big.Int).Set(accountBalance)
 
 		if transaction.Value != nil {
@@ -553,6 +948,11 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		}
 	}
 
+	// Cap the ceiling to the gas cap of the RPC (if any)
+	if e.gasCap != 0 && highEnd > e.gasCap {
+		highEnd = e.gasCap
+	}
+
 	// Recalculate the gas ceiling based on the available funds (if any)
 	// and the passed in gas price (if present)
 	if gasPriceInt.BitLen() != 0 && // Gas price has been set
@@ -590,13 +990,13 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	}
 
 	// Run the transaction with the specified gas value.
-	// Returns a status indicating if the transaction failed and the accompanying error
-	testTransaction := func(gas uint64, shouldOmitErr bool) (bool, error) {
+	// Returns a status indicating if the transaction failed, the gas used and the accompanying error
+	testTransaction := func(gas uint64, shouldOmitErr bool) (bool, uint64, error) {
 		// Create a dummy transaction with the new gas
 		txn := transaction.Copy()
 		txn.Gas = gas
 
-		result, applyErr := e.store.ApplyTxn(header, txn)
+		result, applyErr := e.store.ApplyTxn(header, txn, override)
 
 		if applyErr != nil {
 			// Check the application error.
@@ -605,10 +1005,10 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 				// Specifying the transaction failed, but not providing an error
 				// is an indication that a valid error occurred due to low gas,
 				// which will increase the lower bound for the search
-				return true, nil
+				return true, 0, nil
 			}
 
-			return true, applyErr
+			return true, 0, applyErr
 		}
 
 		// Check if an out of gas error happened during EVM execution
@@ -617,30 +1017,67 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 				// Specifying the transaction failed, but not providing an error
 				// is an indication that a valid error occurred due to low gas,
 				// which will increase the lower bound for the search
-				return true, nil
+				return true, 0, nil
 			}
 
 			if isEVMRevertError(result.Err) {
 				// The EVM reverted during execution, attempt to extract the
 				// error message and return it
-				return true, constructErrorFromRevert(result)
+				return true, 0, constructErrorFromRevert(result)
 			}
 
-			return true, result.Err
+			return true, 0, result.Err
 		}
 
-		return false, nil
+		return false, result.GasUsed, nil
+	}
+
+	// Run the transaction at the ceiling first, if it fails there
+	// it fails for any gas limit and there is nothing to search
+	failed, gasUsed, err := testTransaction(highEnd, false)
+	if failed {
+		if isEVMRevertError(err) {
+			// The revert is returned as is, so the revert data reaches the caller
+			return 0, err
+		}
+
+		return 0, fmt.Errorf(
+			"unable to apply transaction even for the highest gas limit %d: %w",
+			highEnd,
+			err,
+		)
+	}
+
+	// The execution consumes at least the gas used (the refunds are only deducted after it),
+	// so no lower gas limit can make the transaction pass
+	if gasUsed > lowEnd {
+		lowEnd = gasUsed
 	}
 
-	// Start the binary search for the lowest possible gas price
+	// Most of the transactions pass with the gas used and the gas retained
+	// by the calls (1/64 of the available gas), try it before searching
+	if optimistic := lowEnd * 64 / 63; optimistic < highEnd {
+		failed, _, testErr := testTransaction(optimistic, true)
+		if testErr != nil && !isEVMRevertError(testErr) {
+			return 0, testErr
+		}
+
+		if failed {
+			lowEnd = optimistic + 1
+		} else {
+			highEnd = optimistic
+		}
+	}
+
+	// Start the binary search for the lowest possible gas limit
 	for lowEnd < highEnd {
 		mid := (lowEnd + highEnd) / 2
 
-		failed, testErr := testTransaction(mid, true)
+		failed, _, testErr := testTransaction(mid, true)
 		if testErr != nil &&
 			!isEVMRevertError(testErr) {
-			// Reverts are ignored in the binary search, but are checked later on
-			// during the execution for the optimal gas limit found
+			// Reverts are ignored in the binary search, the transaction
+			// passed at the ceiling so they are caused by the low gas
 			return 0, testErr
 		}
 
@@ -653,18 +1090,7 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		}
 	}
 
-	// Check if the highEnd is a good value to make the transaction pass
-	failed, err := testTransaction(highEnd, false)
-	if failed {
-		// The transaction shouldn't fail, for whatever reason, at highEnd
-		return 0, fmt.Errorf(
-			"unable to apply transaction even for the highest gas limit %d: %w",
-			highEnd,
-			err,
-		)
-	}
-
-	return hex.EncodeUint64(highEnd), nil
+	return highEnd, nil
 }
 
 // GetFilterLogs returns an array of logs for the specified filter
@@ -682,6 +1108,25 @@ func (e *Eth) GetLogs(query *LogQuery) (interface{}, error) {
 	return e.filterManager.GetLogsForQuery(query)
 }
 
+// GetLogsPage returns a page of the logs matching the filter options.
+// The cursor of the response continues the query after the page
+func (e *Eth) GetLogsPage(query *LogQuery, cursor *string, limit *argUint64) (interface{}, error) {
+	var (
+		cursorStr string
+		pageSize  uint64
+	)
+
+	if cursor != nil {
+		cursorStr = *cursor
+	}
+
+	if limit != nil {
+		pageSize = uint64(*limit)
+	}
+
+	return e.filterManager.GetLogsPage(query, cursorStr, pageSize)
+}
+
 // GetBalance returns the account's balance at the referenced block.
 func (e *Eth) GetBalance(address types.Address, filter BlockNumberOrHash) (interface{}, error) {
 	var (
@@ -814,11 +1259,11 @@ func (e *Eth) Unsubscribe(id string) (bool, error) {
 }
 
 func (e *Eth) getBlockHeader(number BlockNumber) (*types.Header, error) {
-	switch number {
-	case LatestBlockNumber:
+	switch {
+	case number.isLatest():
 		return e.store.Header(), nil
 
-	case EarliestBlockNumber:
+	case number == EarliestBlockNumber:
 		header, ok := e.store.GetHeaderByNumber(uint64(0))
 		if !ok {
 			return nil, fmt.Errorf("error fetching genesis block header")
@@ -826,7 +1271,7 @@ func (e *Eth) getBlockHeader(number BlockNumber) (*types.Header, error) {
 
 		return header, nil
 
-	case PendingBlockNumber:
+	case number == PendingBlockNumber:
 		return nil, fmt.Errorf("fetching the pending header is not supported")
 
 	default:
@@ -870,6 +1315,42 @@ func (e *Eth) getNextNonce(address types.Address, number BlockNumber) (uint64, e
 	return acc.Nonce, nil
 }
 
+// overrideHeader returns the header with the overridden block context of the call
+func overrideHeader(header *types.Header, override *types.CallOverride) *types.Header {
+	if override == nil {
+		return header
+	}
+
+	return override.Block.Apply(header)
+}
+
+// overrideBalance returns the overridden balance of the account, nil if it isn't overridden
+func overrideBalance(override *types.CallOverride, addr types.Address) *big.Int {
+	if override == nil {
+		return nil
+	}
+
+	if account, ok := override.State[addr]; ok && account.Balance != nil {
+		return account.Balance
+	}
+
+	return nil
+}
+
+// callHeader returns the header used for executing a call.
+// Calls which don't pay for gas are executed with a zero base fee,
+// so they aren't rejected for not covering it
+func callHeader(header *types.Header, txn *types.Transaction) *types.Header {
+	if header.BaseFee == 0 || txn.GasPrice.BitLen() != 0 {
+		return header
+	}
+
+	header = header.Copy()
+	header.BaseFee = 0
+
+	return header
+}
+
 func (e *Eth) decodeTxn(arg *txnArgs) (*types.Transaction, error) {
 	// set default values
 	if arg.From == nil {
@@ -925,6 +1406,43 @@ func (e *Eth) decodeTxn(arg *txnArgs) (*types.Transaction, error) {
 		txn.To = arg.To
 	}
 
+	// the transaction type is inferred from the fields, unless explicitly set
+	txType := types.LegacyTx
+
+	if arg.Type != nil {
+		txType = types.TxType(*arg.Type)
+	} else if arg.MaxFeePerGas != nil || arg.MaxPriorityFeePerGas != nil {
+		txType = types.DynamicFeeTx
+	} else if arg.AccessList != nil {
+		txType = types.AccessListTx
+	}
+
+	switch txType {
+	case types.LegacyTx:
+	case types.AccessListTx, types.DynamicFeeTx:
+		txn.Type = txType
+		txn.ChainID = new(big.Int).SetUint64(e.chainID)
+
+		if arg.AccessList != nil {
+			txn.AccessList = *arg.AccessList
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d", types.ErrTxTypeNotSupported, uint64(*arg.Type))
+	}
+
+	if txn.IsDynamicFee() {
+		// the fee cap is carried in the gas price field
+		txn.GasTipCap = new(big.Int)
+
+		if arg.MaxPriorityFeePerGas != nil {
+			txn.GasTipCap.SetBytes(*arg.MaxPriorityFeePerGas)
+		}
+
+		if arg.MaxFeePerGas != nil {
+			txn.GasPrice.SetBytes(*arg.MaxFeePerGas)
+		}
+	}
+
 	txn.ComputeHash()
 
 	return txn, nil
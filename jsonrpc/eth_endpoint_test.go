@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -142,6 +143,72 @@ func TestEth_DecodeTxn(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "should create an access list transaction",
+			arg: &txnArgs{
+				From:     &addr1,
+				To:       &addr2,
+				Gas:      toArgUint64Ptr(21000),
+				GasPrice: toArgBytesPtr(big.NewInt(10000).Bytes()),
+				Value:    toArgBytesPtr(oneEther.Bytes()),
+				Nonce:    toArgUint64Ptr(0),
+				AccessList: &types.TxAccessList{
+					{Address: addr2, StorageKeys: []types.Hash{{0x1}}},
+				},
+			},
+			res: &types.Transaction{
+				Type:     types.AccessListTx,
+				ChainID:  big.NewInt(100),
+				From:     addr1,
+				To:       &addr2,
+				Gas:      21000,
+				GasPrice: big.NewInt(10000),
+				Value:    oneEther,
+				Input:    []byte{},
+				Nonce:    0,
+				AccessList: types.TxAccessList{
+					{Address: addr2, StorageKeys: []types.Hash{{0x1}}},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should create a dynamic fee transaction",
+			arg: &txnArgs{
+				From:                 &addr1,
+				To:                   &addr2,
+				Gas:                  toArgUint64Ptr(21000),
+				MaxFeePerGas:         toArgBytesPtr(big.NewInt(10000).Bytes()),
+				MaxPriorityFeePerGas: toArgBytesPtr(big.NewInt(100).Bytes()),
+				Value:                toArgBytesPtr(oneEther.Bytes()),
+				Nonce:                toArgUint64Ptr(0),
+			},
+			res: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				ChainID:   big.NewInt(100),
+				From:      addr1,
+				To:        &addr2,
+				Gas:       21000,
+				GasPrice:  big.NewInt(10000),
+				GasTipCap: big.NewInt(100),
+				Value:     oneEther,
+				Input:     []byte{},
+				Nonce:     0,
+			},
+			err: nil,
+		},
+		{
+			name: "should fail for unsupported transaction types",
+			arg: &txnArgs{
+				From:     &addr1,
+				To:       &addr2,
+				GasPrice: toArgBytesPtr(big.NewInt(10000).Bytes()),
+				Nonce:    toArgUint64Ptr(0),
+				Type:     toArgUint64Ptr(0x7f),
+			},
+			res: nil,
+			err: fmt.Errorf("%w: %d", types.ErrTxTypeNotSupported, 0x7f),
+		},
 	}
 
 	for _, tt := range tests {
@@ -233,5 +300,5 @@ func TestEth_GetNextNonce(t *testing.T) {
 }
 
 func newTestEthEndpoint(store ethStore) *Eth {
-	return &Eth{hclog.NewNullLogger(), store, 100, nil}
+	return &Eth{hclog.NewNullLogger(), store, 100, nil, 0}
 }
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
@@ -683,7 +686,7 @@ func TestEth_EstimateGas_GasLimit(t *testing.T) {
 			}
 
 			// Run the estimation
-			estimate, estimateErr := ethEndpoint.EstimateGas(testCase.transaction, nil)
+			estimate, estimateErr := ethEndpoint.EstimateGas(testCase.transaction, nil, nil, nil)
 
 			if testCase.expectedError != nil {
 				if estimateErr == nil {
@@ -734,6 +737,8 @@ func TestEth_EstimateGas_Reverts(t *testing.T) {
 	estimate, estimateErr := ethEndpoint.EstimateGas(
 		constructMockTx(nil, nil),
 		nil,
+		nil,
+		nil,
 	)
 
 	assert.Equal(t, 0, estimate)
@@ -760,6 +765,8 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 	estimate, estimateErr := ethEndpoint.EstimateGas(
 		mockTx,
 		nil,
+		nil,
+		nil,
 	)
 
 	assert.Equal(t, 0, estimate)
@@ -768,12 +775,293 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 	assert.ErrorIs(t, estimateErr, ErrInsufficientFunds)
 }
 
+func TestEth_EstimateGas_GasUsed(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	// The transaction uses 50000 gas, but requires 50500 to pass,
+	// like the calls retaining 1/64 of the available gas
+	var runs []uint64
+
+	store.applyTxnHook = func(
+		header *types.Header,
+		txn *types.Transaction,
+	) (*runtime.ExecutionResult, error) {
+		runs = append(runs, txn.Gas)
+
+		if txn.Gas < 50500 {
+			return &runtime.ExecutionResult{Err: runtime.ErrOutOfGas}, nil
+		}
+
+		return &runtime.ExecutionResult{GasUsed: 50000}, nil
+	}
+
+	estimate, estimateErr := ethEndpoint.EstimateGas(constructMockTx(nil, nil), nil, nil, nil)
+	assert.NoError(t, estimateErr)
+	assert.Equal(t, hex.EncodeUint64(50500), estimate)
+
+	// The first run is at the ceiling, then the search is narrowed
+	// between the gas used and the optimistic guess
+	assert.Equal(t, uint64(500000), runs[0])
+	assert.Equal(t, uint64(50793), runs[1])
+	assert.Len(t, runs, 12)
+}
+
+func TestEth_EstimateGas_GasCap(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+	ethEndpoint.gasCap = 100000
+
+	store.applyTxnHook = func(
+		header *types.Header,
+		txn *types.Transaction,
+	) (*runtime.ExecutionResult, error) {
+		if txn.Gas < 200000 {
+			return &runtime.ExecutionResult{Err: runtime.ErrOutOfGas}, nil
+		}
+
+		return &runtime.ExecutionResult{}, nil
+	}
+
+	// The transaction can't pass under the cap
+	estimate, estimateErr := ethEndpoint.EstimateGas(constructMockTx(nil, nil), nil, nil, nil)
+	assert.Equal(t, 0, estimate)
+	assert.ErrorIs(t, estimateErr, runtime.ErrOutOfGas)
+	assert.Contains(t, estimateErr.Error(), "highest gas limit 100000")
+
+	// The calls are capped as well
+	var callGas uint64
+
+	store.applyTxnHook = func(
+		header *types.Header,
+		txn *types.Transaction,
+	) (*runtime.ExecutionResult, error) {
+		callGas = txn.Gas
+
+		return &runtime.ExecutionResult{}, nil
+	}
+
+	_, err := ethEndpoint.Call(constructMockTx(argUintPtr(300000), nil), BlockNumberOrHash{}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100000), callGas)
+}
+
+func TestEth_EstimateGas_RevertData(t *testing.T) {
+	// Panic(0x01), the revert data of a failed assertion
+	revertData := append([]byte{0x4e, 0x48, 0x7b, 0x71}, make([]byte, 32)...)
+	revertData[len(revertData)-1] = 0x01
+
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	store.applyTxnHook = func(
+		header *types.Header,
+		txn *types.Transaction,
+	) (*runtime.ExecutionResult, error) {
+		return &runtime.ExecutionResult{
+			ReturnValue: revertData,
+			Err:         runtime.ErrExecutionReverted,
+		}, nil
+	}
+
+	_, estimateErr := ethEndpoint.EstimateGas(constructMockTx(nil, nil), nil, nil, nil)
+
+	var dataErr DataError
+
+	assert.ErrorAs(t, estimateErr, &dataErr)
+	assert.Equal(t, 3, dataErr.ErrorCode())
+	assert.Equal(t, hex.EncodeToHex(revertData), dataErr.ErrorData())
+	assert.Equal(t, "execution was reverted: panic: assertion failed (0x01)", estimateErr.Error())
+}
+
+func TestEth_State_GetProof(t *testing.T) {
+	t.Parallel()
+
+	store := newConformanceStore(t)
+	eth := newTestEthEndpoint(store)
+	stateRoot := store.Header().StateRoot
+
+	slots := []types.Hash{types.ZeroHash, types.StringToHash("1")}
+
+	res, err := eth.GetProof(conformanceContract, slots, BlockNumberOrHash{})
+	assert.NoError(t, err)
+
+	proof, ok := res.(*accountProof)
+	assert.True(t, ok)
+
+	// the account proof leads to the account in the state
+	value, err := itrie.VerifyProof(
+		stateRoot,
+		keccak.Keccak256(nil, conformanceContract.Bytes()),
+		toProofNodes(proof.AccountProof),
+	)
+	assert.NoError(t, err)
+
+	var account state.Account
+	assert.NoError(t, account.UnmarshalRlp(value))
+	assert.Equal(t, account.Root, proof.StorageHash)
+	assert.Equal(t, argUint64(account.Nonce), proof.Nonce)
+
+	// the storage proofs lead to the slots in the storage of the account
+	expected := []uint64{0x2a, 0}
+
+	for i, storageProof := range proof.StorageProof {
+		assert.Equal(t, slots[i], storageProof.Key)
+		assert.Equal(t, expected[i], (*big.Int)(&storageProof.Value).Uint64())
+
+		_, err := itrie.VerifyProof(
+			proof.StorageHash,
+			keccak.Keccak256(nil, slots[i].Bytes()),
+			toProofNodes(storageProof.Proof),
+		)
+		assert.NoError(t, err)
+	}
+}
+
+func toProofNodes(proof []argBytes) [][]byte {
+	nodes := make([][]byte, len(proof))
+	for i, node := range proof {
+		nodes[i] = node
+	}
+
+	return nodes
+}
+
+func TestEth_EstimateGas_Overrides(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	// Account doesn't have any balance
+	store.account.account.Balance = big.NewInt(0)
+
+	var header *types.Header
+
+	store.applyTxnHook = func(h *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+		header = h
+
+		if txn.Gas < state.TxGas {
+			return &runtime.ExecutionResult{}, state.ErrNotEnoughIntrinsicGas
+		}
+
+		return &runtime.ExecutionResult{}, nil
+	}
+
+	// The transaction has a value > 0
+	mockTx := constructMockTx(nil, nil)
+	mockTx.Value = argBytesPtr([]byte{0x1})
+
+	_, estimateErr := ethEndpoint.EstimateGas(mockTx, nil, nil, nil)
+	assert.ErrorIs(t, estimateErr, ErrInsufficientFunds)
+
+	// The overridden balance covers the value
+	stateOverride := stateOverride{
+		addr0: {Balance: (*argBig)(big.NewInt(10))},
+	}
+	blockOverride := &blockOverride{
+		GasLimit: argUintPtr(30000),
+		Coinbase: &addr1,
+	}
+
+	mockTx = constructMockTx(nil, nil)
+	mockTx.Value = argBytesPtr([]byte{0x1})
+
+	estimate, estimateErr := ethEndpoint.EstimateGas(mockTx, nil, &stateOverride, blockOverride)
+	assert.NoError(t, estimateErr)
+	assert.Equal(t, hex.EncodeUint64(state.TxGas), estimate)
+
+	// The overrides are passed along with the overridden header
+	assert.Equal(t, big.NewInt(10), store.override.State[addr0].Balance)
+	assert.Equal(t, uint64(30000), header.GasLimit)
+	assert.Equal(t, addr1, header.Miner)
+}
+
+func TestEth_Call_Overrides(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	var (
+		header *types.Header
+		gas    uint64
+	)
+
+	store.applyTxnHook = func(h *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+		header = h
+		gas = txn.Gas
+
+		return &runtime.ExecutionResult{ReturnValue: []byte{0x1}}, nil
+	}
+
+	// The overrides are decoded as accepted by geth
+	var (
+		stateOverride stateOverride
+		blockOverride blockOverride
+	)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"0x0000000000000000000000000000000000000001": {
+			"nonce": "0x5",
+			"code": "0x6000",
+			"balance": "0x3e8",
+			"stateDiff": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001":
+				"0x0000000000000000000000000000000000000000000000000000000000000002"
+			}
+		},
+		"0x0000000000000000000000000000000000000002": {
+			"code": "0x",
+			"state": {}
+		}
+	}`), &stateOverride))
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"number": "0x10",
+		"time": "0x64",
+		"gasLimit": "0x7530",
+		"baseFee": "0x0"
+	}`), &blockOverride))
+
+	res, err := ethEndpoint.Call(constructMockTx(nil, nil), BlockNumberOrHash{}, &stateOverride, &blockOverride)
+	assert.NoError(t, err)
+	assert.Equal(t, argBytesPtr([]byte{0x1}), res)
+
+	nonce := uint64(5)
+	assert.Equal(t, types.StateOverride{
+		types.StringToAddress("1"): {
+			Nonce:   &nonce,
+			Code:    []byte{0x60, 0x00},
+			Balance: big.NewInt(1000),
+			StateDiff: map[types.Hash]types.Hash{
+				types.StringToHash("1"): types.StringToHash("2"),
+			},
+		},
+		types.StringToAddress("2"): {
+			Code:  []byte{},
+			State: map[types.Hash]types.Hash{},
+		},
+	}, store.override.State)
+
+	// The gas limit defaults to the overridden block gas limit
+	assert.Equal(t, uint64(0x10), header.Number)
+	assert.Equal(t, uint64(100), header.Timestamp)
+	assert.Equal(t, uint64(30000), header.GasLimit)
+	assert.Equal(t, uint64(30000), gas)
+
+	// The stored header is left untouched
+	assert.Equal(t, uint64(500000), store.block.Header.GasLimit)
+
+	// No overrides are passed along without overrides
+	_, err = ethEndpoint.Call(constructMockTx(nil, nil), BlockNumberOrHash{}, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, store.override)
+}
+
 type mockSpecialStore struct {
 	ethStore
 	account *mockAccount
 	block   *types.Block
 
 	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	// override is the override of the last applied transaction
+	override *types.CallOverride
 }
 
 func (m *mockSpecialStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
@@ -835,7 +1123,13 @@ func (m *mockSpecialStore) GetForksInTime(blockNumber uint64) chain.ForksInTime
 	return chain.ForksInTime{}
 }
 
-func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+func (m *mockSpecialStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	override *types.CallOverride,
+) (*runtime.ExecutionResult, error) {
+	m.override = override
+
 	if m.applyTxnHook != nil {
 		return m.applyTxnHook(header, txn)
 	}
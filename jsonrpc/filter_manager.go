@@ -2,14 +2,18 @@ package jsonrpc
 
 import (
 	"container/heap"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
@@ -23,16 +27,85 @@ var (
 	ErrBlockNotFound                    = errors.New("block not found")
 	ErrIncorrectBlockRange              = errors.New("incorrect range")
 	ErrPendingBlockNumber               = errors.New("pending block number is not supported")
+	ErrBlockRangeTooHigh                = errors.New("block range too high")
+	ErrTooManyLogs                      = errors.New("query returned too many logs")
+	ErrInvalidLogCursor                 = errors.New("invalid log cursor")
+	ErrBlockHashLogPage                 = errors.New("block hash queries are not paginated")
 )
 
 // defaultTimeout is the timeout to remove the filters that don't have a web socket stream
 var defaultTimeout = 1 * time.Minute
 
+// syncingPollInterval is the interval between the checks of the sync progression
+var syncingPollInterval = 1 * time.Second
+
 const (
 	// The index in heap which is indicating the element is not in the heap
 	NoIndexInHeap = -1
+
+	// DefaultBlockRangeLimit is the default maximum number of blocks a log query can span.
+	// It is unlimited, so the clients querying wide ranges keep working after an upgrade
+	DefaultBlockRangeLimit = uint64(0)
+
+	// RecommendedBlockRangeLimit is the maximum number of blocks of a log query recommended for public nodes
+	RecommendedBlockRangeLimit = uint64(1000)
+
+	// DefaultLogResultLimit is the default maximum number of logs a log query can return (unlimited)
+	DefaultLogResultLimit = uint64(0)
+
+	// RecommendedLogResultLimit is the maximum number of logs of a log query recommended for public nodes
+	RecommendedLogResultLimit = uint64(10000)
+
+	// defaultLogPageSize is the number of logs of a page, if neither the request nor the limits set it
+	defaultLogPageSize = uint64(1000)
+
+	// logCursorLength is the length of an encoded log cursor
+	logCursorLength = 16
 )
 
+// LogQueryLimits bounds the work done by a single log query (0 means unlimited)
+type LogQueryLimits struct {
+	// BlockRange is the maximum number of blocks the query can span
+	BlockRange uint64
+	// Results is the maximum number of logs the query can return
+	Results uint64
+}
+
+// logCursor is the position of a log in the chain
+type logCursor struct {
+	block uint64
+	index uint64
+}
+
+// encode returns the opaque string handed to the clients
+func (c *logCursor) encode() string {
+	data := make([]byte, logCursorLength)
+	binary.BigEndian.PutUint64(data[:8], c.block)
+	binary.BigEndian.PutUint64(data[8:], c.index)
+
+	return hex.EncodeToHex(data)
+}
+
+// decodeLogCursor parses a cursor returned by a previous page
+func decodeLogCursor(str string) (*logCursor, error) {
+	data, err := hex.DecodeHex(str)
+	if err != nil || len(data) != logCursorLength {
+		return nil, ErrInvalidLogCursor
+	}
+
+	return &logCursor{
+		block: binary.BigEndian.Uint64(data[:8]),
+		index: binary.BigEndian.Uint64(data[8:]),
+	}, nil
+}
+
+// LogPage is a page of the logs matching a query
+type LogPage struct {
+	Logs []*Log `json:"logs"`
+	// Cursor continues the query after the page, it is nil once all the logs are returned
+	Cursor *string `json:"cursor"`
+}
+
 // filter is an interface that BlockFilter and LogFilter implement
 type filter interface {
 	// isWS returns the flag indicating the filter has web socket stream
@@ -155,6 +228,16 @@ type logFilter struct {
 	sync.Mutex
 	query *LogQuery
 	logs  []*Log
+
+	// replayTo is the last block of the replayed logs, if any.
+	// The new logs up to that block were already replayed
+	replayTo *uint64
+
+	// replaying holds back the updates until the replayed logs can be sent
+	replaying bool
+
+	// sendLock keeps the updates in order while they are written to the web socket stream
+	sendLock sync.Mutex
 }
 
 // appendLog appends new log to logs
@@ -162,9 +245,32 @@ func (f *logFilter) appendLog(log *Log) {
 	f.Lock()
 	defer f.Unlock()
 
+	if !log.Removed && f.replayTo != nil && uint64(log.BlockNumber) <= *f.replayTo {
+		return
+	}
+
 	f.logs = append(f.logs, log)
 }
 
+// setReplayTo sets the last replayed block,
+// the logs up to that block notified since the filter was added are dropped
+func (f *logFilter) setReplayTo(head uint64) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.replayTo = &head
+
+	logs := f.logs[:0]
+
+	for _, log := range f.logs {
+		if log.Removed || uint64(log.BlockNumber) > head {
+			logs = append(logs, log)
+		}
+	}
+
+	f.logs = logs
+}
+
 // takeLogUpdates returns all saved logs in filter and set new log slice
 func (f *logFilter) takeLogUpdates() []*Log {
 	f.Lock()
@@ -190,6 +296,17 @@ func (f *logFilter) getUpdates() (string, error) {
 
 // sendUpdates writes stored logs to web socket stream
 func (f *logFilter) sendUpdates() error {
+	f.sendLock.Lock()
+	defer f.sendLock.Unlock()
+
+	f.Lock()
+	replaying := f.replaying
+	f.Unlock()
+
+	if replaying {
+		return nil
+	}
+
 	logs := f.takeLogUpdates()
 
 	for _, log := range logs {
@@ -206,6 +323,71 @@ func (f *logFilter) sendUpdates() error {
 	return nil
 }
 
+// syncingFilter is a filter to notify the changes of the sync progression
+type syncingFilter struct {
+	filterBase
+	sync.Mutex
+
+	// progression returns the current sync progression, nil if the node isn't syncing
+	progression func() *progress.Progression
+
+	// last is the last notified progression, nil if the node wasn't syncing
+	last *progress.Progression
+	// sentAt is the time of the last notification
+	sentAt time.Time
+}
+
+// changed checks if the progression should be notified
+func (f *syncingFilter) changed(current *progress.Progression) bool {
+	if f.last == nil || current == nil {
+		return (f.last == nil) != (current == nil)
+	}
+
+	if current.SyncType != f.last.SyncType ||
+		current.StartingBlock != f.last.StartingBlock ||
+		current.HighestBlock != f.last.HighestBlock {
+		return true
+	}
+
+	// the current block moves with every synced block, so its updates are throttled
+	return current.CurrentBlock != f.last.CurrentBlock && time.Since(f.sentAt) >= syncingPollInterval
+}
+
+// getUpdates returns the current sync status in string
+func (f *syncingFilter) getUpdates() (string, error) {
+	res, err := json.Marshal(newSyncingResult(f.progression()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(res), nil
+}
+
+// sendUpdates writes the sync status to web socket stream if it changed
+func (f *syncingFilter) sendUpdates() error {
+	f.Lock()
+	defer f.Unlock()
+
+	current := f.progression()
+	if !f.changed(current) {
+		return nil
+	}
+
+	res, err := json.Marshal(newSyncingResult(current))
+	if err != nil {
+		return err
+	}
+
+	if err := f.writeMessageToWs(string(res)); err != nil {
+		return err
+	}
+
+	f.last = current
+	f.sentAt = time.Now()
+
+	return nil
+}
+
 // filterManagerStore provides methods required by FilterManager
 type filterManagerStore interface {
 	// Header returns the current header of the chain (genesis if empty)
@@ -222,6 +404,16 @@ type filterManagerStore interface {
 
 	// GetBlockByNumber returns a block using the provided number
 	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// GetSyncProgression retrieves the current sync progression, if any
+	GetSyncProgression() *progress.Progression
+
+	// LogIndexTail returns the first block covered by the log index
+	LogIndexTail() uint64
+
+	// MatchLogBlocks returns the blocks in the range which could have logs
+	// emitted by the addresses with the topics, using the log index
+	MatchLogBlocks(from, to uint64, addresses []types.Address, topics [][]types.Hash) ([]uint64, error)
 }
 
 // FilterManager manages all running filters
@@ -229,6 +421,11 @@ type FilterManager struct {
 	logger hclog.Logger
 
 	timeout time.Duration
+	limits  LogQueryLimits
+
+	// send queues of the subscription connections, guarded by lock
+	queueConfig SubscriptionQueueConfig
+	queues      map[wsConn]*sendQueue
 
 	store        filterManagerStore
 	subscription blockchain.Subscription
@@ -242,10 +439,18 @@ type FilterManager struct {
 	closeCh  chan struct{}
 }
 
-func NewFilterManager(logger hclog.Logger, store filterManagerStore) *FilterManager {
+func NewFilterManager(
+	logger hclog.Logger,
+	store filterManagerStore,
+	limits LogQueryLimits,
+	queueConfig SubscriptionQueueConfig,
+) *FilterManager {
 	m := &FilterManager{
 		logger:      logger.Named("filter"),
 		timeout:     defaultTimeout,
+		limits:      limits,
+		queueConfig: queueConfig,
+		queues:      make(map[wsConn]*sendQueue),
 		store:       store,
 		blockStream: &blockStream{},
 		lock:        sync.RWMutex{},
@@ -282,6 +487,9 @@ func (f *FilterManager) Run() {
 
 	var timeoutCh <-chan time.Time
 
+	syncingTicker := time.NewTicker(syncingPollInterval)
+	defer syncingTicker.Stop()
+
 	for {
 		// check for the next filter to be removed
 		filterBase := f.nextTimeoutFilter()
@@ -305,6 +513,10 @@ func (f *FilterManager) Run() {
 				f.logger.Error("failed to uninstall filter", "id", filterBase.id)
 			}
 
+		case <-syncingTicker.C:
+			// notify the changes of the sync progression
+			f.flushSyncingFilters()
+
 		case <-f.updateCh:
 			// filters change, reset the loop to start the timeout timer
 
@@ -340,6 +552,98 @@ func (f *FilterManager) NewLogFilter(logQuery *LogQuery, ws wsConn) string {
 	return f.addFilter(filter)
 }
 
+// NewReplayLogFilter adds new LogFilter for a web socket stream, which first replays the logs
+// matching the query from its fromBlock up to the head. The replayed logs are held back
+// until the filter is released, and the replay is subject to the log query limits
+func (f *FilterManager) NewReplayLogFilter(logQuery *LogQuery, ws wsConn) (string, error) {
+	var from uint64
+
+	switch {
+	case logQuery.fromBlock == PendingBlockNumber:
+		return "", ErrPendingBlockNumber
+	case logQuery.fromBlock == EarliestBlockNumber:
+		from = 0
+	case logQuery.fromBlock.isLatest():
+		from = f.store.Header().Number
+	default:
+		from = uint64(logQuery.fromBlock)
+	}
+
+	filter := &logFilter{
+		filterBase: newFilterBase(ws),
+		query:      logQuery,
+		replaying:  true,
+	}
+
+	// the filter is added before reading the head, so the blocks written in between are either
+	// replayed or notified, and no block is missed
+	id := f.addFilter(filter)
+
+	head := f.store.Header().Number
+	filter.setReplayTo(head)
+
+	if from <= head && f.limits.BlockRange != 0 && head-from >= f.limits.BlockRange {
+		f.Uninstall(id)
+
+		return "", fmt.Errorf("%w, the limit is %d blocks", ErrBlockRangeTooHigh, f.limits.BlockRange)
+	}
+
+	if from > head {
+		// the client is up to date
+		return id, nil
+	}
+
+	logs, next, err := f.collectLogs(logQuery, logCursor{block: from}, head, f.limits.Results)
+	if err != nil {
+		f.Uninstall(id)
+
+		return "", err
+	}
+
+	if next != nil {
+		f.Uninstall(id)
+
+		return "", fmt.Errorf("%w, the limit is %d logs", ErrTooManyLogs, f.limits.Results)
+	}
+
+	filter.Lock()
+	filter.logs = append(logs, filter.logs...)
+	filter.Unlock()
+
+	return id, nil
+}
+
+// ReleaseReplayLogFilter sends the replayed logs of the filter, and the new logs since
+func (f *FilterManager) ReleaseReplayLogFilter(id string) {
+	f.lock.RLock()
+	filter, ok := f.filters[id].(*logFilter)
+	f.lock.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	filter.Lock()
+	filter.replaying = false
+	filter.Unlock()
+
+	if err := filter.sendUpdates(); err != nil {
+		f.logger.Error("Unable to send replayed logs", "id", id, "err", err)
+	}
+}
+
+// NewSyncingFilter adds new SyncingFilter, which notifies when the node starts
+// and stops syncing, as well as the sync progression in between.
+// An ongoing sync is notified with the next check of the progression
+func (f *FilterManager) NewSyncingFilter(ws wsConn) string {
+	filter := &syncingFilter{
+		filterBase:  newFilterBase(ws),
+		progression: f.store.GetSyncProgression,
+	}
+
+	return f.addFilter(filter)
+}
+
 // Exists checks the filter with given ID exists
 func (f *FilterManager) Exists(id string) bool {
 	f.lock.RLock()
@@ -357,9 +661,10 @@ func (f *FilterManager) getLogsFromBlock(query *LogQuery, block *types.Block) ([
 	}
 
 	logs := make([]*Log, 0)
+	logIdx := uint64(0)
 
 	for idx, receipt := range receipts {
-		for logIdx, log := range receipt.Logs {
+		for _, log := range receipt.Logs {
 			if query.Match(log) {
 				logs = append(logs, &Log{
 					Address:     log.Address,
@@ -372,22 +677,26 @@ func (f *FilterManager) getLogsFromBlock(query *LogQuery, block *types.Block) ([
 					LogIndex:    argUint64(logIdx),
 				})
 			}
+
+			// the log index is the position of the log in the block
+			logIdx++
 		}
 	}
 
 	return logs, nil
 }
 
-func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
+// resolveLogRange returns the range of blocks of the query
+func (f *FilterManager) resolveLogRange(query *LogQuery) (uint64, uint64, error) {
 	latestBlockNumber := f.store.Header().Number
 
 	resolveNum := func(num BlockNumber) (uint64, error) {
-		switch num {
-		case PendingBlockNumber:
+		switch {
+		case num == PendingBlockNumber:
 			return 0, ErrPendingBlockNumber
-		case EarliestBlockNumber:
+		case num == EarliestBlockNumber:
 			num = 0
-		case LatestBlockNumber:
+		case num.isLatest():
 			return latestBlockNumber, nil
 		}
 
@@ -396,46 +705,141 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 
 	from, err := resolveNum(query.fromBlock)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	to, err := resolveNum(query.toBlock)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	if to < from {
-		return nil, ErrIncorrectBlockRange
+		return 0, 0, ErrIncorrectBlockRange
 	}
 
-	// If from equals genesis block
-	// skip it
-	if from == 0 {
-		from = 1
+	return from, to, nil
+}
+
+func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
+	from, to, err := f.resolveLogRange(query)
+	if err != nil {
+		return nil, err
 	}
 
+	if f.limits.BlockRange != 0 && to-from >= f.limits.BlockRange {
+		return nil, fmt.Errorf("%w, the limit is %d blocks", ErrBlockRangeTooHigh, f.limits.BlockRange)
+	}
+
+	logs, next, err := f.collectLogs(query, logCursor{block: from}, to, f.limits.Results)
+	if err != nil {
+		return nil, err
+	}
+
+	if next != nil {
+		return nil, fmt.Errorf("%w, the limit is %d logs", ErrTooManyLogs, f.limits.Results)
+	}
+
+	return logs, nil
+}
+
+// collectLogs returns the logs matching the query from the start position to the end of the block.
+// Once the limit (0 means unlimited) is reached, the position of the next matching log is returned too.
+// The blocks covered by the log index are only read if they could have matching logs
+func (f *FilterManager) collectLogs(
+	query *LogQuery,
+	start logCursor,
+	to uint64,
+	limit uint64,
+) ([]*Log, *logCursor, error) {
 	logs := make([]*Log, 0)
 
-	for i := from; i <= to; i++ {
-		block, ok := f.store.GetBlockByNumber(i, true)
+	var next *logCursor
+
+	// visitBlock appends the logs of the block, it returns true once the collection is over
+	visitBlock := func(num uint64) (bool, error) {
+		block, ok := f.store.GetBlockByNumber(num, true)
 		if !ok {
-			break
+			return true, nil
 		}
 
 		if len(block.Transactions) == 0 {
 			// do not check logs if no txs
-			continue
+			return false, nil
 		}
 
 		blockLogs, err := f.getLogsFromBlock(query, block)
 		if err != nil {
-			return nil, err
+			return false, err
+		}
+
+		for _, log := range blockLogs {
+			if num == start.block && uint64(log.LogIndex) < start.index {
+				continue
+			}
+
+			if limit != 0 && uint64(len(logs)) == limit {
+				next = &logCursor{block: num, index: uint64(log.LogIndex)}
+
+				return true, nil
+			}
+
+			logs = append(logs, log)
 		}
 
-		logs = append(logs, blockLogs...)
+		return false, nil
 	}
 
-	return logs, nil
+	// If from equals genesis block
+	// skip it
+	num := start.block
+	if num == 0 {
+		num = 1
+	}
+
+	// the blocks below the tail of the log index are scanned one by one
+	tail := f.store.LogIndexTail()
+
+	for ; num <= to && num < tail; num++ {
+		done, err := visitBlock(num)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if done {
+			return logs, next, nil
+		}
+	}
+
+	if num > to {
+		return logs, next, nil
+	}
+
+	matches, err := f.store.MatchLogBlocks(num, to, query.Addresses, query.Topics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, match := range matches {
+		done, err := visitBlock(match)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if done {
+			break
+		}
+	}
+
+	return logs, next, nil
+}
+
+// checkLogResults makes sure the logs collected by a query don't exceed the result limit
+func (f *FilterManager) checkLogResults(logs []*Log) error {
+	if f.limits.Results != 0 && uint64(len(logs)) > f.limits.Results {
+		return fmt.Errorf("%w, the limit is %d logs", ErrTooManyLogs, f.limits.Results)
+	}
+
+	return nil
 }
 
 // GetLogsForQuery return array of logs for given query
@@ -452,13 +856,94 @@ func (f *FilterManager) GetLogsForQuery(query *LogQuery) ([]*Log, error) {
 			return []*Log{}, nil
 		}
 
-		return f.getLogsFromBlock(query, block)
+		logs, err := f.getLogsFromBlock(query, block)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := f.checkLogResults(logs); err != nil {
+			return nil, err
+		}
+
+		return logs, nil
 	}
 
 	//	gets logs from a range of blocks
 	return f.getLogsFromBlocks(query)
 }
 
+// GetLogsPage returns at most limit logs (0 means the default page size) matching the query,
+// starting at the cursor of the previous page or at the beginning of the range if it's empty.
+// A page spans at most the block range limit, so pages can have fewer logs than the limit
+// while more logs are left in the range
+func (f *FilterManager) GetLogsPage(query *LogQuery, cursor string, limit uint64) (*LogPage, error) {
+	if query.BlockHash != nil {
+		return nil, ErrBlockHashLogPage
+	}
+
+	from, to, err := f.resolveLogRange(query)
+	if err != nil {
+		return nil, err
+	}
+
+	start := &logCursor{block: from}
+
+	if cursor != "" {
+		if start, err = decodeLogCursor(cursor); err != nil {
+			return nil, err
+		}
+
+		if start.block < from || start.block > to {
+			return nil, ErrInvalidLogCursor
+		}
+	}
+
+	if limit == 0 {
+		limit = defaultLogPageSize
+		if f.limits.Results != 0 {
+			limit = f.limits.Results
+		}
+	} else if f.limits.Results != 0 && limit > f.limits.Results {
+		limit = f.limits.Results
+	}
+
+	// the blocks after the head don't have logs yet
+	if latest := f.store.Header().Number; to > latest {
+		to = latest
+	}
+
+	page := &LogPage{
+		Logs: []*Log{},
+	}
+
+	if start.block > to {
+		return page, nil
+	}
+
+	end := to
+	if f.limits.BlockRange != 0 && end-start.block >= f.limits.BlockRange {
+		end = start.block + f.limits.BlockRange - 1
+	}
+
+	logs, next, err := f.collectLogs(query, *start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if next == nil && end < to {
+		next = &logCursor{block: end + 1}
+	}
+
+	page.Logs = logs
+
+	if next != nil {
+		encoded := next.encode()
+		page.Cursor = &encoded
+	}
+
+	return page, nil
+}
+
 //GetLogFilterFromID return log filter for given filterID
 func (f *FilterManager) GetLogFilterFromID(filterID string) (*logFilter, error) {
 	f.lock.RLock()
@@ -523,9 +1008,46 @@ func (f *FilterManager) removeFilterByID(id string) bool {
 		f.emitSignalToUpdateCh()
 	}
 
+	// stop the send queue of the connection with its last subscription
+	if queue, ok := filter.getFilterBase().ws.(*sendQueue); ok {
+		if queue.refs--; queue.refs == 0 {
+			delete(f.queues, queue.conn)
+
+			_ = queue.Close()
+		}
+	}
+
 	return true
 }
 
+// removeQueueFilters removes the filters of the failed send queue
+func (f *FilterManager) removeQueueFilters(queue *sendQueue) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for id, filter := range f.filters {
+		if filter.getFilterBase().ws == queue {
+			f.removeFilterByID(id)
+		}
+	}
+}
+
+// getSendQueue returns the send queue of the connection, unsafe against race condition
+func (f *FilterManager) getSendQueue(conn wsConn) *sendQueue {
+	queue, ok := f.queues[conn]
+	if !ok {
+		queue = newSendQueue(conn, f.logger, f.queueConfig, func() {
+			f.removeQueueFilters(queue)
+		})
+
+		f.queues[conn] = queue
+	}
+
+	queue.refs++
+
+	return queue
+}
+
 // addFilter is an internal method to add given filter to list and heap
 func (f *FilterManager) addFilter(filter filter) string {
 	f.lock.Lock()
@@ -533,6 +1055,11 @@ func (f *FilterManager) addFilter(filter filter) string {
 
 	base := filter.getFilterBase()
 
+	// the updates of the web socket streams are written in the background
+	if base.ws != nil {
+		base.ws = f.getSendQueue(base.ws)
+	}
+
 	f.filters[base.id] = filter
 
 	// Set timeout and add to heap if filter doesn't have web socket connection
@@ -631,6 +1158,8 @@ func (f *FilterManager) appendLogsToFilters(header *types.Header, removed bool)
 		return nil
 	}
 
+	logIdx := uint64(0)
+
 	for indx, receipt := range receipts {
 		if receipt.TxHash == types.ZeroHash {
 			// Extract tx Hash
@@ -646,9 +1175,12 @@ func (f *FilterManager) appendLogsToFilters(header *types.Header, removed bool)
 				BlockHash:   header.Hash,
 				TxHash:      receipt.TxHash,
 				TxIndex:     argUint64(indx),
+				LogIndex:    argUint64(logIdx),
 				Removed:     removed,
 			}
 
+			logIdx++
+
 			for _, f := range logFilters {
 				if f.query.Match(log) {
 					f.appendLog(nn)
@@ -674,7 +1206,9 @@ func (f *FilterManager) flushWsFilters() error {
 
 		if flushErr := filter.sendUpdates(); flushErr != nil {
 			// mark as closed if the connection is closed
-			if errors.Is(flushErr, websocket.ErrCloseSent) {
+			if errors.Is(flushErr, websocket.ErrCloseSent) ||
+				errors.Is(flushErr, net.ErrClosed) ||
+				errors.Is(flushErr, ErrSubscriptionQueueFull) {
 				closedFilterIDs = append(closedFilterIDs, id)
 
 				f.logger.Warn(fmt.Sprintf("Subscription %s has been closed", id))
@@ -704,6 +1238,20 @@ func (f *FilterManager) flushWsFilters() error {
 	return nil
 }
 
+// flushSyncingFilters makes the syncing filters write the changes of the sync progression
+func (f *FilterManager) flushSyncingFilters() {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	for id, filter := range f.filters {
+		if syncingFilter, ok := filter.(*syncingFilter); ok {
+			if err := syncingFilter.sendUpdates(); err != nil {
+				f.logger.Error("Unable to send sync progression", "id", id, "err", err)
+			}
+		}
+	}
+}
+
 // getLogFilters returns logFilters
 func (f *FilterManager) getLogFilters() []*logFilter {
 	f.lock.RLock()
@@ -1,12 +1,14 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"math/big"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -60,6 +62,16 @@ func Test_GetLogsForQuery(t *testing.T) {
 			1,
 			nil,
 		},
+		{
+			"Found matching logs, toBlock finalized",
+			&LogQuery{
+				fromBlock: 1,
+				toBlock:   FinalizedBlockNumber,
+				Topics:    topics,
+			},
+			3,
+			nil,
+		},
 		{
 			"No logs found",
 			&LogQuery{
@@ -112,7 +124,7 @@ func Test_GetLogsForQuery(t *testing.T) {
 
 	store.appendBlocksToStore(blocks)
 
-	f := NewFilterManager(hclog.NewNullLogger(), store)
+	f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 
 	for _, testCase := range testTable {
 		testCase := testCase
@@ -135,10 +147,232 @@ func Test_GetLogsForQuery(t *testing.T) {
 	}
 }
 
+func Test_GetLogsForQuery_Limits(t *testing.T) {
+	t.Parallel()
+
+	blockHash := types.StringToHash("1")
+	topic1 := types.StringToHash("4")
+	topic2 := types.StringToHash("5")
+	topic3 := types.StringToHash("6")
+
+	var topics = [][]types.Hash{{topic1}, {topic2}, {topic3}}
+
+	store := &mockBlockStore{
+		topics: []types.Hash{topic1, topic2, topic3},
+	}
+	store.setupLogs()
+
+	blocks := make([]*types.Block, 5)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				{
+					Value: big.NewInt(10),
+				},
+				{
+					Value: big.NewInt(11),
+				},
+				{
+					Value: big.NewInt(12),
+				},
+			},
+		}
+	}
+
+	store.appendBlocksToStore(blocks)
+
+	testTable := []struct {
+		name           string
+		limits         LogQueryLimits
+		query          *LogQuery
+		expectedLength int
+		expectedError  error
+	}{
+		{
+			"Block range within the limit",
+			LogQueryLimits{BlockRange: 2},
+			&LogQuery{fromBlock: 1, toBlock: 2, Topics: topics},
+			2,
+			nil,
+		},
+		{
+			"Block range over the limit",
+			LogQueryLimits{BlockRange: 2},
+			&LogQuery{fromBlock: 1, toBlock: 3, Topics: topics},
+			0,
+			ErrBlockRangeTooHigh,
+		},
+		{
+			"Results within the limit",
+			LogQueryLimits{Results: 3},
+			&LogQuery{fromBlock: 1, toBlock: 3, Topics: topics},
+			3,
+			nil,
+		},
+		{
+			"Results over the limit",
+			LogQueryLimits{Results: 2},
+			&LogQuery{fromBlock: 1, toBlock: 3, Topics: topics},
+			0,
+			ErrTooManyLogs,
+		},
+		{
+			"Results within the limit, BlockHash present",
+			LogQueryLimits{Results: 1},
+			&LogQuery{BlockHash: &blockHash, Topics: topics},
+			1,
+			nil,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := NewFilterManager(hclog.NewNullLogger(), store, testCase.limits, SubscriptionQueueConfig{})
+
+			foundLogs, err := f.GetLogsForQuery(testCase.query)
+
+			assert.ErrorIs(t, err, testCase.expectedError)
+			assert.Len(t, foundLogs, testCase.expectedLength)
+		})
+	}
+}
+
+func Test_GetLogsPage(t *testing.T) {
+	t.Parallel()
+
+	topic1 := types.StringToHash("4")
+	topic2 := types.StringToHash("5")
+	topic3 := types.StringToHash("6")
+
+	var topics = [][]types.Hash{{topic1}, {topic2}, {topic3}}
+
+	blocks := make([]*types.Block, 5)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				{
+					Value: big.NewInt(10),
+				},
+				{
+					Value: big.NewInt(11),
+				},
+				{
+					Value: big.NewInt(12),
+				},
+			},
+		}
+	}
+
+	// positions of the matching logs, with the block level log index
+	expected := []logCursor{{1, 1}, {2, 1}, {3, 0}}
+
+	positions := func(logs []*Log) []logCursor {
+		res := []logCursor{}
+		for _, log := range logs {
+			res = append(res, logCursor{uint64(log.BlockNumber), uint64(log.LogIndex)})
+		}
+
+		return res
+	}
+
+	// readAll collects the logs of all the pages
+	readAll := func(f *FilterManager, query *LogQuery, limit uint64) ([]*Log, int, error) {
+		logs := []*Log{}
+		cursor := ""
+
+		for pages := 1; ; pages++ {
+			page, err := f.GetLogsPage(query, cursor, limit)
+			if err != nil {
+				return nil, pages, err
+			}
+
+			logs = append(logs, page.Logs...)
+
+			if page.Cursor == nil {
+				return logs, pages, nil
+			}
+
+			cursor = *page.Cursor
+		}
+	}
+
+	testTable := []struct {
+		name          string
+		logIndexTail  uint64
+		limits        LogQueryLimits
+		limit         uint64
+		expectedPages int
+	}{
+		{"single page", 0, LogQueryLimits{}, 0, 1},
+		{"page size", 0, LogQueryLimits{}, 2, 2},
+		{"page size capped by the result limit", 0, LogQueryLimits{Results: 1}, 2, 3},
+		{"pages capped by the block range", 0, LogQueryLimits{BlockRange: 2}, 0, 2},
+		{"partially indexed", 2, LogQueryLimits{}, 1, 3},
+		{"not indexed", 10, LogQueryLimits{}, 2, 2},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &mockBlockStore{
+				topics:       []types.Hash{topic1, topic2, topic3},
+				logIndexTail: testCase.logIndexTail,
+			}
+			store.setupLogs()
+			store.appendBlocksToStore(blocks)
+
+			f := NewFilterManager(hclog.NewNullLogger(), store, testCase.limits, SubscriptionQueueConfig{})
+
+			logs, pages, err := readAll(f, &LogQuery{fromBlock: 1, toBlock: 10, Topics: topics}, testCase.limit)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, positions(logs))
+			assert.Equal(t, testCase.expectedPages, pages)
+		})
+	}
+
+	t.Run("invalid queries", func(t *testing.T) {
+		t.Parallel()
+
+		store := &mockBlockStore{}
+		store.setupLogs()
+		store.appendBlocksToStore(blocks)
+
+		f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+		query := &LogQuery{fromBlock: 2, toBlock: 3}
+
+		_, err := f.GetLogsPage(query, "0x01", 0)
+		assert.ErrorIs(t, err, ErrInvalidLogCursor)
+
+		// the cursor is out of the range of the query
+		_, err = f.GetLogsPage(query, (&logCursor{block: 1}).encode(), 0)
+		assert.ErrorIs(t, err, ErrInvalidLogCursor)
+
+		blockHash := types.StringToHash("1")
+		_, err = f.GetLogsPage(&LogQuery{BlockHash: &blockHash}, "", 0)
+		assert.ErrorIs(t, err, ErrBlockHashLogPage)
+	})
+}
+
 func Test_GetLogFilterFromID(t *testing.T) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 
 	go m.Run()
 
@@ -158,7 +392,7 @@ func Test_GetLogFilterFromID(t *testing.T) {
 func TestFilterLog(t *testing.T) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 	go m.Run()
 
 	id := m.NewLogFilter(&LogQuery{
@@ -218,7 +452,7 @@ func TestFilterLog(t *testing.T) {
 func TestFilterBlock(t *testing.T) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 	go m.Run()
 
 	// add block filter
@@ -279,7 +513,7 @@ func TestFilterBlock(t *testing.T) {
 func TestFilterTimeout(t *testing.T) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 	m.timeout = 2 * time.Second
 
 	go m.Run()
@@ -299,7 +533,7 @@ func TestFilterWebsocket(t *testing.T) {
 		msgCh: make(chan []byte, 1),
 	}
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 	go m.Run()
 
 	id := m.NewBlockFilter(mock)
@@ -336,6 +570,10 @@ func (m *mockWsConn) WriteMessage(messageType int, b []byte) error {
 	return nil
 }
 
+func (m *mockWsConn) Close() error {
+	return nil
+}
+
 func TestHeadStream(t *testing.T) {
 	b := &blockStream{}
 
@@ -364,10 +602,14 @@ func (m *MockClosedWSConnection) WriteMessage(_messageType int, _data []byte) er
 	return websocket.ErrCloseSent
 }
 
+func (m *MockClosedWSConnection) Close() error {
+	return nil
+}
+
 func TestClosedFilterDeletion(t *testing.T) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store)
+	m := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
 
 	go m.Run()
 
@@ -388,6 +630,271 @@ func TestClosedFilterDeletion(t *testing.T) {
 	// should not return error when the error is websocket.ErrCloseSen because filter is removed instead
 	assert.NoError(t, err)
 
-	// false because filter was removed automatically
-	assert.False(t, m.Exists(id))
+	// false once the send queue fails to write and the filter is removed automatically
+	assert.Eventually(t, func() bool {
+		return !m.Exists(id)
+	}, time.Second, 10*time.Millisecond)
+}
+
+// subscriptionResult decodes the result of a subscription notification
+func subscriptionResult(t *testing.T, msg []byte, result interface{}) {
+	t.Helper()
+
+	var notification struct {
+		Params struct {
+			Result json.RawMessage `json:"result"`
+		} `json:"params"`
+	}
+
+	assert.NoError(t, json.Unmarshal(msg, &notification))
+	assert.NoError(t, json.Unmarshal(notification.Params.Result, result))
+}
+
+func TestReplayLogFilter(t *testing.T) {
+	t.Parallel()
+
+	topic1 := types.StringToHash("4")
+	topic2 := types.StringToHash("5")
+	topic3 := types.StringToHash("6")
+
+	newBlock := func(num int) *types.Block {
+		return &types.Block{
+			Header: &types.Header{
+				Number: uint64(num),
+				Hash:   types.StringToHash(strconv.Itoa(num)),
+			},
+			Transactions: []*types.Transaction{{}, {}, {}},
+		}
+	}
+
+	newStore := func() *mockBlockStore {
+		store := &mockBlockStore{
+			topics: []types.Hash{topic1, topic2, topic3},
+		}
+		store.setupLogs()
+
+		for i := 0; i < 5; i++ {
+			store.add(newBlock(i))
+		}
+
+		return store
+	}
+
+	query := func(from BlockNumber) *LogQuery {
+		return &LogQuery{
+			fromBlock: from,
+			toBlock:   LatestBlockNumber,
+			Topics:    [][]types.Hash{{topic1}, {topic2}, {topic3}},
+		}
+	}
+
+	readLogs := func(msgCh chan []byte, count int) []logCursor {
+		res := []logCursor{}
+
+		for _, msg := range readMessages(t, msgCh, count) {
+			log := &Log{}
+			subscriptionResult(t, []byte(msg), log)
+
+			res = append(res, logCursor{uint64(log.BlockNumber), uint64(log.LogIndex)})
+		}
+
+		return res
+	}
+
+	t.Run("replays the logs before the new ones", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore()
+		mock := &mockWsConn{msgCh: make(chan []byte, 10)}
+
+		f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+
+		id, err := f.NewReplayLogFilter(query(2), mock)
+		assert.NoError(t, err)
+
+		// the logs of a replayed block are not notified twice
+		assert.NoError(t, f.appendLogsToFilters(store.blocks[3].Header, false))
+
+		// and nothing is sent until the filter is released
+		assert.NoError(t, f.flushWsFilters())
+		assert.Never(t, func() bool {
+			return len(mock.msgCh) > 0
+		}, 100*time.Millisecond, 10*time.Millisecond)
+
+		f.ReleaseReplayLogFilter(id)
+		assert.Equal(t, []logCursor{{2, 1}, {3, 0}}, readLogs(mock.msgCh, 2))
+
+		// new blocks are notified once the replay is over
+		block := newBlock(5)
+		store.add(block)
+		store.receipts[block.Hash()] = []*types.Receipt{
+			{
+				Logs: []*types.Log{
+					{
+						Topics: store.topics,
+					},
+				},
+			},
+		}
+
+		assert.NoError(t, f.appendLogsToFilters(block.Header, false))
+		assert.NoError(t, f.flushWsFilters())
+		assert.Equal(t, []logCursor{{5, 0}}, readLogs(mock.msgCh, 1))
+	})
+
+	t.Run("blocks written while the filter is added", func(t *testing.T) {
+		t.Parallel()
+
+		// the block is written either right before or right after the head is read
+		for _, writtenBeforeHead := range []bool{true, false} {
+			store := &headerHookStore{mockBlockStore: newStore(), hookBeforeHead: writtenBeforeHead}
+			mock := &mockWsConn{msgCh: make(chan []byte, 10)}
+
+			f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+
+			store.hook = func() {
+				block := newBlock(5)
+				store.add(block)
+				store.receipts[block.Hash()] = []*types.Receipt{
+					{
+						Logs: []*types.Log{
+							{
+								Topics: store.topics,
+							},
+						},
+					},
+				}
+
+				assert.NoError(t, f.appendLogsToFilters(block.Header, false))
+			}
+
+			id, err := f.NewReplayLogFilter(query(2), mock)
+			assert.NoError(t, err)
+
+			// the logs of the block are sent once, either replayed or notified
+			f.ReleaseReplayLogFilter(id)
+			assert.NoError(t, f.flushWsFilters())
+			assert.Equal(t, []logCursor{{2, 1}, {3, 0}, {5, 0}}, readLogs(mock.msgCh, 3))
+			assert.Never(t, func() bool {
+				return len(mock.msgCh) > 0
+			}, 100*time.Millisecond, 10*time.Millisecond)
+		}
+	})
+
+	t.Run("nothing to replay", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore()
+		mock := &mockWsConn{msgCh: make(chan []byte, 10)}
+
+		f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+
+		id, err := f.NewReplayLogFilter(query(10), mock)
+		assert.NoError(t, err)
+
+		f.ReleaseReplayLogFilter(id)
+		assert.True(t, f.Exists(id))
+		assert.Never(t, func() bool {
+			return len(mock.msgCh) > 0
+		}, 100*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("invalid replays", func(t *testing.T) {
+		t.Parallel()
+
+		store := newStore()
+
+		f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{BlockRange: 3, Results: 1}, SubscriptionQueueConfig{})
+
+		_, err := f.NewReplayLogFilter(query(PendingBlockNumber), &mockWsConn{})
+		assert.ErrorIs(t, err, ErrPendingBlockNumber)
+
+		_, err = f.NewReplayLogFilter(query(EarliestBlockNumber), &mockWsConn{})
+		assert.ErrorIs(t, err, ErrBlockRangeTooHigh)
+
+		_, err = f.NewReplayLogFilter(query(2), &mockWsConn{})
+		assert.ErrorIs(t, err, ErrTooManyLogs)
+
+		// the filters of the failed replays are removed
+		assert.Empty(t, f.getLogFilters())
+	})
+}
+
+// headerHookStore runs the hook once, when the head is read
+type headerHookStore struct {
+	*mockBlockStore
+	hook           func()
+	hookBeforeHead bool
+}
+
+func (m *headerHookStore) Header() *types.Header {
+	hook := m.hook
+	m.hook = nil
+
+	if hook != nil && m.hookBeforeHead {
+		hook()
+	}
+
+	header := m.mockBlockStore.Header()
+
+	if hook != nil && !m.hookBeforeHead {
+		hook()
+	}
+
+	return header
+}
+
+func TestSyncingFilter(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mock := &mockWsConn{msgCh: make(chan []byte, 10)}
+
+	f := NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+	f.NewSyncingFilter(mock)
+
+	// nothing is sent while the node isn't syncing
+	f.flushSyncingFilters()
+
+	store.setSyncProgression(&progress.Progression{
+		SyncType:      progress.ChainSyncBulk,
+		StartingBlock: 1,
+		CurrentBlock:  5,
+		HighestBlock:  10,
+	})
+	f.flushSyncingFilters()
+
+	// the progress of the current block is throttled
+	store.setSyncProgression(&progress.Progression{
+		SyncType:      progress.ChainSyncBulk,
+		StartingBlock: 1,
+		CurrentBlock:  6,
+		HighestBlock:  10,
+	})
+	f.flushSyncingFilters()
+
+	store.setSyncProgression(nil)
+	f.flushSyncingFilters()
+
+	msgs := readMessages(t, mock.msgCh, 2)
+
+	status := &syncingResult{}
+	subscriptionResult(t, []byte(msgs[0]), status)
+	assert.Equal(t, &syncingResult{
+		Syncing: true,
+		Status: progression{
+			Type:          string(progress.ChainSyncBulk),
+			StartingBlock: "0x1",
+			CurrentBlock:  "0x5",
+			HighestBlock:  "0xa",
+		},
+	}, status)
+
+	var syncing bool
+	subscriptionResult(t, []byte(msgs[1]), &syncing)
+	assert.False(t, syncing)
+
+	assert.Never(t, func() bool {
+		return len(mock.msgCh) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
 }
@@ -0,0 +1,1096 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/introspection"
+	"github.com/graph-gophers/graphql-go/trace"
+	"github.com/hashicorp/go-hclog"
+)
+
+// graphQLStore provides access to the methods needed by the GraphQL endpoint
+type graphQLStore interface {
+	ethStore
+
+	// GetTxs gets tx pool transactions currently pending for inclusion and currently queued for validation
+	GetTxs(inclQueued bool) (map[types.Address][]*types.Transaction, map[types.Address][]*types.Transaction)
+}
+
+const (
+	// graphQLMaxDepth is the maximum nesting of the selected fields,
+	// the introspection query of the GraphQL clients nests 13 fields
+	graphQLMaxDepth = 16
+
+	// graphQLMaxCost is the maximum cost of an operation, the queries of EIP-1767 are far below it.
+	// Every resolved field costs 1, including the aliased fields and the fields of every list item
+	graphQLMaxCost = 10000
+
+	// graphQLCallCost is the cost of the fields executing a call, like the eth_call and eth_estimateGas methods
+	graphQLCallCost = 100
+)
+
+// GraphQL serves the EIP-1767 schema, resolved with the same store and the same limits as the eth endpoint
+type GraphQL struct {
+	logger hclog.Logger
+	schema *graphql.Schema
+}
+
+// newGraphQL returns the GraphQL endpoint, the block range limit also applies to the block queries.
+// The fields resolved like an eth method are refused if the method filters don't allow it
+func newGraphQL(
+	logger hclog.Logger,
+	store graphQLStore,
+	eth *Eth,
+	blockRange uint64,
+	isMethodAllowed func(method string) bool,
+) *GraphQL {
+	resolver := &graphQLResolver{
+		store:           store,
+		eth:             eth,
+		blockRange:      blockRange,
+		isMethodAllowed: isMethodAllowed,
+	}
+
+	return &GraphQL{
+		logger: logger.Named("graphql"),
+		schema: graphql.MustParseSchema(
+			graphQLSchema,
+			resolver,
+			graphql.MaxDepth(graphQLMaxDepth),
+			graphql.Tracer(graphQLCostTracer{}),
+		),
+	}
+}
+
+// graphQLRequest is a GraphQL request, as posted to the endpoint
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handle executes a JSON encoded GraphQL request, the errors are reported in the response
+func (g *GraphQL) Handle(ctx context.Context, reqBody []byte) []byte {
+	var req graphQLRequest
+
+	// the numbers of the variables are parsed by the scalars
+	decoder := json.NewDecoder(bytes.NewReader(reqBody))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&req); err != nil {
+		return graphQLErrorResponse(fmt.Sprintf("invalid request, %v", err))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cost := &graphQLCost{cancel: cancel}
+
+	resp := g.schema.Exec(context.WithValue(ctx, graphQLCostKey{}, cost), req.Query, req.OperationName, req.Variables)
+	if cost.exceeded() {
+		return graphQLErrorResponse(fmt.Sprintf("the query is too complex, the cost limit is %d", graphQLMaxCost))
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		g.logger.Error("Unable to encode GraphQL response", "err", err)
+
+		return graphQLErrorResponse("unable to encode the response")
+	}
+
+	return data
+}
+
+// graphQLErrorResponse is the response of a request failed before being executed
+func graphQLErrorResponse(msg string) []byte {
+	data, _ := json.Marshal(&graphql.Response{
+		Errors: []*gqlerrors.QueryError{{Message: msg}},
+	})
+
+	return data
+}
+
+// graphQLCostKey is the context key of the cost of an operation
+type graphQLCostKey struct{}
+
+// graphQLCost is the cost of the fields resolved so far by an operation,
+// which is canceled once the cost exceeds the limit
+type graphQLCost struct {
+	spent  uint64
+	cancel context.CancelFunc
+}
+
+// add adds the cost of a field, the fields of a list are resolved concurrently
+func (c *graphQLCost) add(cost uint64) {
+	if atomic.AddUint64(&c.spent, cost) > graphQLMaxCost {
+		c.cancel()
+	}
+}
+
+func (c *graphQLCost) exceeded() bool {
+	return atomic.LoadUint64(&c.spent) > graphQLMaxCost
+}
+
+// graphQLCostTracer charges the cost of every field before it is resolved.
+// The executor doesn't call any resolver once the operation is canceled
+type graphQLCostTracer struct{}
+
+func (graphQLCostTracer) TraceQuery(
+	ctx context.Context,
+	_ string,
+	_ string,
+	_ map[string]interface{},
+	_ map[string]*introspection.Type,
+) (context.Context, trace.TraceQueryFinishFunc) {
+	return ctx, func([]*gqlerrors.QueryError) {}
+}
+
+func (graphQLCostTracer) TraceField(
+	ctx context.Context,
+	_, typeName, fieldName string,
+	_ bool,
+	_ map[string]interface{},
+) (context.Context, trace.TraceFieldFinishFunc) {
+	if cost, ok := ctx.Value(graphQLCostKey{}).(*graphQLCost); ok {
+		cost.add(graphQLFieldCost(typeName, fieldName))
+	}
+
+	return ctx, func(*gqlerrors.QueryError) {}
+}
+
+// graphQLFieldCost returns the cost of resolving the field of the type
+func graphQLFieldCost(typeName, fieldName string) uint64 {
+	if (typeName == "Block" || typeName == "Pending") && (fieldName == "call" || fieldName == "estimateGas") {
+		return graphQLCallCost
+	}
+
+	return 1
+}
+
+// graphQLResolver resolves the queries and the mutations of the GraphQL schema
+type graphQLResolver struct {
+	store graphQLStore
+	eth   *Eth
+
+	// blockRange is the maximum number of blocks returned at once (0 means unlimited)
+	blockRange uint64
+	// isMethodAllowed checks the method filters
+	isMethodAllowed func(method string) bool
+}
+
+// checkMethod refuses the field if the eth method resolving it the same way isn't allowed
+func (r *graphQLResolver) checkMethod(method string) error {
+	if !r.isMethodAllowed(method) {
+		return NewMethodNotAllowedError(method)
+	}
+
+	return nil
+}
+
+// headerAt returns the header of the block number argument, the latest header if missing
+func (r *graphQLResolver) headerAt(num *gqlLong) (*types.Header, error) {
+	if num == nil {
+		return r.store.Header(), nil
+	}
+
+	header, ok := r.store.GetHeaderByNumber(uint64(*num))
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", *num)
+	}
+
+	return header, nil
+}
+
+// accountAt returns the account at the block number argument
+func (r *graphQLResolver) accountAt(address types.Address, num *gqlLong) (*graphQLAccount, error) {
+	header, err := r.headerAt(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphQLAccount{r: r, address: address, header: header}, nil
+}
+
+// newBlock returns the block resolver of the block
+func (r *graphQLResolver) newBlock(block *types.Block) *graphQLBlock {
+	return &graphQLBlock{r: r, block: block}
+}
+
+// newTransaction returns the transaction resolver of the transaction,
+// the block is nil if the transaction is pending
+func (r *graphQLResolver) newTransaction(txn *types.Transaction, block *types.Block, index int) *graphQLTransaction {
+	return &graphQLTransaction{r: r, tx: txn, block: block, index: index}
+}
+
+// newLogs returns the log resolvers of the logs
+func (r *graphQLResolver) newLogs(logs []*Log) []*graphQLLog {
+	resolvers := make([]*graphQLLog, len(logs))
+	for i, log := range logs {
+		resolvers[i] = &graphQLLog{r: r, log: log}
+	}
+
+	return resolvers
+}
+
+// getLogs returns the logs matching the query, along with the constraints of the filter
+func (r *graphQLResolver) getLogs(query *LogQuery, addresses *[]gqlAddress, topics *[][]gqlBytes32) ([]*graphQLLog, error) {
+	if err := r.checkMethod("eth_getLogs"); err != nil {
+		return nil, err
+	}
+
+	if addresses != nil {
+		for _, addr := range *addresses {
+			query.Addresses = append(query.Addresses, types.Address(addr))
+		}
+	}
+
+	if topics != nil {
+		for _, set := range *topics {
+			hashes := []types.Hash{}
+			for _, topic := range set {
+				hashes = append(hashes, types.Hash(topic))
+			}
+
+			query.Topics = append(query.Topics, hashes)
+		}
+	}
+
+	logs, err := r.eth.filterManager.GetLogsForQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newLogs(logs), nil
+}
+
+// call executes the call on the state of the header
+func (r *graphQLResolver) call(header *types.Header, data *graphQLCallData) (*graphQLCallResult, error) {
+	if err := r.checkMethod("eth_call"); err != nil {
+		return nil, err
+	}
+
+	txn, err := r.eth.decodeTxn(data.txnArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	// the gas limit defaults to the block gas limit
+	if txn.Gas == 0 {
+		txn.Gas = header.GasLimit
+	}
+
+	result, err := r.store.ApplyTxn(callHeader(header, txn), txn, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphQLCallResult{result: result}, nil
+}
+
+// estimateGas estimates the gas of the call on the state of the header
+func (r *graphQLResolver) estimateGas(header *types.Header, data *graphQLCallData) (gqlLong, error) {
+	if err := r.checkMethod("eth_estimateGas"); err != nil {
+		return 0, err
+	}
+
+	txn, err := r.eth.decodeTxn(data.txnArgs())
+	if err != nil {
+		return 0, err
+	}
+
+	gas, err := r.eth.estimateGas(txn, BlockNumber(header.Number), nil)
+
+	return gqlLong(gas), err
+}
+
+// graphQLCallData is the CallData input
+type graphQLCallData struct {
+	From                 *gqlAddress
+	To                   *gqlAddress
+	Gas                  *gqlLong
+	GasPrice             *gqlBigInt
+	MaxFeePerGas         *gqlBigInt
+	MaxPriorityFeePerGas *gqlBigInt
+	Value                *gqlBigInt
+	Data                 *gqlBytes
+}
+
+// txnArgs converts the CallData input to the arguments of a call
+func (d *graphQLCallData) txnArgs() *txnArgs {
+	args := &txnArgs{}
+
+	if d.From != nil {
+		from := types.Address(*d.From)
+		args.From = &from
+	}
+
+	if d.To != nil {
+		to := types.Address(*d.To)
+		args.To = &to
+	}
+
+	if d.Gas != nil {
+		args.Gas = argUintPtr(uint64(*d.Gas))
+	}
+
+	bigArg := func(v *gqlBigInt) *argBytes {
+		if v != nil {
+			return argBytesPtr(v.toBig().Bytes())
+		}
+
+		return nil
+	}
+
+	args.GasPrice = bigArg(d.GasPrice)
+	args.MaxFeePerGas = bigArg(d.MaxFeePerGas)
+	args.MaxPriorityFeePerGas = bigArg(d.MaxPriorityFeePerGas)
+	args.Value = bigArg(d.Value)
+
+	if d.Data != nil {
+		args.Data = argBytesPtr(*d.Data)
+	}
+
+	return args
+}
+
+// graphQLFilterCriteria is the FilterCriteria input
+type graphQLFilterCriteria struct {
+	FromBlock *gqlLong
+	ToBlock   *gqlLong
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+// graphQLBlockFilterCriteria is the BlockFilterCriteria input
+type graphQLBlockFilterCriteria struct {
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+// Query
+
+func (r *graphQLResolver) Block(args struct {
+	Number *gqlLong
+	Hash   *gqlBytes32
+}) (*graphQLBlock, error) {
+	if args.Number != nil && args.Hash != nil {
+		return nil, errors.New("only one of number or hash can be specified")
+	}
+
+	var (
+		block *types.Block
+		ok    bool
+	)
+
+	if args.Hash != nil {
+		block, ok = r.store.GetBlockByHash(types.Hash(*args.Hash), true)
+	} else {
+		num := r.store.Header().Number
+		if args.Number != nil {
+			num = uint64(*args.Number)
+		}
+
+		block, ok = r.store.GetBlockByNumber(num, true)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return r.newBlock(block), nil
+}
+
+func (r *graphQLResolver) Blocks(args struct {
+	From gqlLong
+	To   *gqlLong
+}) ([]*graphQLBlock, error) {
+	from := uint64(args.From)
+	head := r.store.Header().Number
+
+	to := head
+	if args.To != nil && uint64(*args.To) < head {
+		to = uint64(*args.To)
+	}
+
+	blocks := []*graphQLBlock{}
+
+	if from > to {
+		return blocks, nil
+	}
+
+	if r.blockRange != 0 && to-from >= r.blockRange {
+		return nil, fmt.Errorf("%w, the limit is %d blocks", ErrBlockRangeTooHigh, r.blockRange)
+	}
+
+	for num := from; num <= to; num++ {
+		block, ok := r.store.GetBlockByNumber(num, true)
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", num)
+		}
+
+		blocks = append(blocks, r.newBlock(block))
+	}
+
+	return blocks, nil
+}
+
+func (r *graphQLResolver) Pending() *graphQLPending {
+	return &graphQLPending{r: r}
+}
+
+func (r *graphQLResolver) Transaction(args struct{ Hash gqlBytes32 }) *graphQLTransaction {
+	hash := types.Hash(args.Hash)
+
+	if blockHash, ok := r.store.ReadTxLookup(hash); ok {
+		if block, ok := r.store.GetBlockByHash(blockHash, true); ok {
+			for idx, txn := range block.Transactions {
+				if txn.Hash == hash {
+					return r.newTransaction(txn, block, idx)
+				}
+			}
+		}
+	}
+
+	if txn, ok := r.store.GetPendingTx(hash); ok {
+		return r.newTransaction(txn, nil, 0)
+	}
+
+	return nil
+}
+
+func (r *graphQLResolver) Logs(args struct{ Filter graphQLFilterCriteria }) ([]*graphQLLog, error) {
+	query := &LogQuery{
+		fromBlock: LatestBlockNumber,
+		toBlock:   LatestBlockNumber,
+	}
+
+	if args.Filter.FromBlock != nil {
+		query.fromBlock = BlockNumber(*args.Filter.FromBlock)
+	}
+
+	if args.Filter.ToBlock != nil {
+		query.toBlock = BlockNumber(*args.Filter.ToBlock)
+	}
+
+	return r.getLogs(query, args.Filter.Addresses, args.Filter.Topics)
+}
+
+func (r *graphQLResolver) GasPrice() gqlBigInt {
+	return newGQLBigInt(r.store.GetAvgGasPrice())
+}
+
+func (r *graphQLResolver) MaxPriorityFeePerGas() gqlBigInt {
+	return newGQLBigInt(r.eth.suggestTip())
+}
+
+func (r *graphQLResolver) ChainID() gqlBigInt {
+	return newGQLBigInt(new(big.Int).SetUint64(r.eth.chainID))
+}
+
+func (r *graphQLResolver) Syncing() *graphQLSyncState {
+	if progression := r.store.GetSyncProgression(); progression != nil {
+		return &graphQLSyncState{progression: progression}
+	}
+
+	return nil
+}
+
+// Mutation
+
+func (r *graphQLResolver) SendRawTransaction(args struct{ Data gqlBytes }) (gqlBytes32, error) {
+	if err := r.checkMethod("eth_sendRawTransaction"); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	txn, err := r.eth.sendRawTransaction(args.Data)
+	if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(txn.Hash), nil
+}
+
+// graphQLPending resolves the pending state
+type graphQLPending struct {
+	r *graphQLResolver
+}
+
+func (p *graphQLPending) Transactions() *[]*graphQLTransaction {
+	pending, _ := p.r.store.GetTxs(false)
+
+	// the transactions are sorted by account, then by nonce
+	addresses := make([]types.Address, 0, len(pending))
+	for addr := range pending {
+		addresses = append(addresses, addr)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	txns := []*graphQLTransaction{}
+
+	for _, addr := range addresses {
+		for _, txn := range pending[addr] {
+			txns = append(txns, p.r.newTransaction(txn, nil, 0))
+		}
+	}
+
+	return &txns
+}
+
+func (p *graphQLPending) TransactionCount() int32 {
+	pending, _ := p.r.store.GetTxs(false)
+
+	count := 0
+	for _, txns := range pending {
+		count += len(txns)
+	}
+
+	return int32(count)
+}
+
+func (p *graphQLPending) Account(args struct{ Address gqlAddress }) *graphQLAccount {
+	return &graphQLAccount{r: p.r, address: types.Address(args.Address), header: p.r.store.Header(), pending: true}
+}
+
+func (p *graphQLPending) Call(args struct{ Data graphQLCallData }) (*graphQLCallResult, error) {
+	return p.r.call(p.r.store.Header(), &args.Data)
+}
+
+func (p *graphQLPending) EstimateGas(args struct{ Data graphQLCallData }) (gqlLong, error) {
+	return p.r.estimateGas(p.r.store.Header(), &args.Data)
+}
+
+// graphQLBlock resolves a block
+type graphQLBlock struct {
+	r     *graphQLResolver
+	block *types.Block
+}
+
+func (b *graphQLBlock) Number() gqlLong {
+	return gqlLong(b.block.Number())
+}
+
+func (b *graphQLBlock) Hash() gqlBytes32 {
+	return gqlBytes32(b.block.Hash())
+}
+
+func (b *graphQLBlock) Parent() *graphQLBlock {
+	header := b.block.Header
+	if header.Number == 0 {
+		return nil
+	}
+
+	parent, ok := b.r.store.GetBlockByHash(header.ParentHash, true)
+	if !ok {
+		return nil
+	}
+
+	return b.r.newBlock(parent)
+}
+
+func (b *graphQLBlock) Nonce() gqlBytes {
+	return b.block.Header.Nonce[:]
+}
+
+func (b *graphQLBlock) TransactionsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.TxRoot)
+}
+
+func (b *graphQLBlock) TransactionCount() *int32 {
+	count := int32(len(b.block.Transactions))
+
+	return &count
+}
+
+func (b *graphQLBlock) StateRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.StateRoot)
+}
+
+func (b *graphQLBlock) ReceiptsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.ReceiptsRoot)
+}
+
+func (b *graphQLBlock) Miner(args struct{ Block *gqlLong }) (*graphQLAccount, error) {
+	return b.r.accountAt(b.block.Header.Miner, args.Block)
+}
+
+func (b *graphQLBlock) ExtraData() gqlBytes {
+	return b.block.Header.ExtraData
+}
+
+func (b *graphQLBlock) GasLimit() gqlLong {
+	return gqlLong(b.block.Header.GasLimit)
+}
+
+func (b *graphQLBlock) GasUsed() gqlLong {
+	return gqlLong(b.block.Header.GasUsed)
+}
+
+func (b *graphQLBlock) BaseFeePerGas() *gqlBigInt {
+	header := b.block.Header
+	if header.BaseFee == 0 {
+		return nil
+	}
+
+	fee := newGQLBigInt(new(big.Int).SetUint64(header.BaseFee))
+
+	return &fee
+}
+
+func (b *graphQLBlock) Timestamp() gqlLong {
+	return gqlLong(b.block.Header.Timestamp)
+}
+
+func (b *graphQLBlock) LogsBloom() gqlBytes {
+	return b.block.Header.LogsBloom[:]
+}
+
+func (b *graphQLBlock) MixHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.MixHash)
+}
+
+func (b *graphQLBlock) Difficulty() gqlBigInt {
+	return newGQLBigInt(new(big.Int).SetUint64(b.block.Header.Difficulty))
+}
+
+func (b *graphQLBlock) TotalDifficulty() gqlBigInt {
+	return b.Difficulty()
+}
+
+func (b *graphQLBlock) OmmerCount() *int32 {
+	count := int32(len(b.block.Uncles))
+
+	return &count
+}
+
+func (b *graphQLBlock) Ommers() *[]*graphQLBlock {
+	ommers := make([]*graphQLBlock, len(b.block.Uncles))
+	for i, uncle := range b.block.Uncles {
+		ommers[i] = b.r.newBlock(&types.Block{Header: uncle})
+	}
+
+	return &ommers
+}
+
+func (b *graphQLBlock) OmmerAt(args struct{ Index int32 }) *graphQLBlock {
+	uncles := b.block.Uncles
+
+	if args.Index < 0 || int(args.Index) >= len(uncles) {
+		return nil
+	}
+
+	return b.r.newBlock(&types.Block{Header: uncles[args.Index]})
+}
+
+func (b *graphQLBlock) OmmerHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Sha3Uncles)
+}
+
+func (b *graphQLBlock) Transactions() *[]*graphQLTransaction {
+	txns := make([]*graphQLTransaction, len(b.block.Transactions))
+	for idx, txn := range b.block.Transactions {
+		txns[idx] = b.r.newTransaction(txn, b.block, idx)
+	}
+
+	return &txns
+}
+
+func (b *graphQLBlock) TransactionAt(args struct{ Index int32 }) *graphQLTransaction {
+	if args.Index < 0 || int(args.Index) >= len(b.block.Transactions) {
+		return nil
+	}
+
+	return b.r.newTransaction(b.block.Transactions[args.Index], b.block, int(args.Index))
+}
+
+func (b *graphQLBlock) Logs(args struct{ Filter graphQLBlockFilterCriteria }) ([]*graphQLLog, error) {
+	hash := b.block.Hash()
+
+	return b.r.getLogs(&LogQuery{BlockHash: &hash}, args.Filter.Addresses, args.Filter.Topics)
+}
+
+func (b *graphQLBlock) Account(args struct{ Address gqlAddress }) *graphQLAccount {
+	return &graphQLAccount{r: b.r, address: types.Address(args.Address), header: b.block.Header}
+}
+
+func (b *graphQLBlock) Call(args struct{ Data graphQLCallData }) (*graphQLCallResult, error) {
+	return b.r.call(b.block.Header, &args.Data)
+}
+
+func (b *graphQLBlock) EstimateGas(args struct{ Data graphQLCallData }) (gqlLong, error) {
+	return b.r.estimateGas(b.block.Header, &args.Data)
+}
+
+// graphQLTransaction resolves a transaction along with its block, which is nil if the transaction is pending
+type graphQLTransaction struct {
+	r     *graphQLResolver
+	tx    *types.Transaction
+	block *types.Block
+	index int
+
+	// the receipt and the index of its first log in the block, loaded once needed
+	receiptLoaded bool
+	receipt       *types.Receipt
+	logIndex      uint64
+}
+
+// getReceipt returns the receipt of the transaction, nil if it is pending or if the receipts aren't written yet
+func (t *graphQLTransaction) getReceipt() (*types.Receipt, error) {
+	if t.block == nil || t.receiptLoaded {
+		return t.receipt, nil
+	}
+
+	receipts, err := t.r.store.GetReceiptsByHash(t.block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	t.receiptLoaded = true
+
+	if t.index >= len(receipts) {
+		return nil, nil
+	}
+
+	// the logs are indexed by their position in the block
+	for _, raw := range receipts[:t.index] {
+		t.logIndex += uint64(len(raw.Logs))
+	}
+
+	t.receipt = receipts[t.index]
+
+	return t.receipt, nil
+}
+
+func (t *graphQLTransaction) Hash() gqlBytes32 {
+	return gqlBytes32(t.tx.Hash)
+}
+
+func (t *graphQLTransaction) Nonce() gqlLong {
+	return gqlLong(t.tx.Nonce)
+}
+
+func (t *graphQLTransaction) Index() *int32 {
+	if t.block == nil {
+		return nil
+	}
+
+	index := int32(t.index)
+
+	return &index
+}
+
+func (t *graphQLTransaction) From(args struct{ Block *gqlLong }) (*graphQLAccount, error) {
+	return t.r.accountAt(t.tx.From, args.Block)
+}
+
+func (t *graphQLTransaction) To(args struct{ Block *gqlLong }) (*graphQLAccount, error) {
+	if t.tx.To == nil {
+		return nil, nil
+	}
+
+	return t.r.accountAt(*t.tx.To, args.Block)
+}
+
+func (t *graphQLTransaction) Value() gqlBigInt {
+	return newGQLBigInt(t.tx.Value)
+}
+
+func (t *graphQLTransaction) GasPrice() gqlBigInt {
+	return newGQLBigInt(t.tx.GasPrice)
+}
+
+func (t *graphQLTransaction) MaxFeePerGas() *gqlBigInt {
+	if !t.tx.IsDynamicFee() {
+		return nil
+	}
+
+	// the fee cap is carried in the gas price field
+	fee := newGQLBigInt(t.tx.GasPrice)
+
+	return &fee
+}
+
+func (t *graphQLTransaction) MaxPriorityFeePerGas() *gqlBigInt {
+	if !t.tx.IsDynamicFee() {
+		return nil
+	}
+
+	tip := newGQLBigInt(t.tx.GasTipCap)
+
+	return &tip
+}
+
+func (t *graphQLTransaction) EffectiveGasPrice() *gqlBigInt {
+	if t.block == nil {
+		return nil
+	}
+
+	price := newGQLBigInt(t.tx.EffectiveGasPrice(t.block.Header.BaseFee))
+
+	return &price
+}
+
+func (t *graphQLTransaction) Gas() gqlLong {
+	return gqlLong(t.tx.Gas)
+}
+
+func (t *graphQLTransaction) InputData() gqlBytes {
+	if t.tx.Input == nil {
+		return gqlBytes{}
+	}
+
+	return t.tx.Input
+}
+
+func (t *graphQLTransaction) Block() *graphQLBlock {
+	if t.block == nil {
+		return nil
+	}
+
+	return t.r.newBlock(t.block)
+}
+
+func (t *graphQLTransaction) Status() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.Status == nil {
+		return nil, err
+	}
+
+	status := gqlLong(*receipt.Status)
+
+	return &status, nil
+}
+
+func (t *graphQLTransaction) GasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	gas := gqlLong(receipt.GasUsed)
+
+	return &gas, nil
+}
+
+func (t *graphQLTransaction) CumulativeGasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	gas := gqlLong(receipt.CumulativeGasUsed)
+
+	return &gas, nil
+}
+
+func (t *graphQLTransaction) CreatedContract(args struct{ Block *gqlLong }) (*graphQLAccount, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+
+	return t.r.accountAt(*receipt.ContractAddress, args.Block)
+}
+
+func (t *graphQLTransaction) Logs() (*[]*graphQLLog, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	logs := t.r.newLogs(toReceipt(receipt, t.tx, uint64(t.index), t.block, t.logIndex).Logs)
+
+	return &logs, nil
+}
+
+func (t *graphQLTransaction) Type() *int32 {
+	txType := int32(t.tx.Type)
+
+	return &txType
+}
+
+func (t *graphQLTransaction) R() gqlBigInt {
+	return newGQLBigInt(t.tx.R)
+}
+
+func (t *graphQLTransaction) S() gqlBigInt {
+	return newGQLBigInt(t.tx.S)
+}
+
+func (t *graphQLTransaction) V() gqlBigInt {
+	return newGQLBigInt(t.tx.V)
+}
+
+// graphQLLog resolves a log
+type graphQLLog struct {
+	r   *graphQLResolver
+	log *Log
+}
+
+func (l *graphQLLog) Index() int32 {
+	return int32(l.log.LogIndex)
+}
+
+func (l *graphQLLog) Account(args struct{ Block *gqlLong }) (*graphQLAccount, error) {
+	return l.r.accountAt(l.log.Address, args.Block)
+}
+
+func (l *graphQLLog) Topics() []gqlBytes32 {
+	topics := make([]gqlBytes32, len(l.log.Topics))
+	for i, topic := range l.log.Topics {
+		topics[i] = gqlBytes32(topic)
+	}
+
+	return topics
+}
+
+func (l *graphQLLog) Data() gqlBytes {
+	if l.log.Data == nil {
+		return gqlBytes{}
+	}
+
+	return gqlBytes(l.log.Data)
+}
+
+func (l *graphQLLog) Transaction() (*graphQLTransaction, error) {
+	block, ok := l.r.store.GetBlockByHash(l.log.BlockHash, true)
+	if !ok || int(l.log.TxIndex) >= len(block.Transactions) {
+		return nil, fmt.Errorf("transaction of log %d in block %s not found", l.log.LogIndex, l.log.BlockHash)
+	}
+
+	index := int(l.log.TxIndex)
+
+	return l.r.newTransaction(block.Transactions[index], block, index), nil
+}
+
+// graphQLAccount resolves an account in the state of the header,
+// pending accounts have their nonce from the pool
+type graphQLAccount struct {
+	r       *graphQLResolver
+	address types.Address
+	header  *types.Header
+	pending bool
+}
+
+// getAccount returns the state of the account, empty if it doesn't exist
+func (a *graphQLAccount) getAccount() (*state.Account, error) {
+	acc, err := a.r.store.GetAccount(a.header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return &state.Account{Balance: big.NewInt(0)}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
+func (a *graphQLAccount) Address() gqlAddress {
+	return gqlAddress(a.address)
+}
+
+func (a *graphQLAccount) Balance() (gqlBigInt, error) {
+	acc, err := a.getAccount()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return newGQLBigInt(acc.Balance), nil
+}
+
+func (a *graphQLAccount) TransactionCount() (gqlLong, error) {
+	if a.pending {
+		return gqlLong(a.r.store.GetNonce(a.address)), nil
+	}
+
+	acc, err := a.getAccount()
+	if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(acc.Nonce), nil
+}
+
+func (a *graphQLAccount) Code() (gqlBytes, error) {
+	acc, err := a.getAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(acc.CodeHash) == 0 {
+		return gqlBytes{}, nil
+	}
+
+	code, err := a.r.store.GetCode(types.BytesToHash(acc.CodeHash))
+	if err != nil {
+		// accounts without code have no code stored
+		return gqlBytes{}, nil
+	}
+
+	return code, nil
+}
+
+func (a *graphQLAccount) Storage(args struct{ Slot gqlBytes32 }) (gqlBytes32, error) {
+	data, err := a.r.eth.getStorage(a.header.StateRoot, a.address, types.Hash(args.Slot))
+	if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(types.BytesToHash(data)), nil
+}
+
+// graphQLCallResult resolves the result of a call
+type graphQLCallResult struct {
+	result *runtime.ExecutionResult
+}
+
+func (c *graphQLCallResult) Data() gqlBytes {
+	if c.result.ReturnValue == nil {
+		return gqlBytes{}
+	}
+
+	return c.result.ReturnValue
+}
+
+func (c *graphQLCallResult) GasUsed() gqlLong {
+	return gqlLong(c.result.GasUsed)
+}
+
+func (c *graphQLCallResult) Status() gqlLong {
+	if c.result.Failed() {
+		return 0
+	}
+
+	return 1
+}
+
+// graphQLSyncState resolves the progression of the sync
+type graphQLSyncState struct {
+	progression *progress.Progression
+}
+
+func (s *graphQLSyncState) StartingBlock() gqlLong {
+	return gqlLong(s.progression.StartingBlock)
+}
+
+func (s *graphQLSyncState) CurrentBlock() gqlLong {
+	return gqlLong(s.progression.CurrentBlock)
+}
+
+func (s *graphQLSyncState) HighestBlock() gqlLong {
+	return gqlLong(s.progression.HighestBlock)
+}
@@ -0,0 +1,458 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/ratelimit"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+// graphQLTestStore serves the blocks of the block store along with the pending transactions
+type graphQLTestStore struct {
+	*mockBlockStore
+	pending map[types.Address][]*types.Transaction
+
+	// calls is the number of executed calls
+	calls int64
+}
+
+func (m *graphQLTestStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	override *types.CallOverride,
+) (*runtime.ExecutionResult, error) {
+	atomic.AddInt64(&m.calls, 1)
+
+	return m.mockBlockStore.ApplyTxn(header, txn, override)
+}
+
+func (m *graphQLTestStore) GetTxs(inclQueued bool) (
+	map[types.Address][]*types.Transaction,
+	map[types.Address][]*types.Transaction,
+) {
+	return m.pending, nil
+}
+
+func newGraphQLTestStore() *graphQLTestStore {
+	store := &graphQLTestStore{mockBlockStore: newMockBlockStore()}
+
+	for i := 0; i < 3; i++ {
+		block := newTestBlock(uint64(i), types.Hash{byte(i + 1)})
+		if i > 0 {
+			block.Header.ParentHash = types.Hash{byte(i)}
+		}
+
+		store.add(block)
+	}
+
+	// the block 1 has two transactions, the second one emitting a log
+	block := store.blocks[1]
+	block.Transactions = []*types.Transaction{
+		{Hash: types.Hash{0x11}, Nonce: 1, From: addr0, To: &addr1, GasPrice: big.NewInt(1)},
+		{Hash: types.Hash{0x12}, Nonce: 2, From: addr0, GasPrice: big.NewInt(1)},
+	}
+
+	status := types.ReceiptSuccess
+	store.receipts[block.Hash()] = []*types.Receipt{
+		{GasUsed: 21000, CumulativeGasUsed: 21000, Status: &status},
+		{
+			GasUsed:           50000,
+			CumulativeGasUsed: 71000,
+			Status:            &status,
+			ContractAddress:   &addr1,
+			Logs: []*types.Log{
+				{Address: addr1, Topics: []types.Hash{hash1}, Data: []byte{0x1}},
+			},
+		},
+	}
+
+	pendingTxn := &types.Transaction{Hash: types.Hash{0x21}, Nonce: 3, From: addr0}
+	store.pendingTxns = []*types.Transaction{pendingTxn}
+	store.pending = map[types.Address][]*types.Transaction{addr0: {pendingTxn}}
+
+	return store
+}
+
+func executeGraphQLQuery(t *testing.T, g *GraphQL, query string) string {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	assert.NoError(t, err)
+
+	return string(g.Handle(context.Background(), body))
+}
+
+func newTestGraphQL(store *graphQLTestStore, blockRange uint64, filters map[string]*MethodFilter) *GraphQL {
+	eth := newTestEthEndpoint(store)
+	eth.filterManager = NewFilterManager(hclog.NewNullLogger(), store, LogQueryLimits{}, SubscriptionQueueConfig{})
+
+	d := &Dispatcher{params: &dispatcherParams{methodFilters: filters}}
+
+	return newGraphQL(hclog.NewNullLogger(), store, eth, blockRange, d.isMethodAllowed)
+}
+
+func TestGraphQL_Queries(t *testing.T) {
+	g := newTestGraphQL(newGraphQLTestStore(), 2, nil)
+
+	cases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			"latest block",
+			`{ block { number hash parent { number } } }`,
+			`{"data":{"block":{
+				"number":"0x2",
+				"hash":"0x0300000000000000000000000000000000000000000000000000000000000000",
+				"parent":{"number":"0x1"}
+			}}}`,
+		},
+		{
+			"genesis has no parent",
+			`{ block(number: "0x0") { number parent { number } } }`,
+			`{"data":{"block":{"number":"0x0","parent":null}}}`,
+		},
+		{
+			"unknown block",
+			`{ block(number: 10) { number } }`,
+			`{"data":{"block":null}}`,
+		},
+		{
+			"number and hash are exclusive",
+			`{ block(number: 1, hash: "0x0200000000000000000000000000000000000000000000000000000000000000") { number } }`,
+			`{"data":{"block":null},"errors":[{"message":"only one of number or hash can be specified","path":["block"]}]}`,
+		},
+		{
+			"block with transactions and receipts",
+			`{ block(hash: "0x0200000000000000000000000000000000000000000000000000000000000000") {
+				transactionCount
+				transactions { hash index nonce to { address } status gasUsed cumulativeGasUsed }
+			} }`,
+			`{"data":{"block":{
+				"transactionCount":2,
+				"transactions":[
+					{
+						"hash":"0x1100000000000000000000000000000000000000000000000000000000000000",
+						"index":0,
+						"nonce":"0x1",
+						"to":{"address":"0x0000000000000000000000000000000000000001"},
+						"status":"0x1",
+						"gasUsed":"0x5208",
+						"cumulativeGasUsed":"0x5208"
+					},
+					{
+						"hash":"0x1200000000000000000000000000000000000000000000000000000000000000",
+						"index":1,
+						"nonce":"0x2",
+						"to":null,
+						"status":"0x1",
+						"gasUsed":"0xc350",
+						"cumulativeGasUsed":"0x11558"
+					}
+				]
+			}}}`,
+		},
+		{
+			"transaction logs are indexed in the block",
+			`{ transaction(hash: "0x1200000000000000000000000000000000000000000000000000000000000000") {
+				block { number }
+				createdContract { address }
+				logs { index account { address } topics data transaction { hash } }
+			} }`,
+			`{"data":{"transaction":{
+				"block":{"number":"0x1"},
+				"createdContract":{"address":"0x0000000000000000000000000000000000000001"},
+				"logs":[{
+					"index":0,
+					"account":{"address":"0x0000000000000000000000000000000000000001"},
+					"topics":["0x0000000000000000000000000000000000000000000000000000000000000001"],
+					"data":"0x01",
+					"transaction":{"hash":"0x1200000000000000000000000000000000000000000000000000000000000000"}
+				}]
+			}}}`,
+		},
+		{
+			"pending transaction",
+			`{ transaction(hash: "0x2100000000000000000000000000000000000000000000000000000000000000") {
+				nonce index block { number } status
+			} }`,
+			`{"data":{"transaction":{"nonce":"0x3","index":null,"block":null,"status":null}}}`,
+		},
+		{
+			"pending pool",
+			`{ pending { transactionCount transactions { hash } } }`,
+			`{"data":{"pending":{
+				"transactionCount":1,
+				"transactions":[{"hash":"0x2100000000000000000000000000000000000000000000000000000000000000"}]
+			}}}`,
+		},
+		{
+			"blocks range",
+			`{ blocks(from: 1) { number } }`,
+			`{"data":{"blocks":[{"number":"0x1"},{"number":"0x2"}]}}`,
+		},
+		{
+			"blocks over the range limit",
+			`{ blocks(from: 0, to: 2) { number } }`,
+			`{"data":null,"errors":[{
+				"message":"block range too high, the limit is 2 blocks",
+				"path":["blocks"]
+			}]}`,
+		},
+		{
+			"logs of a range",
+			`{ logs(filter: {fromBlock: 0, toBlock: 2, topics: [["0x0000000000000000000000000000000000000000000000000000000000000001"]]}) {
+				index transaction { hash }
+			} }`,
+			`{"data":{"logs":[{
+				"index":0,
+				"transaction":{"hash":"0x1200000000000000000000000000000000000000000000000000000000000000"}
+			}]}}`,
+		},
+		{
+			"invalid scalar",
+			`{ block(hash: "0x01") { number } }`,
+			`{"data":{},"errors":[{"message":"invalid hex string \"0x01\", expected 32 bytes"}]}`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			assert.JSONEq(t, c.expected, executeGraphQLQuery(t, g, c.query))
+		})
+	}
+}
+
+func TestGraphQL_Handler(t *testing.T) {
+	store := newGraphQLTestStore()
+
+	j := &JSONRPC{
+		logger:      hclog.NewNullLogger(),
+		config:      &Config{MaxRequestBodySize: 128},
+		rateLimiter: ratelimit.NewLimiter(),
+		graphQL:     newTestGraphQL(store, 0, nil),
+	}
+
+	send := func(method string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/graphql", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		j.handleGraphQL(rec, req)
+
+		return rec
+	}
+
+	rec := send(http.MethodPost, `{"query": "{ block { number } }"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":{"block":{"number":"0x2"}}}`, rec.Body.String())
+
+	rec = send(http.MethodPost, `{"query": "query($n: Long) { block(number: $n) { number } }", "variables": {"n": 1}}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":{"block":{"number":"0x1"}}}`, rec.Body.String())
+
+	rec = send(http.MethodPost, `{"query": 1}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid request")
+
+	rec = send(http.MethodGet, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = send(http.MethodPost, `{"query": "{ block { number } }`+strings.Repeat(" ", 128)+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestGraphQL_MethodFilters(t *testing.T) {
+	g := newTestGraphQL(newGraphQLTestStore(), 0, map[string]*MethodFilter{
+		"eth": {Deny: []string{"call", "estimateGas", "getLogs", "sendRawTransaction"}},
+	})
+
+	cases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			"call",
+			`{ block { number call(data: {}) { status } } }`,
+			`{"data":{"block":{"number":"0x2","call":null}},"errors":[{
+				"message":"the method eth_call is not allowed",
+				"path":["block","call"]
+			}]}`,
+		},
+		{
+			"gas estimation",
+			`{ pending { estimateGas(data: {}) } }`,
+			`{"data":null,"errors":[{
+				"message":"the method eth_estimateGas is not allowed",
+				"path":["pending","estimateGas"]
+			}]}`,
+		},
+		{
+			"logs",
+			`{ block { logs(filter: {}) { index } } }`,
+			`{"data":{"block":null},"errors":[{
+				"message":"the method eth_getLogs is not allowed",
+				"path":["block","logs"]
+			}]}`,
+		},
+		{
+			"transaction",
+			`mutation { sendRawTransaction(data: "0x00") }`,
+			`{"data":null,"errors":[{
+				"message":"the method eth_sendRawTransaction is not allowed",
+				"path":["sendRawTransaction"]
+			}]}`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			assert.JSONEq(t, c.expected, executeGraphQLQuery(t, g, c.query))
+		})
+	}
+}
+
+func TestGraphQL_Limits(t *testing.T) {
+	store := newGraphQLTestStore()
+	g := newTestGraphQL(store, 0, nil)
+
+	// every block is nested in its child, past the depth limit
+	query := "{ block { number" + strings.Repeat(" parent { number", 16) + strings.Repeat(" }", 17) + " }"
+
+	resp := struct {
+		Data   interface{}
+		Errors []struct{ Message string }
+	}{}
+
+	assert.NoError(t, json.Unmarshal([]byte(executeGraphQLQuery(t, g, query)), &resp))
+	assert.Nil(t, resp.Data)
+	assert.NotEmpty(t, resp.Errors)
+	assert.Contains(t, resp.Errors[0].Message, "has depth 17 that exceeds max depth 16")
+
+	// the aliased calls add up, and no call is executed once the limit is exceeded
+	calls := make([]string, 200)
+	for i := range calls {
+		calls[i] = fmt.Sprintf(`c%d: pending { call(data: {to: "%s"}) { status } }`, i, addr1)
+	}
+
+	assert.JSONEq(
+		t,
+		`{"errors":[{"message":"the query is too complex, the cost limit is 10000"}]}`,
+		executeGraphQLQuery(t, g, "{ "+strings.Join(calls, " ")+" }"),
+	)
+	assert.LessOrEqual(t, atomic.LoadInt64(&store.calls), int64(graphQLMaxCost/graphQLCallCost))
+}
+
+func TestGraphQL_Introspection(t *testing.T) {
+	g := newTestGraphQL(newGraphQLTestStore(), 0, nil)
+
+	resp := struct {
+		Data struct {
+			Schema struct {
+				QueryType    struct{ Name string }
+				MutationType struct{ Name string }
+				Types        []struct{ Name string }
+			} `json:"__schema"`
+		}
+		Errors []interface{}
+	}{}
+
+	assert.NoError(t, json.Unmarshal([]byte(executeGraphQLQuery(t, g, introspectionQuery)), &resp))
+	assert.Empty(t, resp.Errors)
+
+	assert.Equal(t, "Query", resp.Data.Schema.QueryType.Name)
+	assert.Equal(t, "Mutation", resp.Data.Schema.MutationType.Name)
+
+	names := []string{}
+	for _, typ := range resp.Data.Schema.Types {
+		names = append(names, typ.Name)
+	}
+
+	assert.Subset(t, names, []string{
+		"Account", "Address", "BigInt", "Block", "BlockFilterCriteria", "Bytes", "Bytes32", "CallData",
+		"CallResult", "FilterCriteria", "Log", "Long", "Pending", "SyncState", "Transaction",
+	})
+}
+
+// introspectionQuery is the query of the GraphQL clients discovering the schema
+const introspectionQuery = `
+query IntrospectionQuery {
+	__schema {
+		queryType { name }
+		mutationType { name }
+		subscriptionType { name }
+		types { ...FullType }
+		directives { name description locations args { ...InputValue } }
+	}
+}
+
+fragment FullType on __Type {
+	kind
+	name
+	description
+	fields(includeDeprecated: true) {
+		name
+		description
+		args { ...InputValue }
+		type { ...TypeRef }
+		isDeprecated
+		deprecationReason
+	}
+	inputFields { ...InputValue }
+	interfaces { ...TypeRef }
+	enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+	possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+	name
+	description
+	type { ...TypeRef }
+	defaultValue
+}
+
+fragment TypeRef on __Type {
+	kind
+	name
+	ofType {
+		kind
+		name
+		ofType {
+			kind
+			name
+			ofType {
+				kind
+				name
+				ofType {
+					kind
+					name
+					ofType {
+						kind
+						name
+						ofType {
+							kind
+							name
+							ofType { kind name }
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
@@ -0,0 +1,29 @@
+package jsonrpc
+
+import (
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
+)
+
+// ibftStore provides access to the methods needed by ibft endpoint
+type ibftStore interface {
+	// GetBlockProof returns the proof of the committed seals of the block
+	GetBlockProof(number uint64) (*finality.Proof, error)
+}
+
+// Ibft is the ibft jsonrpc endpoint
+type Ibft struct {
+	store ibftStore
+	// eth resolves the block numbers the same way the eth methods do
+	eth *Eth
+}
+
+// GetBlockProof returns the proof that a quorum of the validators committed to the block.
+// The blocks are final once sealed, the proof can be verified with the finality package
+func (i *Ibft) GetBlockProof(number BlockNumber) (interface{}, error) {
+	num, err := GetNumericBlockNumber(number, i.eth)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.store.GetBlockProof(num)
+}
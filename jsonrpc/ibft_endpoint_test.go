@@ -0,0 +1,70 @@
+package jsonrpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockIbftStore struct {
+	ethStore
+
+	header *types.Header
+}
+
+func (m *mockIbftStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockIbftStore) GetBlockProof(number uint64) (*finality.Proof, error) {
+	if number == 0 || number > m.header.Number {
+		return nil, errors.New("not found")
+	}
+
+	return &finality.Proof{
+		Header: &types.Header{Number: number},
+		Quorum: 3,
+	}, nil
+}
+
+func TestIbft_GetBlockProof(t *testing.T) {
+	store := &mockIbftStore{header: &types.Header{Number: 10}}
+	ibft := &Ibft{store, &Eth{store: store}}
+
+	cases := []struct {
+		name     string
+		number   BlockNumber
+		expected uint64
+		err      bool
+	}{
+		{"proof of a block number", BlockNumber(5), 5, false},
+		{"proof of the latest block", LatestBlockNumber, 10, false},
+		{"proof of the finalized block", FinalizedBlockNumber, 10, false},
+		{"proof of the safe block", SafeBlockNumber, 10, false},
+		{"no proof of the pending block", PendingBlockNumber, 0, true},
+		{"no proof of the genesis block", EarliestBlockNumber, 0, true},
+		{"no proof of a future block", BlockNumber(11), 0, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := ibft.GetBlockProof(c.number)
+
+			if c.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			proof, ok := res.(*finality.Proof)
+			if assert.True(t, ok) {
+				assert.Equal(t, c.expected, proof.Header.Number)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/hashicorp/go-hclog"
+)
+
+// ipcConn is a client connection of the IPC endpoint.
+// Messages are written as a stream of JSON values, separated by new lines
+type ipcConn struct {
+	conn      net.Conn
+	logger    hclog.Logger
+	writeLock sync.Mutex
+}
+
+// WriteMessage writes out the message to the IPC client, the message type is ignored
+func (c *ipcConn) WriteMessage(_ int, data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err := c.conn.Write(append(data, '\n'))
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		c.logger.Error("Unable to write IPC message", "err", err)
+	}
+
+	return err
+}
+
+// Close closes the IPC connection, which stops its read loop
+func (c *ipcConn) Close() error {
+	return c.conn.Close()
+}
+
+// setupIPC starts serving the dispatcher over the IPC socket
+func (j *JSONRPC) setupIPC() error {
+	lis, err := ipc.Listen(j.config.IPCPath)
+	if err != nil {
+		return err
+	}
+
+	j.logger.Info("ipc server started", "path", j.config.IPCPath)
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				j.logger.Error("closed ipc listener", "err", err)
+
+				return
+			}
+
+			go j.handleIPC(conn)
+		}
+	}()
+
+	return nil
+}
+
+// handleIPC reads the requests of an IPC client until the connection is closed.
+// Closing the connection also drops its subscriptions, as their writes start failing
+func (j *JSONRPC) handleIPC(conn net.Conn) {
+	defer conn.Close()
+
+	wrapConn := &ipcConn{conn: conn, logger: j.logger}
+	decoder := json.NewDecoder(conn)
+
+	for {
+		var message json.RawMessage
+		if err := decoder.Decode(&message); err != nil {
+			if !errors.Is(err, io.EOF) {
+				// the stream cannot be recovered after a malformed message
+				j.logger.Error("Unable to read IPC message", "err", err)
+
+				resp, _ := NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
+				_ = wrapConn.WriteMessage(0, resp)
+			}
+
+			return
+		}
+
+		go func() {
+			if resp := j.handleIPCMessage(message, wrapConn); resp != nil {
+				_ = wrapConn.WriteMessage(0, resp)
+			}
+		}()
+	}
+}
+
+// handleIPCMessage handles a single request or a batch of requests
+func (j *JSONRPC) handleIPCMessage(message []byte, conn *ipcConn) []byte {
+	var (
+		resp []byte
+		err  error
+	)
+
+	if bytes.HasPrefix(bytes.TrimLeft(message, " \t\r\n"), []byte("[")) {
+		resp, err = j.dispatcher.Handle(message)
+	} else {
+		// single requests can subscribe to events, which are written to the connection
+		resp, err = j.dispatcher.HandleWs(message, conn)
+	}
+
+	if err != nil {
+		j.logger.Error("Unable to handle IPC request", "err", err)
+
+		resp, _ = NewRPCResponse(nil, "2.0", nil, NewInternalError(err.Error())).Bytes()
+	}
+
+	return resp
+}
@@ -0,0 +1,84 @@
+//go:build !windows
+// +build !windows
+
+package jsonrpc
+
+import (
+	"bufio"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/helper/ratelimit"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPCServer(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		Store:   newMockStore(),
+		IPCPath: filepath.Join(t.TempDir(), "edge.ipc"),
+	}
+
+	j := &JSONRPC{
+		logger:      hclog.NewNullLogger(),
+		config:      config,
+		dispatcher:  newDispatcher(hclog.NewNullLogger(), config.Store, &dispatcherParams{}),
+		rateLimiter: ratelimit.NewLimiter(),
+	}
+
+	assert.NoError(t, j.setupIPC())
+
+	conn, err := ipc.Dial(config.IPCPath)
+	assert.NoError(t, err)
+
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	request := func(req string) []byte {
+		_, err := conn.Write([]byte(req))
+		assert.NoError(t, err)
+
+		resp, err := reader.ReadBytes('\n')
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	// single request
+	var version string
+
+	assert.NoError(t, expectJSONResult(
+		request(`{"id":1,"jsonrpc":"2.0","method":"web3_clientVersion","params":[]}`),
+		&version,
+	))
+	assert.NotEmpty(t, version)
+
+	// batch request, the requests don't need to be separated by new lines
+	var batch []SuccessResponse
+
+	assert.NoError(t, json.Unmarshal(request(`[
+		{"id":1,"jsonrpc":"2.0","method":"web3_clientVersion","params":[]},
+		{"id":2,"jsonrpc":"2.0","method":"eth_chainId","params":[]}
+	]`), &batch))
+	assert.Len(t, batch, 2)
+
+	// subscriptions are supported
+	var subscriptionID string
+
+	assert.NoError(t, expectJSONResult(
+		request(`{"id":3,"jsonrpc":"2.0","method":"eth_subscribe","params":["newHeads"]}`),
+		&subscriptionID,
+	))
+	assert.NotEmpty(t, subscriptionID)
+
+	// the listener replaces the socket left behind by a previous run
+	lis, err := ipc.Listen(config.IPCPath)
+	if assert.NoError(t, err) {
+		lis.Close()
+	}
+}
@@ -1,16 +1,47 @@
 package jsonrpc
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"sync"
 
+	"github.com/0xPolygon/polygon-edge/helper/ratelimit"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
 )
 
+const (
+	// DefaultMaxRequestBodySize is the default maximum size of a request body in bytes
+	DefaultMaxRequestBodySize = uint64(5 * 1024 * 1024)
+
+	// DefaultBatchLengthLimit is the default maximum number of requests in a batch.
+	// It is unlimited, so the clients batching many requests keep working after an upgrade
+	DefaultBatchLengthLimit = uint64(0)
+
+	// RecommendedBatchLengthLimit is the maximum number of requests in a batch recommended for public nodes
+	RecommendedBatchLengthLimit = uint64(20)
+
+	// DefaultGasCap is the default maximum gas of the calls and the gas estimations
+	DefaultGasCap = uint64(50_000_000)
+
+	// apiKeyHeader is the HTTP header carrying the API key of the client,
+	// it can also be passed with the apiKeyQueryParam query parameter
+	apiKeyHeader     = "X-API-Key"
+	apiKeyQueryParam = "apikey"
+)
+
+var (
+	// DefaultPrivilegedNamespaces are the namespaces served only by the admin listener, once enabled
+	DefaultPrivilegedNamespaces = []string{"txpool", "debug"}
+
+	errInvalidAPIKey = errors.New("invalid API key")
+)
+
 type serverType int
 
 const (
@@ -34,9 +65,14 @@ func (s serverType) String() string {
 
 // JSONRPC is an API backend
 type JSONRPC struct {
-	logger     hclog.Logger
-	config     *Config
-	dispatcher dispatcher
+	logger      hclog.Logger
+	config      *Config
+	dispatcher  dispatcher
+	rateLimiter *ratelimit.Limiter
+	// authenticator checks the credentials of the requests, nil if the listener is public
+	authenticator *authenticator
+	// graphQL serves the GraphQL queries, nil if disabled
+	graphQL *GraphQL
 }
 
 type dispatcher interface {
@@ -51,6 +87,8 @@ type JSONRPCStore interface {
 	networkStore
 	txPoolStore
 	filterManagerStore
+	debugStore
+	ibftStore
 }
 
 type Config struct {
@@ -58,14 +96,70 @@ type Config struct {
 	Addr                     *net.TCPAddr
 	ChainID                  uint64
 	AccessControlAllowOrigin []string
+
+	// MaxRequestBodySize is the maximum size of a request in bytes (0 means unlimited)
+	MaxRequestBodySize uint64
+	// BatchLengthLimit is the maximum number of requests in a batch (0 means unlimited)
+	BatchLengthLimit uint64
+	// RateLimit is the number of requests per second accepted from a single IP (0 means unlimited)
+	RateLimit uint64
+	// APIKeys are the accepted API keys along with their own number of requests
+	// per second (0 means unlimited), which replaces the IP rate limit
+	APIKeys map[string]uint64
+	// MethodFilters are the methods that can be called, by namespace
+	MethodFilters map[string]*MethodFilter
+	// LogQueryLimits are the limits of the log queries
+	LogQueryLimits LogQueryLimits
+	// SubscriptionQueue configures the notifications queued for each subscription connection
+	SubscriptionQueue SubscriptionQueueConfig
+	// GasCap is the maximum gas of the calls and the gas estimations (0 means unlimited)
+	GasCap uint64
+
+	// TLSCertFile and TLSKeyFile are the certificate and key served over TLS, plain HTTP if empty
+	TLSCertFile string
+	TLSKeyFile  string
+	// Auth are the credentials required by the listener, nil if it is public
+	Auth *AuthConfig
+	// IPCPath is the path of the IPC endpoint, disabled if empty
+	IPCPath string
+	// GraphQL enables the GraphQL endpoint on the /graphql path
+	GraphQL bool
+	// Debug serves the debug namespace, whose methods re-execute transactions
+	Debug bool
 }
 
 // NewJSONRPC returns the JSONRPC http server
 func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
+	d := newDispatcher(logger, config.Store, &dispatcherParams{
+		chainID:           config.ChainID,
+		batchLengthLimit:  config.BatchLengthLimit,
+		methodFilters:     config.MethodFilters,
+		logQueryLimits:    config.LogQueryLimits,
+		subscriptionQueue: config.SubscriptionQueue,
+		gasCap:            config.GasCap,
+		debug:             config.Debug,
+	})
+
 	srv := &JSONRPC{
-		logger:     logger.Named("jsonrpc"),
-		config:     config,
-		dispatcher: newDispatcher(logger, config.Store, config.ChainID),
+		logger:      logger.Named("jsonrpc"),
+		config:      config,
+		dispatcher:  d,
+		rateLimiter: ratelimit.NewLimiter(),
+	}
+
+	if config.GraphQL {
+		// the GraphQL queries share the eth endpoint, so they are served with the same limits and filters
+		srv.graphQL = newGraphQL(
+			logger,
+			config.Store,
+			d.endpoints.Eth,
+			config.LogQueryLimits.BlockRange,
+			d.isMethodAllowed,
+		)
+	}
+
+	if config.Auth != nil {
+		srv.authenticator = newAuthenticator(config.Auth)
 	}
 
 	// start http server
@@ -73,18 +167,46 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		return nil, err
 	}
 
+	// start ipc server
+	if config.IPCPath != "" {
+		if err := srv.setupIPC(); err != nil {
+			return nil, err
+		}
+	}
+
 	return srv, nil
 }
 
 func (j *JSONRPC) setupHTTP() error {
-	j.logger.Info("http server started", "addr", j.config.Addr.String())
+	j.logger.Info(
+		"http server started",
+		"addr", j.config.Addr.String(),
+		"tls", j.config.TLSCertFile != "",
+		"auth", j.authenticator != nil,
+	)
+
+	srv := http.Server{}
+
+	if j.config.TLSCertFile != "" {
+		// load the certificate upfront, so a misconfiguration stops the node from starting
+		cert, err := tls.LoadX509KeyPair(j.config.TLSCertFile, j.config.TLSKeyFile)
+		if err != nil {
+			return fmt.Errorf("unable to load the TLS certificate, %w", err)
+		}
+
+		srv.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
 
 	lis, err := net.Listen("tcp", j.config.Addr.String())
 	if err != nil {
 		return err
 	}
 
-	mux := http.DefaultServeMux
+	// every listener has its own mux, as there can be more than one
+	mux := http.NewServeMux()
 
 	// The middleware factory returns a handler, so we need to wrap the handler function properly.
 	jsonRPCHandler := http.HandlerFunc(j.handle)
@@ -92,12 +214,22 @@ func (j *JSONRPC) setupHTTP() error {
 
 	mux.HandleFunc("/ws", j.handleWs)
 
-	srv := http.Server{
-		Handler: mux,
+	if j.graphQL != nil {
+		mux.Handle("/graphql", middlewareFactory(j.config)(http.HandlerFunc(j.handleGraphQL)))
 	}
 
+	srv.Handler = mux
+
 	go func() {
-		if err := srv.Serve(lis); err != nil {
+		var err error
+
+		if srv.TLSConfig != nil {
+			err = srv.ServeTLS(lis, "", "")
+		} else {
+			err = srv.Serve(lis)
+		}
+
+		if err != nil {
 			j.logger.Error("closed http connection", "err", err)
 		}
 	}()
@@ -129,6 +261,47 @@ func middlewareFactory(config *Config) func(http.Handler) http.Handler {
 	}
 }
 
+// authenticate checks the credentials of the request, if the listener requires them
+func (j *JSONRPC) authenticate(req *http.Request) error {
+	if j.authenticator == nil {
+		return nil
+	}
+
+	return j.authenticator.authenticate(req)
+}
+
+// getClient returns the identifier used for rate limiting the client
+// along with its rate limit. Clients with an API key are limited by key, the others by IP
+func (j *JSONRPC) getClient(req *http.Request) (string, uint64, error) {
+	apiKey := req.Header.Get(apiKeyHeader)
+	if apiKey == "" {
+		apiKey = req.URL.Query().Get(apiKeyQueryParam)
+	}
+
+	if apiKey != "" {
+		limit, ok := j.config.APIKeys[apiKey]
+		if !ok {
+			return "", 0, errInvalidAPIKey
+		}
+
+		return "key:" + apiKey, limit, nil
+	}
+
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+
+	return "ip:" + ip, j.config.RateLimit, nil
+}
+
+// rateLimitExceededResponse is the response sent to the clients over their rate limit
+func rateLimitExceededResponse() []byte {
+	resp, _ := NewRPCResponse(nil, "2.0", nil, NewLimitExceededError("rate limit exceeded")).Bytes()
+
+	return resp
+}
+
 // wsUpgrader defines upgrade parameters for the WS connection
 var wsUpgrader = websocket.Upgrader{
 	// Uses the default HTTP buffer sizes for Read / Write buffers.
@@ -161,6 +334,11 @@ func (w *wsWrapper) WriteMessage(messageType int, data []byte) error {
 	return writeErr
 }
 
+// Close closes the WS connection, which stops its listen loop
+func (w *wsWrapper) Close() error {
+	return w.ws.Close()
+}
+
 // isSupportedWSType returns a status indicating if the message type is supported
 func isSupportedWSType(messageType int) bool {
 	return messageType == websocket.TextMessage ||
@@ -168,6 +346,19 @@ func isSupportedWSType(messageType int) bool {
 }
 
 func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
+	if err := j.authenticate(req); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	client, rateLimit, err := j.getClient(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
 	// CORS rule - Allow requests from anywhere
 	wsUpgrader.CheckOrigin = func(r *http.Request) bool { return true }
 
@@ -189,6 +380,11 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 		}
 	}(ws)
 
+	if j.config.MaxRequestBodySize != 0 {
+		// messages over the limit close the connection
+		ws.SetReadLimit(int64(j.config.MaxRequestBodySize))
+	}
+
 	wrapConn := &wsWrapper{ws: ws, logger: j.logger}
 
 	j.logger.Info("Websocket connection established")
@@ -213,6 +409,12 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 		}
 
 		if isSupportedWSType(msgType) {
+			if !j.rateLimiter.Allow(client, rateLimit) {
+				_ = wrapConn.WriteMessage(msgType, rateLimitExceededResponse())
+
+				continue
+			}
+
 			go func() {
 				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn)
 				if handleErr != nil {
@@ -222,7 +424,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 						msgType,
 						[]byte(fmt.Sprintf("WS Handle error: %s", handleErr.Error())),
 					)
-				} else {
+				} else if resp != nil {
 					_ = wrapConn.WriteMessage(msgType, resp)
 				}
 			}()
@@ -256,12 +458,8 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	data, err := ioutil.ReadAll(req.Body)
-
-	if err != nil {
-		//nolint
-		w.Write([]byte(err.Error()))
-
+	data, ok := j.readRequest(w, req, rateLimitExceededResponse())
+	if !ok {
 		return
 	}
 
@@ -280,3 +478,88 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 
 	j.logger.Debug("handle", "response", string(resp))
 }
+
+// readRequest authenticates and rate limits the request before reading its body,
+// the rejected requests are answered and reported as not ok
+func (j *JSONRPC) readRequest(w http.ResponseWriter, req *http.Request, limitExceeded []byte) ([]byte, bool) {
+	if err := j.authenticate(req); err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		//nolint
+		w.Write([]byte(err.Error()))
+
+		return nil, false
+	}
+
+	client, rateLimit, err := j.getClient(req)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		//nolint
+		w.Write([]byte(err.Error()))
+
+		return nil, false
+	}
+
+	if !j.rateLimiter.Allow(client, rateLimit) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		//nolint
+		w.Write(limitExceeded)
+
+		return nil, false
+	}
+
+	body := io.Reader(req.Body)
+	if j.config.MaxRequestBodySize != 0 {
+		// read one byte over the limit to detect the oversized requests
+		body = io.LimitReader(req.Body, int64(j.config.MaxRequestBodySize)+1)
+	}
+
+	data, err := ioutil.ReadAll(body)
+
+	if err != nil {
+		//nolint
+		w.Write([]byte(err.Error()))
+
+		return nil, false
+	}
+
+	if j.config.MaxRequestBodySize != 0 && uint64(len(data)) > j.config.MaxRequestBodySize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		//nolint
+		w.Write([]byte(fmt.Sprintf("request body exceeds the limit of %d bytes", j.config.MaxRequestBodySize)))
+
+		return nil, false
+	}
+
+	return data, true
+}
+
+func (j *JSONRPC) handleGraphQL(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set(
+		"Access-Control-Allow-Headers",
+		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
+	)
+
+	if req.Method == "OPTIONS" {
+		return
+	}
+
+	if req.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		//nolint
+		w.Write(graphQLErrorResponse("method " + req.Method + " not allowed"))
+
+		return
+	}
+
+	data, ok := j.readRequest(w, req, graphQLErrorResponse("rate limit exceeded"))
+	if !ok {
+		return
+	}
+
+	j.logger.Debug("handle graphql", "request", string(data))
+
+	//nolint
+	w.Write(j.graphQL.Handle(req.Context(), data))
+}
@@ -1,11 +1,16 @@
 package jsonrpc
 
 import (
+	"github.com/0xPolygon/polygon-edge/helper/ratelimit"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestHTTPServer(t *testing.T) {
@@ -26,3 +31,115 @@ func TestHTTPServer(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHTTPServer_Limits(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		Store:              newMockStore(),
+		MaxRequestBodySize: 100,
+		RateLimit:          2,
+		APIKeys: map[string]uint64{
+			"unlimited": 0,
+		},
+	}
+
+	j := &JSONRPC{
+		logger:      hclog.NewNullLogger(),
+		config:      config,
+		dispatcher:  newDispatcher(hclog.NewNullLogger(), config.Store, &dispatcherParams{}),
+		rateLimiter: ratelimit.NewLimiter(),
+	}
+
+	request := `{"id":1,"jsonrpc":"2.0","method":"web3_clientVersion","params":[]}`
+
+	send := func(remoteAddr, apiKey, body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = remoteAddr
+
+		if apiKey != "" {
+			req.Header.Set(apiKeyHeader, apiKey)
+		}
+
+		rec := httptest.NewRecorder()
+		j.handle(rec, req)
+
+		return rec.Code
+	}
+
+	// requests over the size limit are rejected
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send("10.0.0.1:1000", "", request+strings.Repeat(" ", 100)))
+
+	// requests are rate limited by IP, regardless of the port
+	assert.Equal(t, http.StatusOK, send("10.0.0.2:1000", "", request))
+	assert.Equal(t, http.StatusOK, send("10.0.0.2:1001", "", request))
+	assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.2:1002", "", request))
+	assert.Equal(t, http.StatusOK, send("10.0.0.3:1000", "", request))
+
+	// API keys replace the IP rate limit
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, http.StatusOK, send("10.0.0.2:1000", "unlimited", request))
+	}
+
+	// unknown API keys are rejected
+	assert.Equal(t, http.StatusUnauthorized, send("10.0.0.4:1000", "unknown", request))
+}
+
+func TestHTTPServer_Auth(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		Store: newMockStore(),
+		Auth: &AuthConfig{
+			BearerToken: "token",
+		},
+	}
+
+	j := &JSONRPC{
+		logger:        hclog.NewNullLogger(),
+		config:        config,
+		dispatcher:    newDispatcher(hclog.NewNullLogger(), config.Store, &dispatcherParams{}),
+		rateLimiter:   ratelimit.NewLimiter(),
+		authenticator: newAuthenticator(config.Auth),
+	}
+
+	send := func(token string) int {
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/",
+			strings.NewReader(`{"id":1,"jsonrpc":"2.0","method":"web3_clientVersion","params":[]}`),
+		)
+
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		j.handle(rec, req)
+
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, send("token"))
+	assert.Equal(t, http.StatusUnauthorized, send("wrong"))
+	assert.Equal(t, http.StatusUnauthorized, send(""))
+}
+
+func TestHTTPServer_InvalidTLSCertificate(t *testing.T) {
+	t.Parallel()
+
+	port, portErr := tests.GetFreePort()
+	if portErr != nil {
+		t.Fatalf("Unable to fetch free port, %v", portErr)
+	}
+
+	config := &Config{
+		Store:       newMockStore(),
+		Addr:        &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		TLSCertFile: "missing.crt",
+		TLSKeyFile:  "missing.key",
+	}
+
+	_, err := NewJSONRPC(hclog.NewNullLogger(), config)
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package jsonrpc
+
+// allMethods matches every method of a namespace in a method filter
+const allMethods = "*"
+
+// MethodFilter restricts the methods of a namespace that can be called.
+// Methods are listed without the namespace prefix (e.g. "sendRawTransaction")
+type MethodFilter struct {
+	// Allow are the only methods that can be called, all of them if empty
+	Allow []string
+	// Deny are the methods that cannot be called, it takes precedence over Allow
+	Deny []string
+}
+
+// isAllowed checks if the method of the namespace passes the filter
+func (f *MethodFilter) isAllowed(method string) bool {
+	if containsMethod(f.Deny, method) {
+		return false
+	}
+
+	return len(f.Allow) == 0 || containsMethod(f.Allow, method)
+}
+
+func containsMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == allMethods || m == method {
+			return true
+		}
+	}
+
+	return false
+}
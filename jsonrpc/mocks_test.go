@@ -3,6 +3,7 @@ package jsonrpc
 import (
 	"errors"
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"math/big"
@@ -52,6 +53,9 @@ type mockStore struct {
 	receiptsLock sync.Mutex
 	receipts     map[types.Hash][]*types.Receipt
 	accounts     map[types.Address]*state.Account
+
+	progressionLock sync.Mutex
+	progression     *progress.Progression
 }
 
 func newMockStore() *mockStore {
@@ -122,6 +126,33 @@ func (m *mockStore) GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
 	return nil, false
 }
 
+func (m *mockStore) LogIndexTail() uint64 {
+	// nothing is indexed
+	return m.header.Number + 1
+}
+
+func (m *mockStore) MatchLogBlocks(
+	from, to uint64,
+	addresses []types.Address,
+	topics [][]types.Hash,
+) ([]uint64, error) {
+	return []uint64{}, nil
+}
+
+func (m *mockStore) GetSyncProgression() *progress.Progression {
+	m.progressionLock.Lock()
+	defer m.progressionLock.Unlock()
+
+	return m.progression
+}
+
+func (m *mockStore) setSyncProgression(progression *progress.Progression) {
+	m.progressionLock.Lock()
+	defer m.progressionLock.Unlock()
+
+	m.progression = progression
+}
+
 func (m *mockStore) GetTxs(inclQueued bool) (
 	map[types.Address][]*types.Transaction,
 	map[types.Address][]*types.Transaction,
@@ -0,0 +1,169 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/go-hclog"
+)
+
+// OverflowPolicy is the action taken when the notifications of a connection
+// don't fit in its send queue, because the client reads them too slowly
+type OverflowPolicy string
+
+const (
+	// OverflowDrop drops the notifications until the queue has room again
+	OverflowDrop OverflowPolicy = "drop"
+
+	// OverflowDisconnect closes the connection of the slow client
+	OverflowDisconnect OverflowPolicy = "disconnect"
+)
+
+// DefaultSubscriptionQueueSize is the default number of notifications queued for a connection
+const DefaultSubscriptionQueueSize = uint64(1024)
+
+var (
+	ErrSubscriptionQueueFull = errors.New("subscription send queue is full")
+)
+
+// SubscriptionQueueConfig configures the send queues of the subscription connections
+type SubscriptionQueueConfig struct {
+	// Size is the number of notifications queued for a connection (0 means the default)
+	Size uint64
+	// Policy is the action taken when the queue is full (empty means drop)
+	Policy OverflowPolicy
+}
+
+// ParseOverflowPolicy validates the name of an overflow policy
+func ParseOverflowPolicy(name string) (OverflowPolicy, error) {
+	switch policy := OverflowPolicy(name); policy {
+	case OverflowDrop, OverflowDisconnect:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown subscription overflow policy %q", name)
+	}
+}
+
+// sendQueue writes the notifications of the subscriptions of a connection in the background,
+// so a slow client doesn't hold up the notifications of the others
+type sendQueue struct {
+	conn   wsConn
+	logger hclog.Logger
+	policy OverflowPolicy
+
+	msgCh   chan []byte
+	closeCh chan struct{}
+
+	// onFailure is called once the connection can't be written anymore
+	onFailure func()
+
+	lock     sync.Mutex
+	err      error
+	dropping bool
+
+	// refs is the number of filters using the queue, guarded by the filter manager lock
+	refs int
+}
+
+func newSendQueue(
+	conn wsConn,
+	logger hclog.Logger,
+	config SubscriptionQueueConfig,
+	onFailure func(),
+) *sendQueue {
+	size := config.Size
+	if size == 0 {
+		size = DefaultSubscriptionQueueSize
+	}
+
+	policy := config.Policy
+	if policy == "" {
+		policy = OverflowDrop
+	}
+
+	q := &sendQueue{
+		conn:      conn,
+		logger:    logger,
+		policy:    policy,
+		msgCh:     make(chan []byte, size),
+		closeCh:   make(chan struct{}),
+		onFailure: onFailure,
+	}
+
+	go q.run()
+
+	return q
+}
+
+// WriteMessage queues the notification, the message type is always text
+func (q *sendQueue) WriteMessage(_ int, data []byte) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.err != nil {
+		return q.err
+	}
+
+	select {
+	case q.msgCh <- data:
+		if q.dropping {
+			q.dropping = false
+
+			q.logger.Info("Subscription connection caught up, resuming notifications")
+		}
+
+		return nil
+	default:
+	}
+
+	if q.policy == OverflowDisconnect {
+		q.err = ErrSubscriptionQueueFull
+
+		q.logger.Warn("Closing slow subscription connection")
+
+		if err := q.conn.Close(); err != nil {
+			q.logger.Error("Unable to close subscription connection", "err", err)
+		}
+
+		return q.err
+	}
+
+	if !q.dropping {
+		q.dropping = true
+
+		q.logger.Warn("Subscription connection is too slow, dropping notifications")
+	}
+
+	return nil
+}
+
+// Close stops the queue, the connection itself is closed by its transport
+func (q *sendQueue) Close() error {
+	close(q.closeCh)
+
+	return nil
+}
+
+// run writes the queued notifications until the queue is closed or the connection fails
+func (q *sendQueue) run() {
+	for {
+		select {
+		case data := <-q.msgCh:
+			if err := q.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				q.lock.Lock()
+				if q.err == nil {
+					q.err = err
+				}
+				q.lock.Unlock()
+
+				q.onFailure()
+
+				return
+			}
+		case <-q.closeCh:
+			return
+		}
+	}
+}
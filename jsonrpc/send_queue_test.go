@@ -0,0 +1,144 @@
+package jsonrpc
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingWsConn is a connection whose writes block until the messages are read
+type blockingWsConn struct {
+	msgCh  chan []byte
+	closed int32
+}
+
+func (m *blockingWsConn) WriteMessage(messageType int, b []byte) error {
+	m.msgCh <- b
+
+	return nil
+}
+
+func (m *blockingWsConn) Close() error {
+	atomic.StoreInt32(&m.closed, 1)
+
+	return nil
+}
+
+func (m *blockingWsConn) isClosed() bool {
+	return atomic.LoadInt32(&m.closed) == 1
+}
+
+func readMessages(t *testing.T, msgCh chan []byte, count int) []string {
+	t.Helper()
+
+	msgs := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		select {
+		case msg := <-msgCh:
+			msgs = append(msgs, string(msg))
+		case <-time.After(2 * time.Second):
+			t.Fatalf("message %d not received in 2 seconds", i)
+		}
+	}
+
+	return msgs
+}
+
+// fillSendQueue makes the queue of a single message overflow,
+// one message is being written while another one is queued
+func fillSendQueue(t *testing.T, q *sendQueue) {
+	t.Helper()
+
+	assert.NoError(t, q.WriteMessage(0, []byte("1")))
+
+	// wait for the first message to be taken by the writer
+	assert.Eventually(t, func() bool {
+		return len(q.msgCh) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, q.WriteMessage(0, []byte("2")))
+}
+
+func TestSendQueue_Drop(t *testing.T) {
+	t.Parallel()
+
+	conn := &blockingWsConn{msgCh: make(chan []byte)}
+	failed := make(chan struct{})
+
+	q := newSendQueue(conn, hclog.NewNullLogger(), SubscriptionQueueConfig{Size: 1, Policy: OverflowDrop}, func() {
+		close(failed)
+	})
+	defer q.Close()
+
+	fillSendQueue(t, q)
+
+	// the message is dropped and the connection is kept
+	assert.NoError(t, q.WriteMessage(0, []byte("3")))
+	assert.False(t, conn.isClosed())
+
+	assert.Equal(t, []string{"1", "2"}, readMessages(t, conn.msgCh, 2))
+
+	// the notifications resume once the client caught up
+	assert.NoError(t, q.WriteMessage(0, []byte("4")))
+	assert.Equal(t, []string{"4"}, readMessages(t, conn.msgCh, 1))
+
+	select {
+	case <-failed:
+		t.Fatal("the queue should not fail")
+	default:
+	}
+}
+
+func TestSendQueue_Disconnect(t *testing.T) {
+	t.Parallel()
+
+	conn := &blockingWsConn{msgCh: make(chan []byte)}
+
+	q := newSendQueue(conn, hclog.NewNullLogger(), SubscriptionQueueConfig{Size: 1, Policy: OverflowDisconnect}, func() {})
+	defer q.Close()
+
+	fillSendQueue(t, q)
+
+	// the slow connection is closed
+	assert.ErrorIs(t, q.WriteMessage(0, []byte("3")), ErrSubscriptionQueueFull)
+	assert.True(t, conn.isClosed())
+
+	// and no other message is accepted
+	assert.ErrorIs(t, q.WriteMessage(0, []byte("4")), ErrSubscriptionQueueFull)
+}
+
+func TestSendQueue_WriteFailure(t *testing.T) {
+	t.Parallel()
+
+	failed := make(chan struct{})
+
+	q := newSendQueue(&MockClosedWSConnection{}, hclog.NewNullLogger(), SubscriptionQueueConfig{}, func() {
+		close(failed)
+	})
+	defer q.Close()
+
+	assert.NoError(t, q.WriteMessage(0, []byte("1")))
+
+	select {
+	case <-failed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the queue did not fail in 2 seconds")
+	}
+
+	assert.Error(t, q.WriteMessage(0, []byte("2")))
+}
+
+func TestParseOverflowPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := ParseOverflowPolicy("disconnect")
+	assert.NoError(t, err)
+	assert.Equal(t, OverflowDisconnect, policy)
+
+	_, err = ParseOverflowPolicy("block")
+	assert.Error(t, err)
+}
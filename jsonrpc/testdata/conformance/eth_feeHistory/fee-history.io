@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_feeHistory","params":["0x2","latest",[25,75]]}
+<< {"jsonrpc":"2.0","id":1,"result":{"oldestBlock":"0x0","baseFeePerGas":["0x3e8","0x3e8","0x3e8"],"gasUsedRatio":[0,0.0015],"reward":[["0x0","0x0"],["0x1f4","0x3e8"]]}}
@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getBlockReceipts","params":[{"blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d"}]}
+<< {"jsonrpc":"2.0","id":1,"result":[{"type":"0x0","root":"0x0000000000000000000000000000000000000000000000000000000000000000","cumulativeGasUsed":"0x5208","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","logs":[],"status":"0x1","transactionHash":"0x473178ee9512d8b81e5a7b2040587c2f36331e0009c8e4cf6caff3c35edcfb7f","transactionIndex":"0x0","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","blockNumber":"0x1","gasUsed":"0x5208","contractAddress":null,"from":"0x1000000000000000000000000000000000000001","to":"0x2000000000000000000000000000000000000002","effectiveGasPrice":"0x7d0"},{"type":"0x2","root":"0x0000000000000000000000000000000000000000000000000000000000000000","cumulativeGasUsed":"0xafc8","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","logs":[{"address":"0x2000000000000000000000000000000000000002","topics":["0x0000000000000000000000000000000000000000000000000000000000000000"],"data":"0x01","blockNumber":"0x1","transactionHash":"0x79dd3997ed116c4aa49087316208527d97a87693f756103b894f9829b798439b","transactionIndex":"0x1","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","logIndex":"0x0","removed":false},{"address":"0x2000000000000000000000000000000000000002","topics":[],"data":"0x02","blockNumber":"0x1","transactionHash":"0x79dd3997ed116c4aa49087316208527d97a87693f756103b894f9829b798439b","transactionIndex":"0x1","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","logIndex":"0x1","removed":false}],"status":"0x1","transactionHash":"0x79dd3997ed116c4aa49087316208527d97a87693f756103b894f9829b798439b","transactionIndex":"0x1","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","blockNumber":"0x1","gasUsed":"0x5dc0","contractAddress":null,"from":"0x1000000000000000000000000000000000000001","to":"0x2000000000000000000000000000000000000002","effectiveGasPrice":"0x5dc"}]}
@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getBlockTransactionCountByHash","params":["0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d"]}
+<< {"jsonrpc":"2.0","id":1,"result":"0x2"}
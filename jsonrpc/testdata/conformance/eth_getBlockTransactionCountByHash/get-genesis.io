@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getBlockTransactionCountByHash","params":["0x3599281b2731c2e4d16f6c6293ad789a4d9d55326ca2274b780fc35b467a95c2"]}
+<< {"jsonrpc":"2.0","id":1,"result":"0x0"}
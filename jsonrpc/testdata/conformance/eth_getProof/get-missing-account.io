@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getProof","params":["0x3000000000000000000000000000000000000003",[],"latest"]}
+<< {"jsonrpc":"2.0","id":1,"result":{"address":"0x3000000000000000000000000000000000000003","accountProof":["0xf8518080a0ce0b52b1b2fbd2d3ea6adce4b00d68cb62a7a84f8672644d8424ac659a497e69a00d250a320440d5b3ea5d257f17615ed944bba2567818b70087331937458c2f2180808080808080808080808080","0xf871a03ed02be1e351ddbcc2bf3ffafc25fb42a533df024b33c85f9805e17b60f7230cb84ef84c02880de0b6b3a7640000a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a0c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"],"balance":"0x0","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","nonce":"0x0","storageHash":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","storageProof":[]}}
@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getProof","params":["0x2000000000000000000000000000000000000002",["0x0000000000000000000000000000000000000000000000000000000000000000","0x0000000000000000000000000000000000000000000000000000000000000001"],"latest"]}
+<< {"jsonrpc":"2.0","id":1,"result":{"address":"0x2000000000000000000000000000000000000002","accountProof":["0xf8518080a0ce0b52b1b2fbd2d3ea6adce4b00d68cb62a7a84f8672644d8424ac659a497e69a00d250a320440d5b3ea5d257f17615ed944bba2567818b70087331937458c2f2180808080808080808080808080","0xf869a035baa1f53460dfe937af66419cef1b8dd5251c7daa1faf4061b53f21a5cd51e0b846f8440180a081d1fa699f807735499cf6f7df860797cf66f6a66b565cfcda3fae3521eb6861a0bc36789e7a1e281436464229828f817d6612f7b477d66591ff96a9e064bcc98a"],"balance":"0x0","codeHash":"0xbc36789e7a1e281436464229828f817d6612f7b477d66591ff96a9e064bcc98a","nonce":"0x1","storageHash":"0x81d1fa699f807735499cf6f7df860797cf66f6a66b565cfcda3fae3521eb6861","storageProof":[{"key":"0x0000000000000000000000000000000000000000000000000000000000000000","value":"0x2a","proof":["0xe3a120290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e5632a"]},{"key":"0x0000000000000000000000000000000000000000000000000000000000000001","value":"0x0","proof":["0xe3a120290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e5632a"]}]}}
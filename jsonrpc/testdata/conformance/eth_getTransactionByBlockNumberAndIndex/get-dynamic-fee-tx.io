@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByBlockNumberAndIndex","params":["latest","0x1"]}
+<< {"jsonrpc":"2.0","id":1,"result":{"type":"0x2","chainId":"0x64","nonce":"0x1","gasPrice":"0xbb8","gas":"0xc350","to":"0x2000000000000000000000000000000000000002","value":"0x0","input":"0x0102","v":"0x1","r":"0x3","s":"0x4","hash":"0x79dd3997ed116c4aa49087316208527d97a87693f756103b894f9829b798439b","from":"0x1000000000000000000000000000000000000001","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","blockNumber":"0x1","transactionIndex":"0x1","maxPriorityFeePerGas":"0x1f4","maxFeePerGas":"0xbb8"}}
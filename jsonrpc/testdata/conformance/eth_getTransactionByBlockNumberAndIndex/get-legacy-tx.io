@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByBlockNumberAndIndex","params":["0x1","0x0"]}
+<< {"jsonrpc":"2.0","id":1,"result":{"type":"0x0","nonce":"0x0","gasPrice":"0x7d0","gas":"0x5208","to":"0x2000000000000000000000000000000000000002","value":"0x1","input":"0x","v":"0x1b","r":"0x1","s":"0x2","hash":"0x473178ee9512d8b81e5a7b2040587c2f36331e0009c8e4cf6caff3c35edcfb7f","from":"0x1000000000000000000000000000000000000001","blockHash":"0x1d28f6c545ab139db7a5b145a03b3a6ec3b0aa4d40ed544360e7c915ec460b1d","blockNumber":"0x1","transactionIndex":"0x0"}}
@@ -0,0 +1,2 @@
+>> {"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByBlockNumberAndIndex","params":["0x1","0x2"]}
+<< {"jsonrpc":"2.0","id":1,"result":null}
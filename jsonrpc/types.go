@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -15,20 +16,25 @@ type transactionOrHash interface {
 }
 
 type transaction struct {
-	Nonce       argUint64      `json:"nonce"`
-	GasPrice    argBig         `json:"gasPrice"`
-	Gas         argUint64      `json:"gas"`
-	To          *types.Address `json:"to"`
-	Value       argBig         `json:"value"`
-	Input       argBytes       `json:"input"`
-	V           argBig         `json:"v"`
-	R           argBig         `json:"r"`
-	S           argBig         `json:"s"`
-	Hash        types.Hash     `json:"hash"`
-	From        types.Address  `json:"from"`
-	BlockHash   *types.Hash    `json:"blockHash"`
-	BlockNumber *argUint64     `json:"blockNumber"`
-	TxIndex     *argUint64     `json:"transactionIndex"`
+	Type        argUint64          `json:"type"`
+	ChainID     *argBig            `json:"chainId,omitempty"`
+	Nonce       argUint64          `json:"nonce"`
+	GasPrice    argBig             `json:"gasPrice"`
+	Gas         argUint64          `json:"gas"`
+	To          *types.Address     `json:"to"`
+	Value       argBig             `json:"value"`
+	Input       argBytes           `json:"input"`
+	V           argBig             `json:"v"`
+	R           argBig             `json:"r"`
+	S           argBig             `json:"s"`
+	Hash        types.Hash         `json:"hash"`
+	From        types.Address      `json:"from"`
+	BlockHash   *types.Hash        `json:"blockHash"`
+	BlockNumber *argUint64         `json:"blockNumber"`
+	TxIndex     *argUint64         `json:"transactionIndex"`
+	AccessList  types.TxAccessList `json:"accessList,omitempty"`
+	GasTipCap   *argBig            `json:"maxPriorityFeePerGas,omitempty"`
+	GasFeeCap   *argBig            `json:"maxFeePerGas,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -53,6 +59,7 @@ func toTransaction(
 	txIndex *int,
 ) *transaction {
 	res := &transaction{
+		Type:     argUint64(t.Type),
 		Nonce:    argUint64(t.Nonce),
 		GasPrice: argBig(*t.GasPrice),
 		Gas:      argUint64(t.Gas),
@@ -66,6 +73,23 @@ func toTransaction(
 		From:     t.From,
 	}
 
+	if t.IsTyped() {
+		if t.ChainID != nil {
+			res.ChainID = argBigPtr(t.ChainID)
+		}
+
+		// typed transactions always report the access list, even if empty
+		res.AccessList = t.AccessList
+		if res.AccessList == nil {
+			res.AccessList = types.TxAccessList{}
+		}
+	}
+
+	if t.IsDynamicFee() {
+		res.GasTipCap = argBigPtr(t.GasTipCap)
+		res.GasFeeCap = argBigPtr(t.GasPrice)
+	}
+
 	if blockNumber != nil {
 		res.BlockNumber = blockNumber
 	}
@@ -102,6 +126,7 @@ type block struct {
 	Hash            types.Hash          `json:"hash"`
 	Transactions    []transactionOrHash `json:"transactions"`
 	Uncles          []types.Hash        `json:"uncles"`
+	BaseFee         *argUint64          `json:"baseFeePerGas,omitempty"`
 }
 
 func toBlock(b *types.Block, fullTx bool) *block {
@@ -129,6 +154,10 @@ func toBlock(b *types.Block, fullTx bool) *block {
 		Uncles:          []types.Hash{},
 	}
 
+	if h.BaseFee != 0 {
+		res.BaseFee = argUintPtr(h.BaseFee)
+	}
+
 	for idx, txn := range b.Transactions {
 		if fullTx {
 			res.Transactions = append(
@@ -156,6 +185,7 @@ func toBlock(b *types.Block, fullTx bool) *block {
 }
 
 type receipt struct {
+	Type              argUint64      `json:"type"`
 	Root              types.Hash     `json:"root"`
 	CumulativeGasUsed argUint64      `json:"cumulativeGasUsed"`
 	LogsBloom         types.Bloom    `json:"logsBloom"`
@@ -169,6 +199,80 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	EffectiveGasPrice argBig         `json:"effectiveGasPrice"`
+}
+
+func toReceipt(
+	raw *types.Receipt,
+	txn *types.Transaction,
+	txIndex uint64,
+	block *types.Block,
+	logIndex uint64,
+) *receipt {
+	logs := make([]*Log, len(raw.Logs))
+	for indx, elem := range raw.Logs {
+		logs[indx] = &Log{
+			Address:     elem.Address,
+			Topics:      elem.Topics,
+			Data:        argBytes(elem.Data),
+			BlockHash:   block.Hash(),
+			BlockNumber: argUint64(block.Number()),
+			TxHash:      txn.Hash,
+			TxIndex:     argUint64(txIndex),
+			LogIndex:    argUint64(logIndex + uint64(indx)),
+			Removed:     false,
+		}
+	}
+
+	return &receipt{
+		Type:              argUint64(raw.TransactionType),
+		Root:              raw.Root,
+		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
+		LogsBloom:         raw.LogsBloom,
+		Status:            argUint64(*raw.Status),
+		TxHash:            txn.Hash,
+		TxIndex:           argUint64(txIndex),
+		BlockHash:         block.Hash(),
+		BlockNumber:       argUint64(block.Number()),
+		GasUsed:           argUint64(raw.GasUsed),
+		ContractAddress:   raw.ContractAddress,
+		FromAddr:          txn.From,
+		ToAddr:            txn.To,
+		Logs:              logs,
+		EffectiveGasPrice: argBig(*txn.EffectiveGasPrice(block.Header.BaseFee)),
+	}
+}
+
+type accountProof struct {
+	Address      types.Address   `json:"address"`
+	AccountProof []argBytes      `json:"accountProof"`
+	Balance      argBig          `json:"balance"`
+	CodeHash     types.Hash      `json:"codeHash"`
+	Nonce        argUint64       `json:"nonce"`
+	StorageHash  types.Hash      `json:"storageHash"`
+	StorageProof []*storageProof `json:"storageProof"`
+}
+
+type storageProof struct {
+	Key   types.Hash `json:"key"`
+	Value argBig     `json:"value"`
+	Proof []argBytes `json:"proof"`
+}
+
+func toProof(proof [][]byte) []argBytes {
+	res := make([]argBytes, len(proof))
+	for i, node := range proof {
+		res[i] = argBytes(node)
+	}
+
+	return res
+}
+
+type feeHistory struct {
+	OldestBlock  argUint64   `json:"oldestBlock"`
+	BaseFee      []argUint64 `json:"baseFeePerGas"`
+	GasUsedRatio []float64   `json:"gasUsedRatio"`
+	Reward       [][]argBig  `json:"reward,omitempty"`
 }
 
 type Log struct {
@@ -294,14 +398,106 @@ func encodeToHex(b []byte) []byte {
 
 // txnArgs is the transaction argument for the rpc endpoints
 type txnArgs struct {
-	From     *types.Address
-	To       *types.Address
-	Gas      *argUint64
-	GasPrice *argBytes
-	Value    *argBytes
-	Data     *argBytes
-	Input    *argBytes
-	Nonce    *argUint64
+	From       *types.Address
+	To         *types.Address
+	Gas        *argUint64
+	GasPrice   *argBytes
+	Value      *argBytes
+	Data       *argBytes
+	Input      *argBytes
+	Nonce      *argUint64
+	Type       *argUint64
+	AccessList *types.TxAccessList
+
+	// dynamic fee transactions (EIP-1559)
+	MaxFeePerGas         *argBytes
+	MaxPriorityFeePerGas *argBytes
+}
+
+// overrideAccount is the overridden state of an account in the calls, as accepted by geth
+type overrideAccount struct {
+	Nonce     *argUint64                 `json:"nonce"`
+	Code      *argBytes                  `json:"code"`
+	Balance   *argBig                    `json:"balance"`
+	State     *map[types.Hash]types.Hash `json:"state"`
+	StateDiff *map[types.Hash]types.Hash `json:"stateDiff"`
+}
+
+// stateOverride are the overridden accounts of a call, by address
+type stateOverride map[types.Address]overrideAccount
+
+// blockOverride is the overridden block context of a call
+type blockOverride struct {
+	Number     *argUint64     `json:"number"`
+	Difficulty *argUint64     `json:"difficulty"`
+	Time       *argUint64     `json:"time"`
+	GasLimit   *argUint64     `json:"gasLimit"`
+	Coinbase   *types.Address `json:"coinbase"`
+	BaseFee    *argUint64     `json:"baseFee"`
+}
+
+// toCallOverride converts the overrides of a call, nil if there are none
+func toCallOverride(state *stateOverride, block *blockOverride) *types.CallOverride {
+	if state == nil && block == nil {
+		return nil
+	}
+
+	override := &types.CallOverride{}
+
+	if state != nil {
+		override.State = make(types.StateOverride, len(*state))
+
+		for addr, account := range *state {
+			acc := types.OverrideAccount{}
+
+			if account.Nonce != nil {
+				nonce := uint64(*account.Nonce)
+				acc.Nonce = &nonce
+			}
+
+			if account.Code != nil {
+				// empty code removes the code of the account
+				acc.Code = append([]byte{}, *account.Code...)
+			}
+
+			if account.Balance != nil {
+				acc.Balance = new(big.Int).Set((*big.Int)(account.Balance))
+			}
+
+			if account.State != nil {
+				acc.State = *account.State
+			}
+
+			if account.StateDiff != nil {
+				acc.StateDiff = *account.StateDiff
+			}
+
+			override.State[addr] = acc
+		}
+	}
+
+	if block != nil {
+		uint64Ptr := func(v *argUint64) *uint64 {
+			if v == nil {
+				return nil
+			}
+
+			n := uint64(*v)
+
+			return &n
+		}
+
+		override.Block = &types.BlockOverride{
+			Number:     uint64Ptr(block.Number),
+			Difficulty: uint64Ptr(block.Difficulty),
+			Time:       uint64Ptr(block.Time),
+			GasLimit:   uint64Ptr(block.GasLimit),
+			Coinbase:   block.Coinbase,
+			BaseFee:    uint64Ptr(block.BaseFee),
+		}
+	}
+
+	return override
 }
 
 type progression struct {
@@ -310,3 +506,30 @@ type progression struct {
 	CurrentBlock  string `json:"currentBlock"`
 	HighestBlock  string `json:"highestBlock"`
 }
+
+func newProgression(p *progress.Progression) progression {
+	return progression{
+		Type:          string(p.SyncType),
+		StartingBlock: hex.EncodeUint64(p.StartingBlock),
+		CurrentBlock:  hex.EncodeUint64(p.CurrentBlock),
+		HighestBlock:  hex.EncodeUint64(p.HighestBlock),
+	}
+}
+
+// syncingResult is the notification of the syncing subscriptions while the node is syncing
+type syncingResult struct {
+	Syncing bool        `json:"syncing"`
+	Status  progression `json:"status"`
+}
+
+// newSyncingResult returns the notification of the sync progression, false if the node isn't syncing
+func newSyncingResult(p *progress.Progression) interface{} {
+	if p == nil {
+		return false
+	}
+
+	return &syncingResult{
+		Syncing: true,
+		Status:  newProgression(p),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"google.golang.org/protobuf/proto"
 )
@@ -42,7 +43,9 @@ func (t *Topic) Publish(obj proto.Message) error {
 	return t.topic.Publish(context.Background(), data)
 }
 
-func (t *Topic) Subscribe(handler func(obj interface{})) error {
+// Subscribe starts reading the topic messages. The handler receives the decoded message
+// along with the ID of the peer the message was received from
+func (t *Topic) Subscribe(handler func(obj interface{}, from peer.ID)) error {
 	sub, err := t.topic.Subscribe(pubsub.WithBufferSize(subscribeOutputBufferSize))
 	if err != nil {
 		return err
@@ -53,7 +56,7 @@ func (t *Topic) Subscribe(handler func(obj interface{})) error {
 	return nil
 }
 
-func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{})) {
+func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, from peer.ID)) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 
 	go func() {
@@ -77,7 +80,7 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{})
 				return
 			}
 
-			handler(obj)
+			handler(obj, msg.ReceivedFrom)
 		}()
 	}
 }
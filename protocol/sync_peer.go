@@ -73,6 +73,16 @@ type SyncPeer struct {
 	enqueueCh   chan struct{}
 }
 
+// NewSyncPeer creates a sync peer with a known status that is not backed by a connection,
+// for the syncers that serve the blocks from memory
+func NewSyncPeer(id peer.ID, status *Status) *SyncPeer {
+	return &SyncPeer{
+		peer:      id,
+		status:    status,
+		enqueueCh: make(chan struct{}),
+	}
+}
+
 // Number returns the latest peer block height
 func (s *SyncPeer) Number() uint64 {
 	s.statusLock.RLock()
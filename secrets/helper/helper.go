@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/crypto/bls"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -97,6 +98,24 @@ func InitValidatorKey(secretsManager secrets.SecretsManager) (*ecdsa.PrivateKey,
 	return validatorKey, nil
 }
 
+func InitValidatorBLSKey(secretsManager secrets.SecretsManager) (*bls.SecretKey, error) {
+	// Generate the BLS secret key of the aggregated committed seals
+	blsKey, blsKeyEncoded, keyErr := crypto.GenerateAndEncodeBLSSecretKey()
+	if keyErr != nil {
+		return nil, keyErr
+	}
+
+	// Write the BLS secret key to the secrets manager storage
+	if setErr := secretsManager.SetSecret(
+		secrets.ValidatorBLSKey,
+		blsKeyEncoded,
+	); setErr != nil {
+		return nil, setErr
+	}
+
+	return blsKey, nil
+}
+
 func InitNetworkingPrivateKey(secretsManager secrets.SecretsManager) (libp2pCrypto.PrivKey, error) {
 	// Generate the libp2p private key
 	libp2pKey, libp2pKeyEncoded, keyErr := network.GenerateAndEncodeLibp2pKey()
@@ -60,7 +60,7 @@ func SecretsManagerFactory(
 // Setup sets up the local SecretsManager
 func (l *LocalSecretsManager) Setup() error {
 	// The local SecretsManager initially handles only the
-	// validator and networking private keys
+	// validator (ECDSA and BLS) and networking private keys
 	l.secretPathMapLock.Lock()
 	defer l.secretPathMapLock.Unlock()
 
@@ -78,6 +78,13 @@ func (l *LocalSecretsManager) Setup() error {
 		secrets.ValidatorKeyLocal,
 	)
 
+	// baseDir/consensus/validator-bls.key
+	l.secretPathMap[secrets.ValidatorBLSKey] = filepath.Join(
+		l.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyLocal,
+	)
+
 	// baseDir/libp2p/libp2p.key
 	l.secretPathMap[secrets.NetworkKey] = filepath.Join(
 		l.path,
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 )
@@ -21,9 +23,13 @@ type Config struct {
 	GRPCAddr   *net.TCPAddr
 	LibP2PAddr *net.TCPAddr
 
-	PriceLimit uint64
-	MaxSlots   uint64
-	BlockTime  uint64
+	PriceLimit      uint64
+	MaxSlots        uint64
+	GossipRateLimit uint64
+	BlockTime       uint64
+
+	// RoundTimeouts overrides the round timeouts of the chain config, if set
+	RoundTimeouts *consensus.RoundTimeouts
 
 	Telemetry *Telemetry
 	Network   *network.Config
@@ -49,4 +55,43 @@ type Telemetry struct {
 type JSONRPC struct {
 	JSONRPCAddr              *net.TCPAddr
 	AccessControlAllowOrigin []string
+
+	MaxRequestBodySize uint64
+	BatchLengthLimit   uint64
+	RateLimit          uint64
+	APIKeys            map[string]uint64
+	MethodFilters      map[string]*jsonrpc.MethodFilter
+	LogQueryLimits     jsonrpc.LogQueryLimits
+	SubscriptionQueue  jsonrpc.SubscriptionQueueConfig
+
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// IPCPath is the path of the IPC endpoint, disabled if empty
+	IPCPath string
+
+	// GraphQL enables the GraphQL endpoint of the public listener
+	GraphQL bool
+
+	// Debug enables the debug namespace on the public listener
+	Debug bool
+
+	// GasCap is the maximum gas of the calls and the gas estimations (0 means unlimited)
+	GasCap uint64
+
+	// Admin is the authenticated listener of the privileged namespaces, nil if disabled
+	Admin *JSONRPCAdmin
+}
+
+// JSONRPCAdmin holds the config details for the authenticated JSON-RPC listener.
+// Once enabled, its namespaces are no longer served by the public listener
+type JSONRPCAdmin struct {
+	Addr       *net.TCPAddr
+	Namespaces []string
+
+	JWTSecret   []byte
+	BearerToken string
+
+	TLSCertFile string
+	TLSKeyFile  string
 }
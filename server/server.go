@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/finality"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/keccak"
@@ -54,6 +55,9 @@ type Server struct {
 	// jsonrpc stack
 	jsonrpcServer *jsonrpc.JSONRPC
 
+	// authenticated jsonrpc server of the privileged namespaces
+	adminJSONRPCServer *jsonrpc.JSONRPC
+
 	// system grpc server
 	grpcServer *grpc.Server
 
@@ -202,15 +206,16 @@ func NewServer(config *Config) (*Server, error) {
 		// start transaction pool
 		m.txpool, err = txpool.NewTxPool(
 			logger,
-			m.chain.Params.Forks.At(0),
+			m.chain.Params.Forks,
 			hub,
 			m.grpcServer,
 			m.network,
 			m.serverMetrics.txpool,
 			&txpool.Config{
-				Sealing:    m.config.Seal,
-				MaxSlots:   m.config.MaxSlots,
-				PriceLimit: m.config.PriceLimit,
+				Sealing:         m.config.Seal,
+				MaxSlots:        m.config.MaxSlots,
+				PriceLimit:      m.config.PriceLimit,
+				GossipRateLimit: m.config.GossipRateLimit,
 			},
 		)
 		if err != nil {
@@ -405,6 +410,7 @@ func (s *Server) setupConsensus() error {
 			Metrics:        s.serverMetrics.consensus,
 			SecretsManager: s.secretsManager,
 			BlockTime:      s.config.BlockTime,
+			RoundTimeouts:  s.config.RoundTimeouts,
 		},
 	)
 
@@ -466,6 +472,17 @@ func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*state.Acc
 	return &account, nil
 }
 
+// GetProof returns the merkle proof of the key in the trie with the given root
+func (j *jsonRPCHub) GetProof(root types.Hash, key []byte) ([][]byte, error) {
+	prover, ok := j.state.(state.Prover)
+	if !ok {
+		return nil, errors.New("the state does not support merkle proofs")
+	}
+
+	// the keys in the trie are the hashed keys
+	return prover.Prove(root, keccak.Keccak256(nil, key))
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return j.Executor.GetForksInTime(blockNumber)
@@ -500,9 +517,14 @@ func (j *jsonRPCHub) GetCode(hash types.Hash) ([]byte, error) {
 func (j *jsonRPCHub) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
+	override *types.CallOverride,
 ) (result *runtime.ExecutionResult, err error) {
-	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
-	if err != nil {
+	var blockCreator types.Address
+
+	if override != nil && override.Block != nil && override.Block.Coinbase != nil {
+		// the header is already overridden, but the creator of a hypothetical block can't be recovered from its seal
+		blockCreator = *override.Block.Coinbase
+	} else if blockCreator, err = j.GetConsensus().GetBlockCreator(header); err != nil {
 		return nil, err
 	}
 
@@ -512,11 +534,63 @@ func (j *jsonRPCHub) ApplyTxn(
 		return
 	}
 
+	if override != nil {
+		if err = transition.ApplyStateOverride(override.State); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err = transition.Apply(txn)
 
 	return
 }
 
+// TraceTxn re-executes the block up to the given transaction, which is traced
+func (j *jsonRPCHub) TraceTxn(block *types.Block, txHash types.Hash, tracer runtime.Tracer) error {
+	parentHeader, ok := j.GetHeaderByHash(block.ParentHash())
+	if !ok {
+		return fmt.Errorf("parent header %s not found", block.ParentHash())
+	}
+
+	blockCreator, err := j.GetConsensus().GetBlockCreator(block.Header)
+	if err != nil {
+		return err
+	}
+
+	return j.Executor.TraceTxn(parentHeader.StateRoot, block, blockCreator, txHash, tracer)
+}
+
+// TraceCall executes the transaction on top of the state of the header and traces it
+func (j *jsonRPCHub) TraceCall(header *types.Header, txn *types.Transaction, tracer runtime.Tracer) error {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return err
+	}
+
+	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	if err != nil {
+		return err
+	}
+
+	transition.SetTracer(tracer)
+
+	_, err = transition.Apply(txn)
+
+	return err
+}
+
+// GetBlockProof returns the proof of the committed seals of the block, if the consensus seals the blocks with them
+func (j *jsonRPCHub) GetBlockProof(number uint64) (*finality.Proof, error) {
+	prover, ok := j.Consensus.(interface {
+		GetBlockProof(number uint64) (*finality.Proof, error)
+	})
+	if !ok {
+		return nil, errors.New("block proofs are not supported by the consensus")
+	}
+
+	return prover.GetBlockProof(number)
+}
+
 func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 	// restore progression
 	if restoreProg := j.restoreProgression.GetProgression(); restoreProg != nil {
@@ -550,6 +624,31 @@ func (s *Server) setupJSONRPC() error {
 		Addr:                     s.config.JSONRPC.JSONRPCAddr,
 		ChainID:                  uint64(s.config.Chain.Params.ChainID),
 		AccessControlAllowOrigin: s.config.JSONRPC.AccessControlAllowOrigin,
+		MaxRequestBodySize:       s.config.JSONRPC.MaxRequestBodySize,
+		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
+		RateLimit:                s.config.JSONRPC.RateLimit,
+		APIKeys:                  s.config.JSONRPC.APIKeys,
+		MethodFilters:            s.config.JSONRPC.MethodFilters,
+		LogQueryLimits:           s.config.JSONRPC.LogQueryLimits,
+		SubscriptionQueue:        s.config.JSONRPC.SubscriptionQueue,
+		TLSCertFile:              s.config.JSONRPC.TLSCertFile,
+		TLSKeyFile:               s.config.JSONRPC.TLSKeyFile,
+		IPCPath:                  s.config.JSONRPC.IPCPath,
+		GraphQL:                  s.config.JSONRPC.GraphQL,
+		GasCap:                   s.config.JSONRPC.GasCap,
+		Debug:                    s.config.JSONRPC.Debug,
+	}
+
+	if admin := s.config.JSONRPC.Admin; admin != nil {
+		adminSrv, err := s.setupAdminJSONRPC(hub, admin)
+		if err != nil {
+			return err
+		}
+
+		s.adminJSONRPCServer = adminSrv
+
+		// the privileged namespaces are only served by the admin listener
+		conf.MethodFilters = denyNamespaces(conf.MethodFilters, admin.Namespaces)
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -562,6 +661,47 @@ func (s *Server) setupJSONRPC() error {
 	return nil
 }
 
+// setupAdminJSONRPC sets up the authenticated JSONRPC server of the privileged namespaces
+func (s *Server) setupAdminJSONRPC(hub *jsonRPCHub, admin *JSONRPCAdmin) (*jsonrpc.JSONRPC, error) {
+	if len(admin.JWTSecret) == 0 && admin.BearerToken == "" {
+		return nil, errors.New("the admin JSON-RPC listener requires a JWT secret or a bearer token")
+	}
+
+	return jsonrpc.NewJSONRPC(s.logger.Named("admin"), &jsonrpc.Config{
+		Store:              hub,
+		Addr:               admin.Addr,
+		ChainID:            uint64(s.config.Chain.Params.ChainID),
+		MaxRequestBodySize: s.config.JSONRPC.MaxRequestBodySize,
+		LogQueryLimits:     s.config.JSONRPC.LogQueryLimits,
+		SubscriptionQueue:  s.config.JSONRPC.SubscriptionQueue,
+		GasCap:             s.config.JSONRPC.GasCap,
+		Debug:              true,
+		TLSCertFile:        admin.TLSCertFile,
+		TLSKeyFile:         admin.TLSKeyFile,
+		Auth: &jsonrpc.AuthConfig{
+			JWTSecret:   admin.JWTSecret,
+			BearerToken: admin.BearerToken,
+		},
+	})
+}
+
+// denyNamespaces returns a copy of the method filters denying every method of the namespaces
+func denyNamespaces(filters map[string]*jsonrpc.MethodFilter, namespaces []string) map[string]*jsonrpc.MethodFilter {
+	denied := make(map[string]*jsonrpc.MethodFilter, len(filters)+len(namespaces))
+
+	for namespace, filter := range filters {
+		denied[namespace] = filter
+	}
+
+	for _, namespace := range namespaces {
+		denied[namespace] = &jsonrpc.MethodFilter{
+			Deny: []string{"*"},
+		}
+	}
+
+	return denied
+}
+
 // setupGRPC sets up the grpc server and listens on tcp
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{server: s})
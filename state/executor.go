@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -19,6 +20,9 @@ const (
 
 	TxGas                 uint64 = 21000 // Per transaction not creating a contract
 	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
+
+	TxAccessListAddressGas    uint64 = 2400 // Per address specified in the access list (EIP-2930)
+	TxAccessListStorageKeyGas uint64 = 1900 // Per storage key specified in the access list (EIP-2930)
 )
 
 var emptyCodeHashTwo = types.BytesToHash(crypto.Keccak256(nil))
@@ -37,6 +41,10 @@ type Executor struct {
 	GetHash  GetHashByNumberHelper
 
 	PostHook func(txn *Transition)
+
+	// SystemTxHandler applies the system transactions, which aren't executed by the EVM.
+	// It is set by the consensus that adds them to the blocks
+	SystemTxHandler func(t *Transition, txn *types.Transaction) error
 }
 
 // NewExecutor creates a new executor
@@ -117,6 +125,48 @@ func (e *Executor) ProcessBlock(
 	return txn, nil
 }
 
+// ErrTxnNotFoundInBlock is returned when tracing a transaction which is not in the given block
+var ErrTxnNotFoundInBlock = errors.New("transaction not found in the block")
+
+// TraceTxn re-executes the transactions of the block preceding the given one
+// and executes the transaction itself with the tracer
+func (e *Executor) TraceTxn(
+	parentRoot types.Hash,
+	block *types.Block,
+	blockCreator types.Address,
+	txHash types.Hash,
+	tracer runtime.Tracer,
+) error {
+	txn, err := e.BeginTxn(parentRoot, block.Header, blockCreator)
+	if err != nil {
+		return err
+	}
+
+	txn.block = block
+
+	for _, t := range block.Transactions {
+		if t.Hash == txHash {
+			txn.SetTracer(tracer)
+
+			return txn.Write(t)
+		}
+
+		if t.ExceedsBlockGasLimit(block.Header.GasLimit) {
+			if err := txn.WriteFailedReceipt(t); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := txn.Write(t); err != nil {
+			return err
+		}
+	}
+
+	return ErrTxnNotFoundInBlock
+}
+
 // StateAt returns snapshot at given root
 func (e *Executor) State() State {
 	return e.state
@@ -153,6 +203,7 @@ func (e *Executor) BeginTxn(
 		Difficulty: types.BytesToHash(new(big.Int).SetUint64(header.Difficulty).Bytes()),
 		GasLimit:   int64(header.GasLimit),
 		ChainID:    int64(e.config.ChainID),
+		BaseFee:    header.BaseFee,
 	}
 
 	txn := &Transition{
@@ -188,6 +239,9 @@ type Transition struct {
 	ctx     runtime.TxContext
 	gasPool uint64
 
+	// tracer receives the execution events, nil if the execution is not traced
+	tracer runtime.Tracer
+
 	// result
 	receipts []*types.Receipt
 	totalGas uint64
@@ -220,6 +274,7 @@ func (t *Transition) WriteFailedReceipt(txn *types.Transaction) error {
 		CumulativeGasUsed: t.totalGas,
 		TxHash:            txn.Hash,
 		Logs:              t.state.Logs(),
+		TransactionType:   txn.Type,
 	}
 
 	receipt.LogsBloom = types.CreateBloom([]*types.Receipt{receipt})
@@ -235,6 +290,10 @@ func (t *Transition) WriteFailedReceipt(txn *types.Transaction) error {
 
 // Write writes another transaction to the executor
 func (t *Transition) Write(txn *types.Transaction) error {
+	if txn.IsSystem() {
+		return t.writeSystemTx(txn)
+	}
+
 	signer := crypto.NewSigner(t.config, uint64(t.r.config.ChainID))
 
 	var err error
@@ -256,6 +315,37 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		return e
 	}
 
+	t.writeReceipt(txn, result)
+
+	return nil
+}
+
+// writeSystemTx applies a system transaction with the handler of the consensus.
+// System transactions use no gas, and the block is invalid if one of them can't be applied
+func (t *Transition) writeSystemTx(txn *types.Transaction) error {
+	if txn.From != types.SystemAddress {
+		return NewTransitionApplicationError(ErrInvalidSystemTxSender, false)
+	}
+
+	if t.r.SystemTxHandler == nil {
+		return NewTransitionApplicationError(ErrSystemTxNotSupported, false)
+	}
+
+	s := t.state.Snapshot()
+
+	if err := t.r.SystemTxHandler(t, txn.Copy()); err != nil {
+		t.state.RevertToSnapshot(s)
+
+		return NewTransitionApplicationError(err, false)
+	}
+
+	t.writeReceipt(txn, &runtime.ExecutionResult{})
+
+	return nil
+}
+
+// writeReceipt adds the receipt of the applied transaction
+func (t *Transition) writeReceipt(txn *types.Transaction, result *runtime.ExecutionResult) {
 	t.totalGas += result.GasUsed
 
 	logs := t.state.Logs()
@@ -266,6 +356,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		CumulativeGasUsed: t.totalGas,
 		TxHash:            txn.Hash,
 		GasUsed:           result.GasUsed,
+		TransactionType:   txn.Type,
 	}
 
 	if t.config.Byzantium {
@@ -285,16 +376,14 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	}
 
 	// if the transaction created a contract, store the creation address in the receipt.
-	if msg.To == nil {
-		receipt.ContractAddress = crypto.CreateAddress(msg.From, txn.Nonce).Ptr()
+	if txn.To == nil {
+		receipt.ContractAddress = crypto.CreateAddress(txn.From, txn.Nonce).Ptr()
 	}
 
 	// Set the receipt logs and create a bloom for filtering
 	receipt.Logs = logs
 	receipt.LogsBloom = types.CreateBloom([]*types.Receipt{receipt})
 	t.receipts = append(t.receipts, receipt)
-
-	return nil
 }
 
 // Commit commits the final result
@@ -346,6 +435,37 @@ func (t *Transition) Apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	return result, err
 }
 
+// ApplyStateOverride overrides the state of the accounts, before executing a call
+func (t *Transition) ApplyStateOverride(override types.StateOverride) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range override {
+		if account.Nonce != nil {
+			t.state.SetNonce(addr, *account.Nonce)
+		}
+
+		if account.Code != nil {
+			t.state.SetCode(addr, account.Code)
+		}
+
+		if account.Balance != nil {
+			t.state.SetBalance(addr, account.Balance)
+		}
+
+		if account.State != nil {
+			t.state.SetFullState(addr, account.State)
+		}
+
+		for key, value := range account.StateDiff {
+			t.state.SetState(addr, key, value)
+		}
+	}
+
+	return nil
+}
+
 // ContextPtr returns reference of context
 // This method is called only by test
 func (t *Transition) ContextPtr() *runtime.TxContext {
@@ -353,8 +473,17 @@ func (t *Transition) ContextPtr() *runtime.TxContext {
 }
 
 func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
+	// the sender of a dynamic fee transaction has to be able to cover the fee cap,
+	// even though only the effective gas price is charged
+	if msg.IsDynamicFee() {
+		maxGasCost := new(big.Int).Mul(msg.GasPrice, new(big.Int).SetUint64(msg.Gas))
+		if t.state.GetBalance(msg.From).Cmp(maxGasCost) < 0 {
+			return ErrNotEnoughFundsForGas
+		}
+	}
+
 	// deduct the upfront max gas cost
-	upfrontGasCost := new(big.Int).Set(msg.GasPrice)
+	upfrontGasCost := msg.EffectiveGasPrice(t.ctx.BaseFee)
 	upfrontGasCost.Mul(upfrontGasCost, new(big.Int).SetUint64(msg.Gas))
 
 	if err := t.state.SubBalance(msg.From, upfrontGasCost); err != nil {
@@ -388,8 +517,42 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 	ErrNotEnoughFunds        = fmt.Errorf("not enough funds for transfer with given value")
+	ErrFeeCapTooLow          = fmt.Errorf("max fee per gas less than block base fee")
+	ErrTipAboveFeeCap        = fmt.Errorf("max priority fee per gas higher than max fee per gas")
+	ErrInvalidSystemTxSender = fmt.Errorf("system transaction not sent by the system address")
+	ErrSystemTxNotSupported  = fmt.Errorf("system transactions are not supported")
 )
 
+// IsTxTypeSupported checks if the transactions of the given type
+// are accepted with the given set of forks
+func IsTxTypeSupported(txType types.TxType, config chain.ForksInTime) bool {
+	switch txType {
+	case types.LegacyTx:
+		return true
+	case types.AccessListTx:
+		return config.Berlin
+	case types.DynamicFeeTx:
+		return config.London
+	default:
+		return false
+	}
+}
+
+// checkFees checks the fee fields of the transaction against the base fee of the block (EIP-1559)
+func (t *Transition) checkFees(msg *types.Transaction) error {
+	if msg.IsDynamicFee() && msg.GasTipCap.Cmp(msg.GasPrice) > 0 {
+		return NewTransitionApplicationError(ErrTipAboveFeeCap, false)
+	}
+
+	// the base fee could go down in the following blocks,
+	// so the transaction can be retried later
+	if msg.GasPrice.Cmp(new(big.Int).SetUint64(t.ctx.BaseFee)) < 0 {
+		return NewTransitionApplicationError(ErrFeeCapTooLow, true)
+	}
+
+	return nil
+}
+
 type TransitionApplicationError struct {
 	Err           error
 	IsRecoverable bool // Should the transaction be discarded, or put back in the queue.
@@ -428,6 +591,17 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	// 6. caller has enough balance to cover asset transfer for **topmost** call
 	txn := t.state
 
+	// typed transactions are accepted from the berlin fork on
+	if !IsTxTypeSupported(msg.Type, t.config) {
+		return nil, NewTransitionApplicationError(types.ErrTxTypeNotSupported, false)
+	}
+
+	if t.config.London {
+		if err := t.checkFees(msg); err != nil {
+			return nil, err
+		}
+	}
+
 	// 1. the nonce of the message caller is correct
 	if err := t.nonceCheck(msg); err != nil {
 		return nil, NewTransitionApplicationError(err, true)
@@ -461,13 +635,26 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		return nil, NewTransitionApplicationError(ErrNotEnoughFunds, true)
 	}
 
-	gasPrice := new(big.Int).Set(msg.GasPrice)
+	gasPrice := msg.EffectiveGasPrice(t.ctx.BaseFee)
 	value := new(big.Int).Set(msg.Value)
 
 	// Set the specific transaction fields in the context
 	t.ctx.GasPrice = types.BytesToHash(gasPrice.Bytes())
 	t.ctx.Origin = msg.From
 
+	if t.config.Berlin {
+		t.prepareAccessList(msg)
+	}
+
+	if t.tracer != nil {
+		to := crypto.CreateAddress(msg.From, txn.GetNonce(msg.From))
+		if !msg.IsContractCreation() {
+			to = *msg.To
+		}
+
+		t.tracer.CaptureStart(msg.From, to, msg.IsContractCreation(), msg.Input, msg.Gas, value)
+	}
+
 	var result *runtime.ExecutionResult
 	if msg.IsContractCreation() {
 		result = t.Create2(msg.From, msg.Input, value, gasLeft)
@@ -477,14 +664,31 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	}
 
 	refund := txn.GetRefund()
-	result.UpdateGasUsed(msg.Gas, refund)
+	result.UpdateGasUsed(msg.Gas, refund, &t.config)
+
+	if t.tracer != nil {
+		t.tracer.CaptureEnd(result.ReturnValue, result.GasUsed, result.Err)
+	}
 
 	// refund the sender
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), gasPrice)
 	txn.AddBalance(msg.From, remaining)
 
-	// pay the coinbase
-	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), gasPrice)
+	gasUsed := new(big.Int).SetUint64(result.GasUsed)
+
+	// pay the coinbase, which only gets the tip once the base fee is paid
+	coinbaseFee := new(big.Int).Mul(gasUsed, gasPrice)
+
+	if t.config.London {
+		baseFee := new(big.Int).Mul(gasUsed, new(big.Int).SetUint64(t.ctx.BaseFee))
+		coinbaseFee.Sub(coinbaseFee, baseFee)
+
+		// the base fee is burned, unless there is an account collecting it
+		if collector := t.r.config.BaseFeeCollector; collector != nil {
+			txn.AddBalance(*collector, baseFee)
+		}
+	}
+
 	txn.AddBalance(t.ctx.Coinbase, coinbaseFee)
 
 	// return gas to the pool
@@ -493,6 +697,30 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	return result, nil
 }
 
+// prepareAccessList warms up the sender, the destination, the precompiled contracts
+// and the entries of the transaction access list before the execution (EIP-2929)
+func (t *Transition) prepareAccessList(msg *types.Transaction) {
+	t.state.ClearAccessList()
+
+	t.state.AddAddressToAccessList(msg.From)
+
+	if msg.To != nil {
+		t.state.AddAddressToAccessList(*msg.To)
+	}
+
+	for _, addr := range precompiled.ActiveAddresses(&t.config) {
+		t.state.AddAddressToAccessList(addr)
+	}
+
+	for _, tuple := range msg.AccessList {
+		t.state.AddAddressToAccessList(tuple.Address)
+
+		for _, key := range tuple.StorageKeys {
+			t.state.AddSlotToAccessList(tuple.Address, key)
+		}
+	}
+}
+
 func (t *Transition) Create2(
 	caller types.Address,
 	code []byte,
@@ -611,6 +839,11 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 	// Increment the nonce of the caller
 	t.state.IncrNonce(c.Caller)
 
+	// The created address is warm even if the creation fails (EIP-2929)
+	if t.config.Berlin {
+		t.state.AddAddressToAccessList(c.Address)
+	}
+
 	// Check if there if there is a collision and the address already exists
 	if t.hasCodeOrNonce(c.Address) {
 		return &runtime.ExecutionResult{
@@ -654,6 +887,16 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		}
 	}
 
+	// eip-3541, new code starting with the 0xEF byte is rejected
+	if t.config.London && len(result.ReturnValue) > 0 && result.ReturnValue[0] == 0xEF {
+		t.state.RevertToSnapshot(snapshot)
+
+		return &runtime.ExecutionResult{
+			GasLeft: 0,
+			Err:     runtime.ErrInvalidCode,
+		}
+	}
+
 	gasCost := uint64(len(result.ReturnValue)) * 200
 
 	if result.GasLeft < gasCost {
@@ -729,8 +972,25 @@ func (t *Transition) GetNonce(addr types.Address) uint64 {
 	return t.state.GetNonce(addr)
 }
 
+func (t *Transition) AddressInAccessList(addr types.Address) bool {
+	return t.state.AddressInAccessList(addr)
+}
+
+func (t *Transition) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	return t.state.SlotInAccessList(addr, slot)
+}
+
+func (t *Transition) AddAddressToAccessList(addr types.Address) {
+	t.state.AddAddressToAccessList(addr)
+}
+
+func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	t.state.AddSlotToAccessList(addr, slot)
+}
+
 func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address) {
-	if !t.state.HasSuicided(addr) {
+	// eip-3529, selfdestruct refunds are removed from the london fork on
+	if !t.config.London && !t.state.HasSuicided(addr) {
 		t.state.AddRefund(24000)
 	}
 
@@ -739,11 +999,33 @@ func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address)
 }
 
 func (t *Transition) Callx(c *runtime.Contract, h runtime.Host) *runtime.ExecutionResult {
-	if c.Type == runtime.Create {
-		return t.applyCreate(c, h)
+	if t.tracer != nil {
+		t.tracer.CaptureEnter(c.Type, c.Caller, c.Address, c.Input, c.Gas, c.Value)
+	}
+
+	var result *runtime.ExecutionResult
+	if c.Type == runtime.Create || c.Type == runtime.Create2 {
+		result = t.applyCreate(c, h)
+	} else {
+		result = t.applyCall(c, c.Type, h)
+	}
+
+	if t.tracer != nil {
+		t.tracer.CaptureExit(result.ReturnValue, c.Gas-result.GasLeft, result.Err)
 	}
 
-	return t.applyCall(c, c.Type, h)
+	return result
+}
+
+// SetTracer sets the tracer that receives the execution events of the next transactions,
+// a nil tracer disables the tracing
+func (t *Transition) SetTracer(tracer runtime.Tracer) {
+	t.tracer = tracer
+}
+
+// GetTracer returns the tracer of the transition, nil if the execution is not traced
+func (t *Transition) GetTracer() runtime.Tracer {
+	return t.tracer
 }
 
 // SetAccountDirectly sets an account to the given address
@@ -805,5 +1087,22 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 		cost += zeros * 4
 	}
 
+	// access list entries are paid upfront (EIP-2930)
+	if len(msg.AccessList) > 0 {
+		addresses := uint64(len(msg.AccessList))
+		if (math.MaxUint64-cost)/TxAccessListAddressGas < addresses {
+			return 0, ErrIntrinsicGasOverflow
+		}
+
+		cost += addresses * TxAccessListAddressGas
+
+		storageKeys := uint64(msg.AccessList.StorageKeys())
+		if (math.MaxUint64-cost)/TxAccessListStorageKeyGas < storageKeys {
+			return 0, ErrIntrinsicGasOverflow
+		}
+
+		cost += storageKeys * TxAccessListStorageKeyGas
+	}
+
 	return cost, nil
 }
@@ -0,0 +1,141 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid merkle proof")
+)
+
+// Prove returns the merkle proof of the key in the trie with the given root,
+// the encoded nodes on the path from the root to the key. If the key is not
+// in the trie, the proof ends with the node where the path diverges
+func (s *State) Prove(root types.Hash, key []byte) ([][]byte, error) {
+	proof := [][]byte{}
+
+	if root == types.EmptyRootHash {
+		return proof, nil
+	}
+
+	path := bytesToHexNibbles(key)
+	hash := root.Bytes()
+
+	for hash != nil {
+		data, ok := s.storage.Get(hash)
+		if !ok {
+			return nil, fmt.Errorf("trie node %s not found", types.BytesToHash(hash))
+		}
+
+		proof = append(proof, data)
+
+		node, err := decodeProofNode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, path, _ = walkNode(node, path)
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks the merkle proof of the key against the root,
+// it returns the value of the key or nil if the proof shows it is not in the trie
+func VerifyProof(root types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == types.EmptyRootHash && len(proof) == 0 {
+		return nil, nil
+	}
+
+	path := bytesToHexNibbles(key)
+	hash := root.Bytes()
+
+	for i, data := range proof {
+		if hash == nil {
+			return nil, fmt.Errorf("%w: unexpected node %d", ErrInvalidProof, i)
+		}
+
+		if !bytes.Equal(hashit(data), hash) {
+			return nil, fmt.Errorf("%w: node %d does not match its hash", ErrInvalidProof, i)
+		}
+
+		node, err := decodeProofNode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		var value []byte
+
+		hash, path, value = walkNode(node, path)
+		if hash == nil && i == len(proof)-1 {
+			return value, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: missing nodes", ErrInvalidProof)
+}
+
+// decodeProofNode decodes a node of a proof, its children are either
+// embedded nodes or hash references
+func decodeProofNode(data []byte) (Node, error) {
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Type() != fastrlp.TypeArray {
+		return nil, fmt.Errorf("trie node should be an array")
+	}
+
+	return decodeNode(v, nil)
+}
+
+// walkNode follows the path inside a decoded node. It returns the hash of the next
+// node to load along with the rest of the path, or the value of the key
+// once the path ends in this node (nil if the key is not in the trie)
+func walkNode(node Node, path []byte) ([]byte, []byte, []byte) {
+	for {
+		switch n := node.(type) {
+		case nil:
+			return nil, nil, nil
+
+		case *ValueNode:
+			if n.hash {
+				return n.buf, path, nil
+			}
+
+			if len(path) != 0 {
+				return nil, nil, nil
+			}
+
+			return nil, nil, n.buf
+
+		case *ShortNode:
+			if !bytes.HasPrefix(path, n.key) {
+				return nil, nil, nil
+			}
+
+			node = n.child
+			path = path[len(n.key):]
+
+		case *FullNode:
+			if len(path) == 0 {
+				return nil, nil, nil
+			}
+
+			node = n.getEdge(path[0])
+			path = path[1:]
+
+		default:
+			return nil, nil, nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package itrie
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProof(t *testing.T) {
+	t.Parallel()
+
+	st := NewState(NewMemoryStorage())
+
+	objs := []*state.Object{}
+	for i := 0; i < 100; i++ {
+		objs = append(objs, &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i + 1)).Bytes()),
+			Balance:  big.NewInt(int64(i)),
+			CodeHash: types.EmptyRootHash,
+			Root:     types.EmptyRootHash,
+			Nonce:    uint64(i),
+			Storage: []*state.StorageObject{
+				{Key: []byte{0x1}, Val: []byte{byte(i + 1)}},
+			},
+		})
+	}
+
+	snap, root := st.NewSnapshot().Commit(objs)
+	stateRoot := types.BytesToHash(root)
+
+	t.Run("should prove the accounts in the trie", func(t *testing.T) {
+		t.Parallel()
+
+		for _, obj := range objs {
+			key := hashit(obj.Address.Bytes())
+
+			proof, err := st.Prove(stateRoot, key)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, proof)
+
+			value, err := VerifyProof(stateRoot, key, proof)
+			assert.NoError(t, err)
+
+			expected, ok := snap.Get(key)
+			assert.True(t, ok)
+			assert.Equal(t, expected, value)
+		}
+	})
+
+	t.Run("should prove the storage of an account", func(t *testing.T) {
+		t.Parallel()
+
+		data, ok := snap.Get(hashit(objs[0].Address.Bytes()))
+		assert.True(t, ok)
+
+		var account state.Account
+		assert.NoError(t, account.UnmarshalRlp(data))
+
+		key := hashit([]byte{0x1})
+
+		proof, err := st.Prove(account.Root, key)
+		assert.NoError(t, err)
+
+		value, err := VerifyProof(account.Root, key, proof)
+		assert.NoError(t, err)
+		// the values are stored rlp encoded
+		assert.Equal(t, []byte{0x1}, value)
+	})
+
+	t.Run("should prove a missing key", func(t *testing.T) {
+		t.Parallel()
+
+		key := hashit(types.StringToAddress("ff").Bytes())
+
+		proof, err := st.Prove(stateRoot, key)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, proof)
+
+		value, err := VerifyProof(stateRoot, key, proof)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("should reject a tampered proof", func(t *testing.T) {
+		t.Parallel()
+
+		key := hashit(objs[0].Address.Bytes())
+
+		proof, err := st.Prove(stateRoot, key)
+		assert.NoError(t, err)
+
+		// drop the root node
+		_, err = VerifyProof(stateRoot, key, proof[1:])
+		assert.True(t, errors.Is(err, ErrInvalidProof))
+
+		// drop the leaf node
+		_, err = VerifyProof(stateRoot, key, proof[:len(proof)-1])
+		assert.True(t, errors.Is(err, ErrInvalidProof))
+	})
+
+	t.Run("should prove an empty trie", func(t *testing.T) {
+		t.Parallel()
+
+		proof, err := st.Prove(types.EmptyRootHash, hashit([]byte{0x1}))
+		assert.NoError(t, err)
+		assert.Empty(t, proof)
+
+		value, err := VerifyProof(types.EmptyRootHash, hashit([]byte{0x1}), proof)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
@@ -38,6 +38,7 @@ func (e *EVM) Run(c *runtime.Contract, host runtime.Host, config *chain.ForksInT
 	contract.gas = c.Gas
 	contract.host = host
 	contract.config = config
+	contract.tracer = host.GetTracer()
 
 	contract.bitmap.setCode(c.Code)
 
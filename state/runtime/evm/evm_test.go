@@ -6,6 +6,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -87,6 +88,26 @@ func (m *mockHost) GetNonce(addr types.Address) uint64 {
 	panic("Not implemented in tests")
 }
 
+func (m *mockHost) AddressInAccessList(addr types.Address) bool {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) AddAddressToAccessList(addr types.Address) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) GetTracer() runtime.Tracer {
+	return nil
+}
+
 func TestRun(t *testing.T) {
 	t.Parallel()
 
@@ -169,3 +190,38 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+type mockHostForTracer struct {
+	mockHost
+	tracer runtime.Tracer
+}
+
+func (m *mockHostForTracer) GetTracer() runtime.Tracer {
+	return m.tracer
+}
+
+func TestRun_Tracer(t *testing.T) {
+	t.Parallel()
+
+	logger := tracer.NewStructLogger(&tracer.Config{})
+	host := &mockHostForTracer{tracer: logger}
+
+	contract := newMockContract(big.NewInt(0), 5000, []byte{PUSH1, 0x01, PUSH1, 0x02, ADD, byte(STOP)})
+
+	res := NewEVM().Run(contract, host, &chain.ForksInTime{})
+	assert.NoError(t, res.Err)
+
+	result, err := logger.GetResult()
+	assert.NoError(t, err)
+
+	stack := func(items ...string) *[]string {
+		return &items
+	}
+
+	assert.Equal(t, []tracer.StructLog{
+		{PC: 0, Op: "PUSH1", Gas: 5000, GasCost: 3, Depth: 1, Stack: &[]string{}},
+		{PC: 2, Op: "PUSH1", Gas: 4997, GasCost: 3, Depth: 1, Stack: stack("0x1")},
+		{PC: 4, Op: "ADD", Gas: 4994, GasCost: 3, Depth: 1, Stack: stack("0x1", "0x2")},
+		{PC: 5, Op: "STOP", Gas: 4991, GasCost: 0, Depth: 1, Stack: stack("0x3")},
+	}, result.(*tracer.StructLoggerResult).StructLogs)
+}
@@ -459,13 +459,47 @@ func opMStore8(c *state) {
 	c.memory[offset.Uint64()] = byte(val.Uint64() & 0xff)
 }
 
+// --- access lists (eip-2929) ---
+
+const (
+	coldAccountAccessCost = uint64(2600)
+	coldSloadCost         = uint64(2100)
+	warmStorageReadCost   = uint64(100)
+)
+
+// accountAccessCost returns the cost of accessing the account,
+// marking it as warm for the rest of the transaction
+func (c *state) accountAccessCost(addr types.Address) uint64 {
+	if c.host.AddressInAccessList(addr) {
+		return warmStorageReadCost
+	}
+
+	c.host.AddAddressToAccessList(addr)
+
+	return coldAccountAccessCost
+}
+
+// slotAccessCost returns the cost of reading the storage slot of the current contract,
+// marking it as warm for the rest of the transaction
+func (c *state) slotAccessCost(slot types.Hash) uint64 {
+	if _, slotOk := c.host.SlotInAccessList(c.msg.Address, slot); slotOk {
+		return warmStorageReadCost
+	}
+
+	c.host.AddSlotToAccessList(c.msg.Address, slot)
+
+	return coldSloadCost
+}
+
 // --- storage ---
 
 func opSload(c *state) {
 	loc := c.top()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		gas = c.slotAccessCost(bigToHash(loc))
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 800
 	} else if c.config.EIP150 {
@@ -479,6 +513,11 @@ func opSload(c *state) {
 	}
 
 	val := c.host.GetStorage(c.msg.Address, bigToHash(loc))
+
+	if c.tracer != nil {
+		c.tracer.CaptureStorage(c.msg.Address, bigToHash(loc), val)
+	}
+
 	loc.SetBytes(val.Bytes())
 }
 
@@ -500,12 +539,28 @@ func opSStore(c *state) {
 
 	legacyGasMetering := !c.config.Istanbul && (c.config.Petersburg || !c.config.Constantinople)
 
-	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
 	cost := uint64(0)
 
+	if c.config.Berlin {
+		// eip-2929, the cold slot surcharge is paid on top of the eip-2200 costs
+		if _, slotOk := c.host.SlotInAccessList(c.msg.Address, key); !slotOk {
+			cost = coldSloadCost
+
+			c.host.AddSlotToAccessList(c.msg.Address, key)
+		}
+	}
+
+	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
+
+	if c.tracer != nil {
+		c.tracer.CaptureStorage(c.msg.Address, key, val)
+	}
+
 	switch status {
 	case runtime.StorageUnchanged:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -515,10 +570,17 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageModified:
-		cost = 5000
+		if c.config.Berlin {
+			// eip-2929, SSTORE_RESET - COLD_SLOAD
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 
 	case runtime.StorageModifiedAgain:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -528,10 +590,14 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageAdded:
-		cost = 20000
+		cost += 20000
 
 	case runtime.StorageDeleted:
-		cost = 5000
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 	}
 
 	if !c.consumeGas(cost) {
@@ -575,7 +641,9 @@ func opBalance(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		gas = c.accountAccessCost(addr)
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 700
 	} else if c.config.EIP150 {
@@ -611,6 +679,16 @@ func opChainID(c *state) {
 	c.push1().SetUint64(uint64(c.host.GetTxContext().ChainID))
 }
 
+func opBaseFee(c *state) {
+	if !c.config.London {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	c.push1().SetUint64(c.host.GetTxContext().BaseFee)
+}
+
 func opOrigin(c *state) {
 	c.push1().SetBytes(c.host.GetTxContext().Origin.Bytes())
 }
@@ -658,7 +736,9 @@ func opExtCodeSize(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		gas = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -693,7 +773,9 @@ func opExtCodeHash(c *state) {
 	address, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		gas = c.accountAccessCost(address)
+	} else if c.config.Istanbul {
 		gas = 700
 	} else {
 		gas = 400
@@ -767,7 +849,9 @@ func opExtCodeCopy(c *state) {
 	}
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		gas = c.accountAccessCost(address)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -927,6 +1011,13 @@ func opSelfDestruct(c *state) {
 		}
 	}
 
+	// eip-2929, only the access to a cold beneficiary is charged
+	if c.config.Berlin && !c.host.AddressInAccessList(address) {
+		gas += coldAccountAccessCost
+
+		c.host.AddAddressToAccessList(address)
+	}
+
 	if !c.consumeGas(gas) {
 		return
 	}
@@ -1078,6 +1169,9 @@ func opCreate(op OpCode) instruction {
 		}
 
 		contract.Type = runtime.Create
+		if op == CREATE2 {
+			contract.Type = runtime.Create2
+		}
 
 		// Correct call
 		result := c.host.Callx(contract, c.host)
@@ -1208,7 +1302,9 @@ func (c *state) buildCallContract(op OpCode) (*runtime.Contract, uint64, uint64,
 	}
 
 	var gasCost uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		gasCost = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gasCost = 700
 	} else {
 		gasCost = 40
@@ -1236,6 +1332,12 @@ func (c *state) buildCallContract(op OpCode) (*runtime.Contract, uint64, uint64,
 	ok = initialGas.IsUint64()
 
 	if c.config.EIP150 {
+		if c.gas < gasCost {
+			c.exit(errOutOfGas)
+
+			return nil, 0, 0, nil
+		}
+
 		availableGas := c.gas - gasCost
 		availableGas = availableGas - availableGas/64
 
@@ -378,3 +378,74 @@ func TestCreate(t *testing.T) {
 		})
 	}
 }
+
+type mockHostForAccessList struct {
+	mockHost
+	slots map[types.Hash]bool
+}
+
+func (m *mockHostForAccessList) GetStorage(types.Address, types.Hash) types.Hash {
+	return types.Hash{}
+}
+
+func (m *mockHostForAccessList) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	return true, m.slots[slot]
+}
+
+func (m *mockHostForAccessList) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	m.slots[slot] = true
+}
+
+func TestSloadAccessList(t *testing.T) {
+	s, closeFn := getState()
+	defer closeFn()
+
+	s.msg = &runtime.Contract{Address: addr1}
+	s.config = &chain.ForksInTime{Berlin: true}
+	s.host = &mockHostForAccessList{slots: map[types.Hash]bool{}}
+	s.gas = 10000
+
+	// the first access to the slot is cold
+	s.push(big.NewInt(1))
+	opSload(s)
+	assert.Equal(t, uint64(10000-coldSloadCost), s.gas)
+
+	// the slot is warm afterwards
+	s.push(big.NewInt(1))
+	opSload(s)
+	assert.Equal(t, uint64(10000-coldSloadCost-warmStorageReadCost), s.gas)
+}
+
+type mockHostForBaseFee struct {
+	mockHost
+	baseFee uint64
+}
+
+func (m *mockHostForBaseFee) GetTxContext() runtime.TxContext {
+	return runtime.TxContext{BaseFee: m.baseFee}
+}
+
+func TestBaseFee(t *testing.T) {
+	t.Run("pushes the base fee from the london fork on", func(t *testing.T) {
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.config = &chain.ForksInTime{London: true}
+		s.host = &mockHostForBaseFee{baseFee: 1000}
+
+		opBaseFee(s)
+		assert.Nil(t, s.err)
+		assert.Equal(t, big.NewInt(1000), s.pop())
+	})
+
+	t.Run("is an invalid opcode before the london fork", func(t *testing.T) {
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.config = &chain.ForksInTime{Berlin: true}
+		s.host = &mockHostForBaseFee{baseFee: 1000}
+
+		opBaseFee(s)
+		assert.Equal(t, errOpCodeNotFound, s.err)
+	})
+}
@@ -79,6 +79,9 @@ type state struct {
 
 	returnData []byte
 	ret        []byte
+
+	// tracer receives the execution steps, nil if the execution is not traced
+	tracer runtime.Tracer
 }
 
 func (c *state) reset() {
@@ -88,6 +91,7 @@ func (c *state) reset() {
 	c.lastGasCost = 0
 	c.stop = false
 	c.err = nil
+	c.tracer = nil
 
 	// reset bitmap
 	c.bitmap.reset()
@@ -222,35 +226,18 @@ func (c *state) Run() ([]byte, error) {
 
 		op := OpCode(c.code[c.ip])
 
-		inst := dispatchTable[op]
-		if inst.inst == nil {
-			c.exit(errOpCodeNotFound)
+		if c.tracer == nil {
+			c.step(op)
 
-			break
+			continue
 		}
-		// check if the depth of the stack is enough for the instruction
-		if c.sp < inst.stack {
-			c.exit(errStackUnderflow)
 
-			break
-		}
-		// consume the gas of the instruction
-		if !c.consumeGas(inst.gas) {
-			c.exit(errOutOfGas)
+		c.captureState(op)
 
-			break
-		}
+		gas := c.gas
+		c.step(op)
 
-		// execute the instruction
-		inst.inst(c)
-
-		// check if stack size exceeds the max size
-		if c.sp > stackSize {
-			c.exit(errStackOverflow)
-
-			break
-		}
-		c.ip++
+		c.tracer.CaptureStateEnd(gas-c.gas, c.err)
 	}
 
 	if err := c.err; err != nil {
@@ -260,6 +247,54 @@ func (c *state) Run() ([]byte, error) {
 	return c.ret, vmerr
 }
 
+// step executes a single instruction
+func (c *state) step(op OpCode) {
+	inst := dispatchTable[op]
+	if inst.inst == nil {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+	// check if the depth of the stack is enough for the instruction
+	if c.sp < inst.stack {
+		c.exit(errStackUnderflow)
+
+		return
+	}
+	// consume the gas of the instruction
+	if !c.consumeGas(inst.gas) {
+		c.exit(errOutOfGas)
+
+		return
+	}
+
+	// execute the instruction
+	inst.inst(c)
+
+	// check if stack size exceeds the max size
+	if c.sp > stackSize {
+		c.exit(errStackOverflow)
+
+		return
+	}
+	c.ip++
+}
+
+// captureState sends the state before the execution of the instruction to the tracer
+func (c *state) captureState(op OpCode) {
+	c.tracer.CaptureState(&runtime.TraceStep{
+		PC:         uint64(c.ip),
+		Op:         byte(op),
+		OpName:     op.String(),
+		Gas:        c.gas,
+		Depth:      c.msg.Depth,
+		Address:    c.msg.Address,
+		Stack:      c.stack[:c.sp],
+		Memory:     c.memory,
+		ReturnData: c.returnData,
+	})
+}
+
 func (c *state) inStaticCall() bool {
 	return c.msg.Static
 }
@@ -61,14 +61,44 @@ var (
 	nine  = types.StringToAddress("9")
 )
 
+// precompiledAddresses are the addresses of all the precompiled contracts
+var precompiledAddresses = NewPrecompiled().addresses()
+
+func (p *Precompiled) addresses() []types.Address {
+	addrs := make([]types.Address, 0, len(p.contracts))
+	for addr := range p.contracts {
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
+
+// ActiveAddresses returns the addresses of the precompiled contracts enabled in the given forks
+func ActiveAddresses(config *chain.ForksInTime) []types.Address {
+	addrs := make([]types.Address, 0, len(precompiledAddresses))
+
+	for _, addr := range precompiledAddresses {
+		if isActive(addr, config) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
 // CanRun implements the runtime interface
 func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.ForksInTime) bool {
 	if _, ok := p.contracts[c.CodeAddress]; !ok {
 		return false
 	}
 
+	return isActive(c.CodeAddress, config)
+}
+
+// isActive checks if the precompiled contract at the address is enabled in the given forks
+func isActive(addr types.Address, config *chain.ForksInTime) bool {
 	// byzantium precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case five:
 		fallthrough
 	case six:
@@ -80,7 +110,7 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 	}
 
 	// istanbul precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case nine:
 		return config.Istanbul
 	}
@@ -0,0 +1,57 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/umbracle/ethgo/abi"
+)
+
+var (
+	// errorSelector is the selector of the Error(string) revert data, emitted by require and revert
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of the Panic(uint256) revert data, emitted by the compiler checks
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// panicReasons are the descriptions of the panic codes of the solidity compiler
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// DecodeRevert returns the reason of the revert data, either an Error(string) or a Panic(uint256).
+// It returns false for the other revert data, like the custom errors
+func DecodeRevert(data []byte) (string, bool) {
+	switch {
+	case bytes.HasPrefix(data, errorSelector):
+		reason, err := abi.UnpackRevertError(data)
+		if err != nil {
+			return "", false
+		}
+
+		return reason, true
+
+	case bytes.HasPrefix(data, panicSelector) && len(data) == len(panicSelector)+32:
+		code := new(big.Int).SetBytes(data[len(panicSelector):])
+
+		reason, ok := panicReasons[code.Uint64()]
+		if !code.IsUint64() || !ok {
+			reason = "unknown panic code"
+		}
+
+		return fmt.Sprintf("panic: %s (0x%02x)", reason, code), true
+
+	default:
+		return "", false
+	}
+}
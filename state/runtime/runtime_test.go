@@ -0,0 +1,87 @@
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionResult_UpdateGasUsed(t *testing.T) {
+	testTable := []struct {
+		name            string
+		config          *chain.ForksInTime
+		refund          uint64
+		expectedGasUsed uint64
+	}{
+		{
+			"refund below the cap",
+			&chain.ForksInTime{},
+			1000,
+			9000,
+		},
+		{
+			"refund capped to half the gas used",
+			&chain.ForksInTime{},
+			8000,
+			5000,
+		},
+		{
+			"refund capped to a fifth of the gas used from the london fork on",
+			&chain.ForksInTime{London: true},
+			8000,
+			8000,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := &ExecutionResult{GasLeft: 5000}
+			result.UpdateGasUsed(15000, testCase.refund, testCase.config)
+
+			assert.Equal(t, testCase.expectedGasUsed, result.GasUsed)
+			assert.Equal(t, 15000-testCase.expectedGasUsed, result.GasLeft)
+		})
+	}
+}
+
+func TestDecodeRevert(t *testing.T) {
+	// Error("revert reason")
+	errorData, err := hex.DecodeHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000d" +
+		"72657665727420726561736f6e00000000000000000000000000000000000000")
+	assert.NoError(t, err)
+
+	panicData := func(code string) []byte {
+		data, err := hex.DecodeHex("0x4e487b71" + strings.Repeat("0", 64-len(code)) + code)
+		assert.NoError(t, err)
+
+		return data
+	}
+
+	testTable := []struct {
+		name     string
+		data     []byte
+		reason   string
+		expected bool
+	}{
+		{"error string", errorData, "revert reason", true},
+		{"assertion panic", panicData("1"), "panic: assertion failed (0x01)", true},
+		{"overflow panic", panicData("11"), "panic: arithmetic underflow or overflow (0x11)", true},
+		{"unknown panic", panicData("ff"), "panic: unknown panic code (0xff)", true},
+		{"truncated panic", panicData("1")[:20], "", false},
+		{"custom error", []byte{0x01, 0x02, 0x03, 0x04}, "", false},
+		{"empty data", nil, "", false},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			reason, ok := DecodeRevert(testCase.data)
+			assert.Equal(t, testCase.expected, ok)
+			assert.Equal(t, testCase.reason, reason)
+		})
+	}
+}
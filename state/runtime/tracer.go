@@ -0,0 +1,61 @@
+package runtime
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Tracer receives the events of an execution, it is used to debug transactions.
+// All the hooks are called synchronously from the execution, so a tracer
+// must not keep references to the slices it receives
+type Tracer interface {
+	// CaptureStart is called before the top-level call of the transaction
+	CaptureStart(from, to types.Address, create bool, input []byte, gas uint64, value *big.Int)
+	// CaptureEnd is called after the top-level call of the transaction
+	CaptureEnd(output []byte, gasUsed uint64, err error)
+	// CaptureEnter is called before a nested call or contract creation
+	CaptureEnter(typ CallType, from, to types.Address, input []byte, gas uint64, value *big.Int)
+	// CaptureExit is called after a nested call or contract creation
+	CaptureExit(output []byte, gasUsed uint64, err error)
+	// CaptureState is called before an opcode is executed
+	CaptureState(step *TraceStep)
+	// CaptureStateEnd is called after the opcode of the last CaptureState is executed.
+	// Nested calls are traced in between, so the calls of both hooks are balanced
+	CaptureStateEnd(cost uint64, err error)
+	// CaptureStorage is called when an opcode reads or writes a storage slot
+	CaptureStorage(addr types.Address, key, value types.Hash)
+}
+
+// TraceStep is the state of the virtual machine before the execution of an opcode
+type TraceStep struct {
+	PC         uint64
+	Op         byte
+	OpName     string
+	Gas        uint64
+	Depth      int
+	Address    types.Address
+	Stack      []*big.Int
+	Memory     []byte
+	ReturnData []byte
+}
+
+// String returns the name of the call type as used by the tracers
+func (t CallType) String() string {
+	switch t {
+	case Call:
+		return "CALL"
+	case CallCode:
+		return "CALLCODE"
+	case DelegateCall:
+		return "DELEGATECALL"
+	case StaticCall:
+		return "STATICCALL"
+	case Create:
+		return "CREATE"
+	case Create2:
+		return "CREATE2"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -0,0 +1,146 @@
+package tracer
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// CallFrame is a call or contract creation in the tree of calls of a transaction
+type CallFrame struct {
+	Type         string       `json:"type"`
+	From         string       `json:"from"`
+	To           string       `json:"to,omitempty"`
+	Value        string       `json:"value,omitempty"`
+	Gas          string       `json:"gas"`
+	GasUsed      string       `json:"gasUsed"`
+	Input        string       `json:"input"`
+	Output       string       `json:"output,omitempty"`
+	Error        string       `json:"error,omitempty"`
+	RevertReason string       `json:"revertReason,omitempty"`
+	Calls        []*CallFrame `json:"calls,omitempty"`
+}
+
+// CallTracer builds the tree of calls of a transaction
+type CallTracer struct {
+	// callstack are the frames still in execution, the first one is the top-level call
+	callstack []*CallFrame
+}
+
+// NewCallTracer creates a new call tracer
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+func (c *CallTracer) CaptureStart(from, to types.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	typ := runtime.Call
+	if create {
+		typ = runtime.Create
+	}
+
+	c.callstack = []*CallFrame{newCallFrame(typ, from, to, input, gas, value)}
+}
+
+func (c *CallTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if len(c.callstack) == 0 {
+		return
+	}
+
+	c.callstack[0].finish(output, gasUsed, err)
+}
+
+func (c *CallTracer) CaptureEnter(
+	typ runtime.CallType,
+	from,
+	to types.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) {
+	c.callstack = append(c.callstack, newCallFrame(typ, from, to, input, gas, value))
+}
+
+func (c *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// the top-level call is only closed by CaptureEnd
+	if len(c.callstack) <= 1 {
+		return
+	}
+
+	frame := c.callstack[len(c.callstack)-1]
+	c.callstack = c.callstack[:len(c.callstack)-1]
+
+	frame.finish(output, gasUsed, err)
+
+	parent := c.callstack[len(c.callstack)-1]
+	parent.Calls = append(parent.Calls, frame)
+}
+
+func (c *CallTracer) CaptureState(*runtime.TraceStep) {
+}
+
+func (c *CallTracer) CaptureStateEnd(uint64, error) {
+}
+
+func (c *CallTracer) CaptureStorage(types.Address, types.Hash, types.Hash) {
+}
+
+// GetResult returns the top-level call frame
+func (c *CallTracer) GetResult() (interface{}, error) {
+	if len(c.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	return c.callstack[0], nil
+}
+
+func newCallFrame(
+	typ runtime.CallType,
+	from,
+	to types.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) *CallFrame {
+	frame := &CallFrame{
+		Type:  typ.String(),
+		From:  hex.EncodeToHex(from.Bytes()),
+		To:    hex.EncodeToHex(to.Bytes()),
+		Gas:   hex.EncodeUint64(gas),
+		Input: hex.EncodeToHex(input),
+	}
+
+	// static calls cannot transfer value
+	if typ != runtime.StaticCall && value != nil {
+		frame.Value = hex.EncodeBig(value)
+	}
+
+	return frame
+}
+
+func (f *CallFrame) finish(output []byte, gasUsed uint64, err error) {
+	f.GasUsed = hex.EncodeUint64(gasUsed)
+
+	if err == nil {
+		f.Output = hex.EncodeToHex(output)
+
+		return
+	}
+
+	f.Error = err.Error()
+
+	// a failed creation does not deploy any contract
+	if f.Type == runtime.Create.String() || f.Type == runtime.Create2.String() {
+		f.To = ""
+	}
+
+	if errors.Is(err, runtime.ErrExecutionReverted) && len(output) != 0 {
+		f.Output = hex.EncodeToHex(output)
+
+		if reason, ok := runtime.DecodeRevert(output); ok {
+			f.RevertReason = reason
+		}
+	}
+}
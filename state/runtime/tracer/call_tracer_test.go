@@ -0,0 +1,79 @@
+package tracer
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallTracer(t *testing.T) {
+	t.Parallel()
+
+	addr3 := types.StringToAddress("3")
+
+	// Error(string) with the "fail" reason
+	revertData := hex.MustDecodeHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6661696c00000000000000000000000000000000000000000000000000000000")
+
+	tracer := NewCallTracer()
+
+	tracer.CaptureStart(addr1, addr2, false, []byte{0x1}, 100000, big.NewInt(10))
+	tracer.CaptureEnter(runtime.StaticCall, addr2, addr3, nil, 5000, big.NewInt(0))
+	tracer.CaptureExit([]byte{0x2}, 100, nil)
+	tracer.CaptureEnter(runtime.Create2, addr2, addr3, []byte{0x3}, 6000, big.NewInt(1))
+	tracer.CaptureExit(revertData, 200, runtime.ErrExecutionReverted)
+	tracer.CaptureEnd(nil, 30000, nil)
+
+	result, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	res, err := json.Marshal(result)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002",
+		"value": "0xa",
+		"gas": "0x186a0",
+		"gasUsed": "0x7530",
+		"input": "0x01",
+		"output": "0x",
+		"calls": [
+			{
+				"type": "STATICCALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"gas": "0x1388",
+				"gasUsed": "0x64",
+				"input": "0x",
+				"output": "0x02"
+			},
+			{
+				"type": "CREATE2",
+				"from": "0x0000000000000000000000000000000000000002",
+				"value": "0x1",
+				"gas": "0x1770",
+				"gasUsed": "0xc8",
+				"input": "0x03",
+				"output": "`+hex.EncodeToHex(revertData)+`",
+				"error": "execution was reverted",
+				"revertReason": "fail"
+			}
+		]
+	}`, string(res))
+}
+
+func TestCallTracer_NoTopLevelCall(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCallTracer().GetResult()
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package tracer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	hexutil "github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// StructLog is the trace of the execution of a single opcode
+type StructLog struct {
+	PC         uint64             `json:"pc"`
+	Op         string             `json:"op"`
+	Gas        uint64             `json:"gas"`
+	GasCost    uint64             `json:"gasCost"`
+	Depth      int                `json:"depth"`
+	Error      string             `json:"error,omitempty"`
+	Stack      *[]string          `json:"stack,omitempty"`
+	ReturnData string             `json:"returnData,omitempty"`
+	Memory     *[]string          `json:"memory,omitempty"`
+	Storage    *map[string]string `json:"storage,omitempty"`
+}
+
+// StructLoggerResult is the result of the struct logger
+type StructLoggerResult struct {
+	Gas         uint64      `json:"gas"`
+	Failed      bool        `json:"failed"`
+	ReturnValue string      `json:"returnValue"`
+	StructLogs  []StructLog `json:"structLogs"`
+}
+
+// StructLogger records every opcode executed by the transaction
+type StructLogger struct {
+	config *Config
+
+	logs []StructLog
+	// pending are the indexes of the logs whose opcode is still in execution,
+	// -1 if the log was discarded because of the limit
+	pending []int
+	// storage are the slots accessed so far by each contract
+	storage map[types.Address]map[types.Hash]types.Hash
+
+	gasUsed uint64
+	output  []byte
+	err     error
+}
+
+// NewStructLogger creates a new struct logger
+func NewStructLogger(config *Config) *StructLogger {
+	return &StructLogger{
+		config:  config,
+		logs:    []StructLog{},
+		storage: map[types.Address]map[types.Hash]types.Hash{},
+	}
+}
+
+func (s *StructLogger) CaptureStart(types.Address, types.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (s *StructLogger) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	s.output = append([]byte{}, output...)
+	s.gasUsed = gasUsed
+	s.err = err
+}
+
+func (s *StructLogger) CaptureEnter(runtime.CallType, types.Address, types.Address, []byte, uint64, *big.Int) {
+}
+
+func (s *StructLogger) CaptureExit([]byte, uint64, error) {
+}
+
+func (s *StructLogger) CaptureState(step *runtime.TraceStep) {
+	if s.config.Limit != 0 && len(s.logs) >= s.config.Limit {
+		s.pending = append(s.pending, -1)
+
+		return
+	}
+
+	log := StructLog{
+		PC:    step.PC,
+		Op:    step.OpName,
+		Gas:   step.Gas,
+		Depth: step.Depth,
+	}
+
+	if log.Op == "" {
+		log.Op = fmt.Sprintf("opcode 0x%x not defined", step.Op)
+	}
+
+	if !s.config.DisableStack {
+		stack := make([]string, len(step.Stack))
+		for i, item := range step.Stack {
+			stack[i] = hexutil.EncodeBig(item)
+		}
+
+		log.Stack = &stack
+	}
+
+	if s.config.EnableMemory {
+		memory := make([]string, 0, len(step.Memory)/32)
+		for i := 0; i+32 <= len(step.Memory); i += 32 {
+			memory = append(memory, hex.EncodeToString(step.Memory[i:i+32]))
+		}
+
+		log.Memory = &memory
+	}
+
+	if s.config.EnableReturnData && len(step.ReturnData) != 0 {
+		log.ReturnData = hexutil.EncodeToHex(step.ReturnData)
+	}
+
+	s.logs = append(s.logs, log)
+	s.pending = append(s.pending, len(s.logs)-1)
+}
+
+func (s *StructLogger) CaptureStateEnd(cost uint64, err error) {
+	index := s.pending[len(s.pending)-1]
+	s.pending = s.pending[:len(s.pending)-1]
+
+	if index == -1 {
+		return
+	}
+
+	s.logs[index].GasCost = cost
+
+	if err != nil {
+		s.logs[index].Error = err.Error()
+	}
+}
+
+func (s *StructLogger) CaptureStorage(addr types.Address, key, value types.Hash) {
+	if s.config.DisableStorage {
+		return
+	}
+
+	slots, ok := s.storage[addr]
+	if !ok {
+		slots = map[types.Hash]types.Hash{}
+		s.storage[addr] = slots
+	}
+
+	slots[key] = value
+
+	if len(s.pending) == 0 {
+		return
+	}
+
+	// the storage is attached to the opcode accessing it
+	index := s.pending[len(s.pending)-1]
+	if index == -1 {
+		return
+	}
+
+	storage := make(map[string]string, len(slots))
+	for k, v := range slots {
+		storage[hex.EncodeToString(k.Bytes())] = hex.EncodeToString(v.Bytes())
+	}
+
+	s.logs[index].Storage = &storage
+}
+
+// GetResult returns the struct logs of the execution
+func (s *StructLogger) GetResult() (interface{}, error) {
+	return &StructLoggerResult{
+		Gas:         s.gasUsed,
+		Failed:      s.err != nil,
+		ReturnValue: hex.EncodeToString(s.output),
+		StructLogs:  s.logs,
+	}, nil
+}
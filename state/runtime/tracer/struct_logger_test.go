@@ -0,0 +1,124 @@
+package tracer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addr1 = types.StringToAddress("1")
+	addr2 = types.StringToAddress("2")
+)
+
+func TestStructLogger(t *testing.T) {
+	t.Parallel()
+
+	logger := NewStructLogger(&Config{EnableMemory: true})
+
+	logger.CaptureStart(addr1, addr2, false, nil, 100000, big.NewInt(0))
+
+	memory := make([]byte, 32)
+	memory[31] = 0x1
+
+	logger.CaptureState(&runtime.TraceStep{
+		PC:      10,
+		OpName:  "SSTORE",
+		Gas:     50000,
+		Depth:   1,
+		Address: addr2,
+		Stack:   []*big.Int{big.NewInt(1), big.NewInt(2)},
+		Memory:  memory,
+	})
+	logger.CaptureStorage(addr2, types.BytesToHash([]byte{0x2}), types.BytesToHash([]byte{0x1}))
+	logger.CaptureStateEnd(20000, nil)
+
+	logger.CaptureState(&runtime.TraceStep{
+		PC:    11,
+		Op:    0xfe,
+		Gas:   30000,
+		Depth: 1,
+	})
+	logger.CaptureStateEnd(0, errors.New("opcode not found"))
+
+	logger.CaptureEnd([]byte{0x1}, 70000, errors.New("opcode not found"))
+
+	result, err := logger.GetResult()
+	assert.NoError(t, err)
+
+	res, err := json.Marshal(result)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"gas": 70000,
+		"failed": true,
+		"returnValue": "01",
+		"structLogs": [
+			{
+				"pc": 10,
+				"op": "SSTORE",
+				"gas": 50000,
+				"gasCost": 20000,
+				"depth": 1,
+				"stack": ["0x1", "0x2"],
+				"memory": ["0000000000000000000000000000000000000000000000000000000000000001"],
+				"storage": {
+					"0000000000000000000000000000000000000000000000000000000000000002": "0000000000000000000000000000000000000000000000000000000000000001"
+				}
+			},
+			{
+				"pc": 11,
+				"op": "opcode 0xfe not defined",
+				"gas": 30000,
+				"gasCost": 0,
+				"depth": 1,
+				"error": "opcode not found",
+				"stack": [],
+				"memory": []
+			}
+		]
+	}`, string(res))
+}
+
+func TestStructLogger_NestedSteps(t *testing.T) {
+	t.Parallel()
+
+	logger := NewStructLogger(&Config{DisableStack: true})
+
+	// the cost of the call is known only after the steps of the nested call
+	logger.CaptureState(&runtime.TraceStep{PC: 0, OpName: "CALL", Gas: 1000, Depth: 1})
+	logger.CaptureState(&runtime.TraceStep{PC: 0, OpName: "STOP", Gas: 500, Depth: 2})
+	logger.CaptureStateEnd(0, nil)
+	logger.CaptureStateEnd(100, nil)
+
+	result, err := logger.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []StructLog{
+		{PC: 0, Op: "CALL", Gas: 1000, GasCost: 100, Depth: 1},
+		{PC: 0, Op: "STOP", Gas: 500, GasCost: 0, Depth: 2},
+	}, result.(*StructLoggerResult).StructLogs)
+}
+
+func TestStructLogger_Limit(t *testing.T) {
+	t.Parallel()
+
+	logger := NewStructLogger(&Config{DisableStack: true, Limit: 1})
+
+	for i := 0; i < 3; i++ {
+		logger.CaptureState(&runtime.TraceStep{PC: uint64(i), OpName: "PUSH1", Gas: 100, Depth: 1})
+		logger.CaptureStateEnd(3, nil)
+	}
+
+	result, err := logger.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []StructLog{
+		{PC: 0, Op: "PUSH1", Gas: 100, GasCost: 3, Depth: 1},
+	}, result.(*StructLoggerResult).StructLogs)
+}
@@ -0,0 +1,54 @@
+package tracer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+)
+
+var (
+	ErrUnknownTracer = errors.New("unknown tracer")
+)
+
+const (
+	// CallTracerName is the name of the tracer that builds the tree of calls
+	CallTracerName = "callTracer"
+)
+
+// Tracer is a runtime tracer that builds a result out of the execution events
+type Tracer interface {
+	runtime.Tracer
+
+	// GetResult returns the outcome of the trace, ready to be encoded as json
+	GetResult() (interface{}, error)
+}
+
+// Config are the options of a trace, the names follow the ones of the debug
+// namespace of the other ethereum clients
+type Config struct {
+	// Tracer is the name of the tracer, the struct logger is used if it is empty
+	Tracer           string `json:"tracer"`
+	EnableMemory     bool   `json:"enableMemory"`
+	DisableStack     bool   `json:"disableStack"`
+	DisableStorage   bool   `json:"disableStorage"`
+	EnableReturnData bool   `json:"enableReturnData"`
+	// Limit is the max number of struct logs, 0 means no limit
+	Limit int `json:"limit"`
+}
+
+// New creates the tracer selected in the config
+func New(config *Config) (Tracer, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	switch config.Tracer {
+	case "":
+		return NewStructLogger(config), nil
+	case CallTracerName:
+		return NewCallTracer(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTracer, config.Tracer)
+	}
+}
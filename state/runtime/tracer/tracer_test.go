@@ -0,0 +1,52 @@
+package tracer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      *Config
+		expected    Tracer
+		expectedErr error
+	}{
+		{
+			name:     "should use the struct logger by default",
+			config:   nil,
+			expected: &StructLogger{},
+		},
+		{
+			name:     "should create the call tracer",
+			config:   &Config{Tracer: CallTracerName},
+			expected: &CallTracer{},
+		},
+		{
+			name:        "should fail for an unknown tracer",
+			config:      &Config{Tracer: "prestateTracer"},
+			expectedErr: ErrUnknownTracer,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer, err := New(tt.config)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr))
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.IsType(t, tt.expected, tracer)
+		})
+	}
+}
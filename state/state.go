@@ -23,6 +23,12 @@ type Snapshot interface {
 	Commit(objs []*Object) (Snapshot, []byte)
 }
 
+// Prover is a state able to build the merkle proofs of its entries
+type Prover interface {
+	// Prove returns the encoded trie nodes on the path from the root to the key
+	Prove(root types.Hash, k []byte) ([][]byte, error)
+}
+
 // account trie
 type accountTrie interface {
 	Get(k []byte) ([]byte, bool)
@@ -1,10 +1,15 @@
 package state
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -159,3 +164,475 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionGasCost_AccessList(t *testing.T) {
+	t.Parallel()
+
+	txn := &types.Transaction{
+		Type: types.AccessListTx,
+		To:   &addr2,
+		AccessList: types.TxAccessList{
+			{Address: addr1, StorageKeys: []types.Hash{hash1, hash2}},
+			{Address: addr2},
+		},
+	}
+
+	cost, err := TransactionGasCost(txn, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 21000+2*TxAccessListAddressGas+2*TxAccessListStorageKeyGas, cost)
+}
+
+func TestCheckFees(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		txn           *types.Transaction
+		expectedErr   error
+		isRecoverable bool
+	}{
+		{
+			name:        "should accept a legacy transaction covering the base fee",
+			txn:         &types.Transaction{GasPrice: big.NewInt(10)},
+			expectedErr: nil,
+		},
+		{
+			name:          "should reject a legacy transaction below the base fee",
+			txn:           &types.Transaction{GasPrice: big.NewInt(9)},
+			expectedErr:   ErrFeeCapTooLow,
+			isRecoverable: true,
+		},
+		{
+			name: "should reject a dynamic fee transaction below the base fee",
+			txn: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasPrice:  big.NewInt(9),
+				GasTipCap: big.NewInt(1),
+			},
+			expectedErr:   ErrFeeCapTooLow,
+			isRecoverable: true,
+		},
+		{
+			name: "should reject a dynamic fee transaction with the tip above the fee cap",
+			txn: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasPrice:  big.NewInt(20),
+				GasTipCap: big.NewInt(21),
+			},
+			expectedErr:   ErrTipAboveFeeCap,
+			isRecoverable: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(nil)
+			transition.ctx.BaseFee = 10
+
+			err := transition.checkFees(tt.txn)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var appErr *TransitionApplicationError
+
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, tt.expectedErr, appErr.Err)
+			assert.Equal(t, tt.isRecoverable, appErr.IsRecoverable)
+		})
+	}
+}
+
+func TestApply_DynamicFee(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coinbase  = types.StringToAddress("3")
+		collector = types.StringToAddress("4")
+	)
+
+	tests := []struct {
+		name      string
+		collector *types.Address
+	}{
+		{
+			name:      "should burn the base fee",
+			collector: nil,
+		},
+		{
+			name:      "should send the base fee to the collector",
+			collector: &collector,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {
+					Balance: 1000000,
+				},
+			})
+			transition.r = &Executor{
+				config: &chain.Params{
+					BaseFeeCollector: tt.collector,
+				},
+				runtimes: []runtime.Runtime{evm.NewEVM()},
+			}
+			transition.config = chain.ForksInTime{
+				Homestead: true,
+				Istanbul:  true,
+				Berlin:    true,
+				London:    true,
+			}
+			transition.ctx.BaseFee = 10
+			transition.ctx.Coinbase = coinbase
+			transition.gasPool = 1000000
+
+			result, err := transition.apply(&types.Transaction{
+				Type:      types.DynamicFeeTx,
+				From:      addr1,
+				To:        &addr2,
+				Value:     big.NewInt(0),
+				Gas:       30000,
+				GasPrice:  big.NewInt(20),
+				GasTipCap: big.NewInt(3),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(21000), result.GasUsed)
+
+			// the sender pays the base fee plus the tip for the used gas
+			assert.Equal(t, big.NewInt(1000000-21000*13), transition.state.GetBalance(addr1))
+
+			// the coinbase only gets the tip
+			assert.Equal(t, big.NewInt(21000*3), transition.state.GetBalance(coinbase))
+
+			if tt.collector != nil {
+				assert.Equal(t, big.NewInt(21000*10), transition.state.GetBalance(collector))
+			}
+		})
+	}
+}
+
+func TestApply_RejectCodeStartingWithEF(t *testing.T) {
+	t.Parallel()
+
+	// PUSH1 0xEF PUSH1 0 MSTORE8 PUSH1 1 PUSH1 0 RETURN
+	initCode := []byte{0x60, 0xEF, 0x60, 0x00, 0x53, 0x60, 0x01, 0x60, 0x00, 0xF3}
+
+	tests := []struct {
+		name        string
+		config      chain.ForksInTime
+		expectedErr error
+	}{
+		{
+			name:        "should deploy the code before the london fork",
+			config:      chain.ForksInTime{Homestead: true, EIP158: true, Istanbul: true, Berlin: true},
+			expectedErr: nil,
+		},
+		{
+			name:        "should reject the code from the london fork on",
+			config:      chain.ForksInTime{Homestead: true, EIP158: true, Istanbul: true, Berlin: true, London: true},
+			expectedErr: runtime.ErrInvalidCode,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {
+					Balance: 1000000,
+				},
+			})
+			transition.r = &Executor{
+				config:   &chain.Params{},
+				runtimes: []runtime.Runtime{evm.NewEVM()},
+			}
+			transition.config = tt.config
+			transition.gasPool = 1000000
+
+			result, err := transition.apply(&types.Transaction{
+				From:     addr1,
+				Value:    big.NewInt(0),
+				Gas:      100000,
+				GasPrice: big.NewInt(0),
+				Input:    initCode,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedErr, result.Err)
+		})
+	}
+}
+
+func TestSelfdestruct_Refund(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		config         chain.ForksInTime
+		expectedRefund uint64
+	}{
+		{
+			name:           "should refund the selfdestruct before the london fork",
+			config:         chain.ForksInTime{Berlin: true},
+			expectedRefund: 24000,
+		},
+		{
+			name:           "should not refund the selfdestruct from the london fork on",
+			config:         chain.ForksInTime{Berlin: true, London: true},
+			expectedRefund: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(nil)
+			transition.config = tt.config
+
+			transition.Selfdestruct(addr1, addr2)
+			assert.Equal(t, tt.expectedRefund, transition.state.GetRefund())
+		})
+	}
+}
+
+func TestApply_Tracer(t *testing.T) {
+	t.Parallel()
+
+	callee := types.StringToAddress("3")
+
+	// CALL the callee with all the gas left, without value nor data
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}
+	code = append(code, callee.Bytes()...)
+	code = append(code, 0x5A, 0xF1, 0x00)
+
+	transition := newTestTransition(map[types.Address]*PreState{
+		addr1: {
+			Balance: 1000000,
+		},
+	})
+	transition.r = &Executor{
+		config:   &chain.Params{},
+		runtimes: []runtime.Runtime{evm.NewEVM()},
+	}
+	transition.config = chain.ForksInTime{Homestead: true, EIP150: true, Istanbul: true, Berlin: true}
+	transition.gasPool = 1000000
+	transition.state.SetCode(addr2, code)
+
+	callTracer := tracer.NewCallTracer()
+	transition.SetTracer(callTracer)
+
+	result, err := transition.apply(&types.Transaction{
+		From:     addr1,
+		To:       &addr2,
+		Value:    big.NewInt(0),
+		Gas:      100000,
+		GasPrice: big.NewInt(0),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+
+	res, err := callTracer.GetResult()
+	assert.NoError(t, err)
+
+	frame, ok := res.(*tracer.CallFrame)
+	assert.True(t, ok)
+	assert.Equal(t, "CALL", frame.Type)
+	assert.Equal(t, hex.EncodeToHex(addr2.Bytes()), frame.To)
+	assert.Equal(t, hex.EncodeUint64(result.GasUsed), frame.GasUsed)
+
+	// the nested call is traced as a child of the top-level call
+	assert.Len(t, frame.Calls, 1)
+	assert.Equal(t, "CALL", frame.Calls[0].Type)
+	assert.Equal(t, hex.EncodeToHex(addr2.Bytes()), frame.Calls[0].From)
+	assert.Equal(t, hex.EncodeToHex(callee.Bytes()), frame.Calls[0].To)
+}
+
+func TestApplyStateOverride(t *testing.T) {
+	t.Parallel()
+
+	// the committed storage is keyed by the hashed slot
+	preState := func() map[types.Address]*PreState {
+		return map[types.Address]*PreState{
+			addr1: {
+				Nonce:   1,
+				Balance: 100,
+				State: map[types.Hash]types.Hash{
+					types.BytesToHash(hashit(hash1.Bytes())): hash1,
+				},
+			},
+		}
+	}
+
+	nonce := uint64(5)
+	code := []byte{0x60, 0x00}
+
+	t.Run("should override the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTestTransition(preState())
+
+		assert.NoError(t, transition.ApplyStateOverride(types.StateOverride{
+			addr1: {
+				Nonce:     &nonce,
+				Balance:   big.NewInt(1000),
+				StateDiff: map[types.Hash]types.Hash{hash2: hash2},
+			},
+			addr2: {
+				Code: code,
+			},
+		}))
+
+		assert.Equal(t, nonce, transition.state.GetNonce(addr1))
+		assert.Equal(t, big.NewInt(1000), transition.state.GetBalance(addr1))
+		assert.Equal(t, code, transition.state.GetCode(addr2))
+
+		// the diff keeps the other slots
+		assert.Equal(t, hash1, transition.state.GetState(addr1, hash1))
+		assert.Equal(t, hash2, transition.state.GetState(addr1, hash2))
+	})
+
+	t.Run("should replace the whole storage", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTestTransition(preState())
+
+		assert.NoError(t, transition.ApplyStateOverride(types.StateOverride{
+			addr1: {
+				State: map[types.Hash]types.Hash{hash2: hash2},
+			},
+		}))
+
+		assert.Equal(t, types.ZeroHash, transition.state.GetState(addr1, hash1))
+		assert.Equal(t, hash2, transition.state.GetState(addr1, hash2))
+
+		// the other fields of the account are kept
+		assert.Equal(t, uint64(1), transition.state.GetNonce(addr1))
+		assert.Equal(t, big.NewInt(100), transition.state.GetBalance(addr1))
+	})
+
+	t.Run("should reject state along with stateDiff", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTestTransition(preState())
+
+		err := transition.ApplyStateOverride(types.StateOverride{
+			addr1: {
+				State:     map[types.Hash]types.Hash{hash2: hash2},
+				StateDiff: map[types.Hash]types.Hash{hash2: hash2},
+			},
+		})
+		assert.ErrorIs(t, err, types.ErrStateAndStateDiff)
+	})
+}
+
+func TestWrite_SystemTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key   = types.StringToHash("1")
+		value = types.StringToHash("2")
+
+		errHandler = errors.New("handler failed")
+	)
+
+	tests := []struct {
+		name        string
+		txn         *types.Transaction
+		handler     func(t *Transition, txn *types.Transaction) error
+		expectedErr error
+	}{
+		{
+			name: "should apply the system transaction with the handler",
+			txn:  types.NewSystemTx(big.NewInt(100), 1, addr2, nil),
+			handler: func(t *Transition, txn *types.Transaction) error {
+				t.Txn().SetState(*txn.To, key, value)
+
+				return nil
+			},
+		},
+		{
+			name: "should revert the changes of a failing handler",
+			txn:  types.NewSystemTx(big.NewInt(100), 1, addr2, nil),
+			handler: func(t *Transition, txn *types.Transaction) error {
+				t.Txn().SetState(*txn.To, key, value)
+
+				return errHandler
+			},
+			expectedErr: errHandler,
+		},
+		{
+			name:        "should fail without a handler",
+			txn:         types.NewSystemTx(big.NewInt(100), 1, addr2, nil),
+			expectedErr: ErrSystemTxNotSupported,
+		},
+		{
+			name: "should fail if not sent by the system address",
+			txn: func() *types.Transaction {
+				txn := types.NewSystemTx(big.NewInt(100), 1, addr2, nil)
+				txn.From = addr1
+
+				return txn
+			}(),
+			handler: func(t *Transition, txn *types.Transaction) error {
+				return nil
+			},
+			expectedErr: ErrInvalidSystemTxSender,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the called contract isn't an empty account cleaned up after the transaction
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr2: {
+					Nonce: 1,
+				},
+			})
+			transition.r = &Executor{
+				config:          &chain.Params{},
+				SystemTxHandler: tt.handler,
+			}
+			transition.config = chain.ForksInTime{
+				Byzantium: true,
+			}
+
+			err := transition.Write(tt.txn)
+
+			if tt.expectedErr != nil {
+				var appErr *TransitionApplicationError
+
+				assert.ErrorAs(t, err, &appErr)
+				assert.ErrorIs(t, appErr.Err, tt.expectedErr)
+				assert.False(t, appErr.IsRecoverable)
+				assert.Empty(t, transition.Receipts())
+				assert.Equal(t, types.ZeroHash, transition.state.GetState(addr2, key))
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, value, transition.state.GetState(addr2, key))
+
+			// system transactions use no gas
+			assert.Len(t, transition.Receipts(), 1)
+			assert.Equal(t, types.ReceiptSuccess, *transition.Receipts()[0].Status)
+			assert.Equal(t, uint64(0), transition.TotalGas())
+		})
+	}
+}
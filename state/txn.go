@@ -21,6 +21,9 @@ var (
 
 	// refundIndex is the index of the refund
 	refundIndex = types.BytesToHash([]byte{3}).Bytes()
+
+	// accessListIndex is the prefix of the warm addresses and storage slots (EIP-2929)
+	accessListIndex = types.BytesToHash([]byte{4}).Bytes()
 )
 
 // Txn is a reference of the state
@@ -278,13 +281,19 @@ func (txn *Txn) SetStorage(
 		return runtime.StorageModified
 	}
 
+	// eip-3529, SSTORE_RESET - COLD_SLOAD + ACCESS_LIST_STORAGE_KEY
+	clearsRefund := uint64(15000)
+	if config.London {
+		clearsRefund = 4800
+	}
+
 	if original == current {
 		if original == zeroHash { // create slot (2.1.1)
 			return runtime.StorageAdded
 		}
 
 		if value == zeroHash { // delete slot (2.1.2b)
-			txn.AddRefund(15000)
+			txn.AddRefund(clearsRefund)
 
 			return runtime.StorageDeleted
 		}
@@ -294,22 +303,28 @@ func (txn *Txn) SetStorage(
 
 	if original != zeroHash { // Storage slot was populated before this transaction started
 		if current == zeroHash { // recreate slot (2.2.1.1)
-			txn.SubRefund(15000)
+			txn.SubRefund(clearsRefund)
 		} else if value == zeroHash { // delete slot (2.2.1.2)
-			txn.AddRefund(15000)
+			txn.AddRefund(clearsRefund)
 		}
 	}
 
 	if original == value {
 		if original == zeroHash { // reset to original nonexistent slot (2.2.2.1)
 			// Storage was used as memory (allocation and deallocation occurred within the same contract)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929, SSTORE_SET - WARM_STORAGE_READ
+				txn.AddRefund(19900)
+			} else if config.Istanbul {
 				txn.AddRefund(19200)
 			} else {
 				txn.AddRefund(19800)
 			}
 		} else { // reset to original existing slot (2.2.2.2)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929, SSTORE_RESET - COLD_SLOAD - WARM_STORAGE_READ
+				txn.AddRefund(2800)
+			} else if config.Istanbul {
 				txn.AddRefund(4200)
 			} else {
 				txn.AddRefund(4800)
@@ -339,6 +354,21 @@ func (txn *Txn) SetState(
 	})
 }
 
+// SetFullState replaces the whole storage of an address, the slots not given are empty
+func (txn *Txn) SetFullState(addr types.Address, storage map[types.Hash]types.Hash) {
+	txn.upsertAccount(addr, true, func(object *StateObject) {
+		object.Account.Root = emptyStateHash
+		object.Account.Trie = txn.state.NewSnapshot()
+		object.Txn = iradix.New().Txn()
+
+		for key, value := range storage {
+			if value != zeroHash {
+				object.Txn.Insert(key.Bytes(), value.Bytes())
+			}
+		}
+	})
+}
+
 // GetState returns the state of the address at a given key
 func (txn *Txn) GetState(addr types.Address, key types.Hash) types.Hash {
 	object, exists := txn.getStateObject(addr)
@@ -496,6 +526,51 @@ func (txn *Txn) GetRefund() uint64 {
 	return data.(uint64)
 }
 
+// Access list (EIP-2929)
+//
+// The warm addresses and slots are kept in the radix tree with
+// the rest of the transient state, so they are reverted with the snapshots
+
+func accessListKey(addr types.Address, slot *types.Hash) []byte {
+	key := append(append([]byte{}, accessListIndex...), addr.Bytes()...)
+	if slot != nil {
+		key = append(key, slot.Bytes()...)
+	}
+
+	return key
+}
+
+// AddressInAccessList checks if the address is warm
+func (txn *Txn) AddressInAccessList(addr types.Address) bool {
+	_, ok := txn.txn.Get(accessListKey(addr, nil))
+
+	return ok
+}
+
+// SlotInAccessList checks if the address and the storage slot are warm
+func (txn *Txn) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	_, addrOk := txn.txn.Get(accessListKey(addr, nil))
+	_, slotOk := txn.txn.Get(accessListKey(addr, &slot))
+
+	return addrOk, slotOk
+}
+
+// AddAddressToAccessList marks the address as warm
+func (txn *Txn) AddAddressToAccessList(addr types.Address) {
+	txn.txn.Insert(accessListKey(addr, nil), true)
+}
+
+// AddSlotToAccessList marks the address and the storage slot as warm
+func (txn *Txn) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	txn.AddAddressToAccessList(addr)
+	txn.txn.Insert(accessListKey(addr, &slot), true)
+}
+
+// ClearAccessList cools down all the addresses and storage slots
+func (txn *Txn) ClearAccessList() {
+	txn.txn.DeletePrefix(accessListIndex)
+}
+
 // GetCommittedState returns the state of the address in the trie
 func (txn *Txn) GetCommittedState(addr types.Address, key types.Hash) types.Hash {
 	obj, ok := txn.getStateObject(addr)
@@ -7,7 +7,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/umbracle/fastrlp"
@@ -139,6 +141,66 @@ func TestSnapshotUpdateData(t *testing.T) {
 	assert.Equal(t, hash1, txn.GetState(addr1, hash1))
 }
 
+func TestSnapshotAccessList(t *testing.T) {
+	txn := newTestTxn(defaultPreState)
+
+	txn.AddAddressToAccessList(addr1)
+	assert.True(t, txn.AddressInAccessList(addr1))
+
+	ss := txn.Snapshot()
+	txn.AddSlotToAccessList(addr2, hash1)
+
+	addrOk, slotOk := txn.SlotInAccessList(addr2, hash1)
+	assert.True(t, addrOk)
+	assert.True(t, slotOk)
+
+	txn.RevertToSnapshot(ss)
+
+	addrOk, slotOk = txn.SlotInAccessList(addr2, hash1)
+	assert.False(t, addrOk)
+	assert.False(t, slotOk)
+	assert.True(t, txn.AddressInAccessList(addr1))
+
+	txn.ClearAccessList()
+	assert.False(t, txn.AddressInAccessList(addr1))
+}
+
+func TestSetStorage_ClearsRefund(t *testing.T) {
+	testTable := []struct {
+		name           string
+		config         *chain.ForksInTime
+		expectedRefund uint64
+	}{
+		{
+			"istanbul refund for clearing a slot",
+			&chain.ForksInTime{Petersburg: true, Istanbul: true},
+			15000,
+		},
+		{
+			"reduced london refund for clearing a slot",
+			&chain.ForksInTime{Petersburg: true, Istanbul: true, Berlin: true, London: true},
+			4800,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// the committed storage is keyed by the hashed slot
+			txn := newTestTxn(map[types.Address]*PreState{
+				addr1: {
+					State: map[types.Hash]types.Hash{
+						types.BytesToHash(hashit(hash1.Bytes())): hash1,
+					},
+				},
+			})
+
+			status := txn.SetStorage(addr1, hash1, types.ZeroHash, testCase.config)
+			assert.Equal(t, runtime.StorageDeleted, status)
+			assert.Equal(t, testCase.expectedRefund, txn.GetRefund())
+		})
+	}
+}
+
 func hashit(k []byte) []byte {
 	h := sha3.NewLegacyKeccak256()
 	h.Write(k)
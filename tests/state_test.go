@@ -170,3 +170,47 @@ func TestState(t *testing.T) {
 		})
 	}
 }
+
+func TestState_TypedTransaction(t *testing.T) {
+	t.Parallel()
+
+	// transaction section of a london state test
+	data := []byte(`{
+		"data": ["0x", "0x01"],
+		"gasLimit": ["0x5208"],
+		"value": ["0x00"],
+		"maxFeePerGas": "0x0a",
+		"maxPriorityFeePerGas": "0x02",
+		"nonce": "0x00",
+		"secretKey": "0x45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8",
+		"to": "0x095e7baea6a6c7c4c2dfeb977efac326af552d87",
+		"accessLists": [
+			null,
+			[{"address": "0x095e7baea6a6c7c4c2dfeb977efac326af552d87", "storageKeys": []}]
+		]
+	}`)
+
+	var txn stTransaction
+	if err := json.Unmarshal(data, &txn); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range txn.Data {
+		msg, err := txn.At(indexes{Data: i})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if msg.Type != types.DynamicFeeTx {
+			t.Fatalf("expected a dynamic fee transaction but found %s", msg.Type)
+		}
+
+		if msg.GasPrice.Uint64() != 10 || msg.GasTipCap.Uint64() != 2 {
+			t.Fatalf("unexpected fees %s %s", msg.GasPrice, msg.GasTipCap)
+		}
+
+		if len(msg.AccessList) != i {
+			t.Fatalf("expected %d access list entries but found %d", i, len(msg.AccessList))
+		}
+	}
+}
@@ -36,6 +36,7 @@ type env struct {
 	GasLimit   string `json:"currentGasLimit"`
 	Number     string `json:"currentNumber"`
 	Timestamp  string `json:"currentTimestamp"`
+	BaseFee    string `json:"currentBaseFee"`
 }
 
 func remove0xPrefix(str string) string {
@@ -144,9 +145,21 @@ func (e *env) ToHeader(t *testing.T) *types.Header {
 		GasLimit:   stringToUint64T(t, e.GasLimit),
 		Number:     stringToUint64T(t, e.Number),
 		Timestamp:  stringToUint64T(t, e.Timestamp),
+		BaseFee:    e.baseFee(t),
 	}
 }
 
+// baseFee returns the base fee of the environment, which is only set for the london tests
+func (e *env) baseFee(t *testing.T) uint64 {
+	t.Helper()
+
+	if e.BaseFee == "" {
+		return 0
+	}
+
+	return stringToUint64T(t, e.BaseFee)
+}
+
 func (e *env) ToEnv(t *testing.T) runtime.TxContext {
 	t.Helper()
 
@@ -156,6 +169,7 @@ func (e *env) ToEnv(t *testing.T) runtime.TxContext {
 		GasLimit:   stringToInt64T(t, e.GasLimit),
 		Number:     stringToInt64T(t, e.Number),
 		Timestamp:  stringToInt64T(t, e.Timestamp),
+		BaseFee:    e.baseFee(t),
 	}
 }
 
@@ -289,6 +303,11 @@ type stTransaction struct {
 	Nonce    uint64         `json:"nonce"`
 	From     types.Address  `json:"secretKey"`
 	To       *types.Address `json:"to"`
+
+	// typed transactions (berlin and london tests)
+	AccessLists          []*types.TxAccessList `json:"accessLists"`
+	MaxFeePerGas         *big.Int              `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *big.Int              `json:"maxPriorityFeePerGas"`
 }
 
 func (t *stTransaction) At(i indexes) (*types.Transaction, error) {
@@ -315,18 +334,37 @@ func (t *stTransaction) At(i indexes) (*types.Transaction, error) {
 
 	msg.From = t.From
 
+	// the access list is picked by the data index
+	if i.Data < len(t.AccessLists) && t.AccessLists[i.Data] != nil {
+		msg.Type = types.AccessListTx
+		msg.AccessList = t.AccessLists[i.Data].Copy()
+	}
+
+	if t.MaxFeePerGas != nil {
+		msg.Type = types.DynamicFeeTx
+		msg.GasPrice = new(big.Int).Set(t.MaxFeePerGas)
+		msg.GasTipCap = new(big.Int).Set(t.MaxPriorityFeePerGas)
+	}
+
+	if msg.IsTyped() {
+		msg.ChainID = big.NewInt(1)
+	}
+
 	return msg, nil
 }
 
 func (t *stTransaction) UnmarshalJSON(input []byte) error {
 	type txUnmarshall struct {
-		Data      []string `json:"data"`
-		GasLimit  []string `json:"gasLimit"`
-		Value     []string `json:"value"`
-		GasPrice  string   `json:"gasPrice"`
-		Nonce     string   `json:"nonce"`
-		SecretKey string   `json:"secretKey"`
-		To        string   `json:"to"`
+		Data                 []string              `json:"data"`
+		GasLimit             []string              `json:"gasLimit"`
+		Value                []string              `json:"value"`
+		GasPrice             string                `json:"gasPrice"`
+		Nonce                string                `json:"nonce"`
+		SecretKey            string                `json:"secretKey"`
+		To                   string                `json:"to"`
+		AccessLists          []*types.TxAccessList `json:"accessLists"`
+		MaxFeePerGas         string                `json:"maxFeePerGas"`
+		MaxPriorityFeePerGas string                `json:"maxPriorityFeePerGas"`
 	}
 
 	var dec txUnmarshall
@@ -362,11 +400,23 @@ func (t *stTransaction) UnmarshalJSON(input []byte) error {
 		t.Value = append(t.Value, value)
 	}
 
-	t.GasPrice, err = stringToBigInt(dec.GasPrice)
-	if err != nil {
+	// dynamic fee transactions have no gas price
+	if dec.MaxFeePerGas != "" {
+		if t.MaxFeePerGas, err = stringToBigInt(dec.MaxFeePerGas); err != nil {
+			return err
+		}
+
+		if t.MaxPriorityFeePerGas, err = stringToBigInt(dec.MaxPriorityFeePerGas); err != nil {
+			return err
+		}
+
+		t.GasPrice = new(big.Int).Set(t.MaxFeePerGas)
+	} else if t.GasPrice, err = stringToBigInt(dec.GasPrice); err != nil {
 		return err
 	}
 
+	t.AccessLists = dec.AccessLists
+
 	t.Nonce, err = stringToUint64(dec.Nonce)
 	if err != nil {
 		return err
@@ -438,6 +488,29 @@ var Forks = map[string]*chain.Forks{
 		Petersburg:     chain.NewFork(0),
 		Istanbul:       chain.NewFork(0),
 	},
+	"Berlin": {
+		Homestead:      chain.NewFork(0),
+		EIP150:         chain.NewFork(0),
+		EIP155:         chain.NewFork(0),
+		EIP158:         chain.NewFork(0),
+		Byzantium:      chain.NewFork(0),
+		Constantinople: chain.NewFork(0),
+		Petersburg:     chain.NewFork(0),
+		Istanbul:       chain.NewFork(0),
+		Berlin:         chain.NewFork(0),
+	},
+	"London": {
+		Homestead:      chain.NewFork(0),
+		EIP150:         chain.NewFork(0),
+		EIP155:         chain.NewFork(0),
+		EIP158:         chain.NewFork(0),
+		Byzantium:      chain.NewFork(0),
+		Constantinople: chain.NewFork(0),
+		Petersburg:     chain.NewFork(0),
+		Istanbul:       chain.NewFork(0),
+		Berlin:         chain.NewFork(0),
+		London:         chain.NewFork(0),
+	},
 	"FrontierToHomesteadAt5": {
 		Homestead: chain.NewFork(5),
 	},
@@ -11,6 +11,15 @@ import (
 type Metrics struct {
 	// Pending transactions
 	PendingTxs metrics.Gauge
+
+	// Gossiped transactions dropped because of the per-peer rate limit
+	GossipRateLimitedTxs metrics.Counter
+
+	// Invalid transactions received through gossip
+	GossipInvalidTxs metrics.Counter
+
+	// Peers disconnected because of their gossip score
+	GossipDisconnectedPeers metrics.Counter
 }
 
 // GetPrometheusMetrics return the txpool metrics instance
@@ -28,12 +37,33 @@ func GetPrometheusMetrics(namespace string, labelsWithValues ...string) *Metrics
 			Name:      "pending_transactions",
 			Help:      "Pending transactions in the pool",
 		}, labels).With(labelsWithValues...),
+		GossipRateLimitedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "txpool",
+			Name:      "gossip_rate_limited_transactions",
+			Help:      "Gossiped transactions dropped because of the per-peer rate limit",
+		}, labels).With(labelsWithValues...),
+		GossipInvalidTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "txpool",
+			Name:      "gossip_invalid_transactions",
+			Help:      "Invalid transactions received through gossip",
+		}, labels).With(labelsWithValues...),
+		GossipDisconnectedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "txpool",
+			Name:      "gossip_disconnected_peers",
+			Help:      "Peers disconnected because of their transaction gossip score",
+		}, labels).With(labelsWithValues...),
 	}
 }
 
 // NilMetrics will return the non operational txpool metrics
 func NilMetrics() *Metrics {
 	return &Metrics{
-		PendingTxs: discard.NewGauge(),
+		PendingTxs:              discard.NewGauge(),
+		GossipRateLimitedTxs:    discard.NewCounter(),
+		GossipInvalidTxs:        discard.NewCounter(),
+		GossipDisconnectedPeers: discard.NewCounter(),
 	}
 }
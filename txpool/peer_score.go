@@ -0,0 +1,118 @@
+package txpool
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/ratelimit"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	// DefaultGossipRateLimit is the default number of gossiped
+	// transactions accepted from a single peer per second (0 means unlimited).
+	// The sender of a gossip message is the peer relaying it, so honest peers
+	// forwarding a busy network can hit the limit: it is disabled by default
+	DefaultGossipRateLimit = uint64(0)
+
+	// score changes for the gossip behavior of a peer
+	validGossipReward      = int64(1)
+	duplicateGossipPenalty = int64(-1)
+	invalidGossipPenalty   = int64(-10)
+
+	// bounds of the peer score.
+	// Once a peer drops below minPeerScore it gets disconnected
+	maxPeerScore = int64(100)
+	minPeerScore = int64(-100)
+)
+
+// invalidGossipErrors are the txpool errors caused by a transaction
+// which could never be valid, so the peer relaying it gets penalized.
+// Other errors (nonce too low, pool overflow...) can happen to honest peers too
+var invalidGossipErrors = []error{
+	ErrIntrinsicGas,
+	ErrBlockLimitExceeded,
+	ErrNegativeValue,
+	ErrExtractSignature,
+	ErrInvalidSender,
+	ErrOversizedData,
+}
+
+// isInvalidGossipErr checks if the error returned when adding
+// a gossiped transaction should be blamed on the relaying peer
+func isInvalidGossipErr(err error) bool {
+	for _, invalidErr := range invalidGossipErrors {
+		if errors.Is(err, invalidErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerDisconnector is the networking layer method used for
+// dropping connections with misbehaving peers
+type peerDisconnector interface {
+	DisconnectFromPeer(peer peer.ID, reason string)
+}
+
+// gossipPeers rate limits gossiped transactions per peer
+// and keeps the reputation score of each peer
+type gossipPeers struct {
+	sync.Mutex
+
+	// reputation of the peers
+	scores map[peer.ID]int64
+
+	// limiter of the transactions relayed by each peer
+	limiter *ratelimit.Limiter
+
+	// number of transactions accepted per second from a single peer (0 means unlimited)
+	rateLimit uint64
+}
+
+func newGossipPeers(rateLimit uint64) *gossipPeers {
+	return &gossipPeers{
+		scores:    make(map[peer.ID]int64),
+		limiter:   ratelimit.NewLimiter(),
+		rateLimit: rateLimit,
+	}
+}
+
+// allow checks if the peer is within its rate limit, and consumes one token if it is.
+// A burst of up to one second worth of transactions is allowed
+func (g *gossipPeers) allow(id peer.ID) bool {
+	return g.limiter.Allow(string(id), g.rateLimit)
+}
+
+// updateScore applies the delta to the peer score and returns the new score
+func (g *gossipPeers) updateScore(id peer.ID, delta int64) int64 {
+	g.Lock()
+	defer g.Unlock()
+
+	score := g.scores[id] + delta
+	if score > maxPeerScore {
+		score = maxPeerScore
+	}
+
+	g.scores[id] = score
+
+	return score
+}
+
+// score returns the current score of the peer
+func (g *gossipPeers) score(id peer.ID) int64 {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.scores[id]
+}
+
+// remove clears the gossip state of the peer
+func (g *gossipPeers) remove(id peer.ID) {
+	g.Lock()
+	delete(g.scores, id)
+	g.Unlock()
+
+	g.limiter.Remove(string(id))
+}
@@ -0,0 +1,125 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testPeer = peer.ID("test-peer")
+)
+
+type mockDisconnector struct {
+	disconnected []peer.ID
+}
+
+func (m *mockDisconnector) DisconnectFromPeer(peer peer.ID, reason string) {
+	m.disconnected = append(m.disconnected, peer)
+}
+
+func TestGossipPeers_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	peers := newGossipPeers(2)
+
+	// the initial burst is allowed
+	assert.True(t, peers.allow(testPeer))
+	assert.True(t, peers.allow(testPeer))
+	assert.False(t, peers.allow(testPeer))
+
+	// other peers have their own limit
+	assert.True(t, peers.allow(peer.ID("other-peer")))
+
+	// the limit is reset once the peer is removed
+	peers.remove(testPeer)
+	assert.True(t, peers.allow(testPeer))
+
+	// no limit is applied when the rate limit is 0
+	unlimited := newGossipPeers(0)
+	for i := 0; i < 1000; i++ {
+		assert.True(t, unlimited.allow(testPeer))
+	}
+}
+
+func TestGossipPeers_Score(t *testing.T) {
+	t.Parallel()
+
+	peers := newGossipPeers(0)
+
+	for i := int64(0); i < 2*maxPeerScore; i++ {
+		peers.updateScore(testPeer, validGossipReward)
+	}
+
+	// the score is capped
+	assert.Equal(t, maxPeerScore, peers.score(testPeer))
+	assert.Equal(t, maxPeerScore+invalidGossipPenalty, peers.updateScore(testPeer, invalidGossipPenalty))
+
+	peers.remove(testPeer)
+	assert.Equal(t, int64(0), peers.score(testPeer))
+}
+
+func TestAddGossipTx_PeerScore(t *testing.T) {
+	t.Parallel()
+
+	malformedTx := &proto.Txn{}
+
+	t.Run("peer sending invalid txs is disconnected", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+
+		disconnector := &mockDisconnector{}
+		pool.disconnector = disconnector
+		pool.sealing = true
+
+		steps := int(minPeerScore / invalidGossipPenalty)
+		for i := 0; i < steps; i++ {
+			pool.addGossipTx(malformedTx, testPeer)
+		}
+
+		assert.Equal(t, minPeerScore, pool.gossipPeers.score(testPeer))
+		assert.Len(t, disconnector.disconnected, 0)
+
+		pool.addGossipTx(malformedTx, testPeer)
+
+		assert.Equal(t, []peer.ID{testPeer}, disconnector.disconnected)
+
+		// the peer state is cleared after the disconnect
+		assert.Equal(t, int64(0), pool.gossipPeers.score(testPeer))
+	})
+
+	t.Run("rate limited txs are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+
+		pool.sealing = true
+		pool.gossipPeers = newGossipPeers(1)
+
+		// the first message consumes the only token
+		pool.addGossipTx(malformedTx, testPeer)
+		assert.Equal(t, invalidGossipPenalty, pool.gossipPeers.score(testPeer))
+
+		// the relaying peer isn't scored down for exceeding the limit
+		pool.addGossipTx(malformedTx, testPeer)
+		assert.Equal(t, invalidGossipPenalty, pool.gossipPeers.score(testPeer))
+	})
+
+	t.Run("local messages are not scored", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+
+		pool.sealing = true
+		pool.localPeerID = testPeer
+
+		pool.addGossipTx(malformedTx, testPeer)
+		assert.Equal(t, int64(0), pool.gossipPeers.score(testPeer))
+	})
+}
@@ -157,7 +157,9 @@ type pricedQueue struct {
 
 func newPricedQueue() *pricedQueue {
 	q := pricedQueue{
-		queue: make(maxPriceQueue, 0),
+		queue: maxPriceQueue{
+			txs: make([]*types.Transaction, 0),
+		},
 	}
 
 	heap.Init(&q.queue)
@@ -167,7 +169,13 @@ func newPricedQueue() *pricedQueue {
 
 // clear empties the underlying queue.
 func (q *pricedQueue) clear() {
-	q.queue = q.queue[:0]
+	q.queue.txs = q.queue.txs[:0]
+}
+
+// setBaseFee sets the base fee used for sorting the transactions.
+// Should only be called on an empty queue
+func (q *pricedQueue) setBaseFee(baseFee uint64) {
+	q.queue.baseFee = baseFee
 }
 
 // Pushes the given transactions onto the queue.
@@ -195,8 +203,12 @@ func (q *pricedQueue) length() uint64 {
 	return uint64(q.queue.Len())
 }
 
-// transactions sorted by gas price (descending)
-type maxPriceQueue []*types.Transaction
+// transactions sorted by the tip paid on top of the base fee (descending).
+// Without a base fee, this is the same as sorting by gas price
+type maxPriceQueue struct {
+	baseFee uint64
+	txs     []*types.Transaction
+}
 
 /* Queue methods required by the heap interface */
 
@@ -205,19 +217,19 @@ func (q *maxPriceQueue) Peek() *types.Transaction {
 		return nil
 	}
 
-	return (*q)[0]
+	return q.txs[0]
 }
 
 func (q *maxPriceQueue) Len() int {
-	return len(*q)
+	return len(q.txs)
 }
 
 func (q *maxPriceQueue) Swap(i, j int) {
-	(*q)[i], (*q)[j] = (*q)[j], (*q)[i]
+	q.txs[i], q.txs[j] = q.txs[j], q.txs[i]
 }
 
 func (q *maxPriceQueue) Less(i, j int) bool {
-	return (*q)[i].GasPrice.Uint64() > (*q)[j].GasPrice.Uint64()
+	return q.txs[i].EffectiveTip(q.baseFee).Cmp(q.txs[j].EffectiveTip(q.baseFee)) > 0
 }
 
 func (q *maxPriceQueue) Push(x interface{}) {
@@ -226,14 +238,14 @@ func (q *maxPriceQueue) Push(x interface{}) {
 		return
 	}
 
-	*q = append(*q, transaction)
+	q.txs = append(q.txs, transaction)
 }
 
 func (q *maxPriceQueue) Pop() interface{} {
-	old := q
-	n := len(*old)
-	x := (*old)[n-1]
-	*q = (*old)[0 : n-1]
+	old := q.txs
+	n := len(old)
+	x := old[n-1]
+	q.txs = old[0 : n-1]
 
 	return x
 }
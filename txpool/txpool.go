@@ -4,14 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"google.golang.org/grpc"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
+	peerEvent "github.com/0xPolygon/polygon-edge/network/event"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -41,6 +44,8 @@ var (
 	ErrInvalidAccountState = errors.New("invalid account state")
 	ErrAlreadyKnown        = errors.New("already known")
 	ErrOversizedData       = errors.New("oversized data")
+	ErrTxTypeNotSupported  = types.ErrTxTypeNotSupported
+	ErrTipAboveFeeCap      = errors.New("max priority fee per gas higher than max fee per gas")
 )
 
 // indicates origin of a transaction
@@ -78,9 +83,10 @@ type signer interface {
 }
 
 type Config struct {
-	PriceLimit uint64
-	MaxSlots   uint64
-	Sealing    bool
+	PriceLimit      uint64
+	MaxSlots        uint64
+	Sealing         bool
+	GossipRateLimit uint64
 }
 
 /* All requests are passed to the main loop
@@ -123,7 +129,7 @@ type promoteRequest struct {
 type TxPool struct {
 	logger hclog.Logger
 	signer signer
-	forks  chain.ForksInTime
+	forks  *chain.Forks
 	store  store
 
 	// map of all accounts registered by the pool
@@ -139,9 +145,22 @@ type TxPool struct {
 	// networking stack
 	topic *network.Topic
 
+	// rate limits and reputation scores of the gossiping peers
+	gossipPeers *gossipPeers
+
+	// networking layer used for disconnecting misbehaving peers
+	disconnector peerDisconnector
+
+	// ID of the local node, whose own published messages are not scored
+	localPeerID peer.ID
+
 	// gauge for measuring pool capacity
 	gauge slotGauge
 
+	// gas limit of all promoted transactions,
+	// kept up to date so the consensus can poll it cheaply
+	pendingGas uint64
+
 	// priceLimit is a lower threshold for gas price
 	priceLimit uint64
 
@@ -170,7 +189,7 @@ type TxPool struct {
 // NewTxPool returns a new pool for processing incoming transactions.
 func NewTxPool(
 	logger hclog.Logger,
-	forks chain.ForksInTime,
+	forks *chain.Forks,
 	store store,
 	grpcServer *grpc.Server,
 	network *network.Server,
@@ -188,6 +207,7 @@ func NewTxPool(
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
 		sealing:     config.Sealing,
+		gossipPeers: newGossipPeers(config.GossipRateLimit),
 	}
 
 	// Attach the event manager
@@ -205,6 +225,17 @@ func NewTxPool(
 		}
 
 		pool.topic = topic
+		pool.disconnector = network
+		pool.localPeerID = network.AddrInfo().ID
+
+		// forget the gossip state of peers once they disconnect
+		if subscribeErr := network.SubscribeFn(func(event *peerEvent.PeerEvent) {
+			if event.Type == peerEvent.PeerDisconnected {
+				pool.gossipPeers.remove(event.PeerID)
+			}
+		}); subscribeErr != nil {
+			return nil, fmt.Errorf("unable to subscribe to peer events, %w", subscribeErr)
+		}
 	}
 
 	if grpcServer != nil {
@@ -280,12 +311,15 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 
 // Prepare generates all the transactions
 // ready for execution. (primaries)
-func (p *TxPool) Prepare() {
+// The transactions are sorted by the tip they pay on top of the given base fee
+func (p *TxPool) Prepare(baseFee uint64) {
 	// clear from previous round
 	if p.executables.length() != 0 {
 		p.executables.clear()
 	}
 
+	p.executables.setBaseFee(baseFee)
+
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
@@ -326,6 +360,7 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 
 	// update state
 	p.gauge.decrease(slotsRequired(tx))
+	p.decreasePendingGas(tx)
 
 	// update metrics
 	p.metrics.PendingTxs.Add(-1)
@@ -369,6 +404,7 @@ func (p *TxPool) Drop(tx *types.Transaction) {
 	// drop promoted
 	dropped := account.promoted.clear()
 	clearAccountQueue(dropped)
+	p.decreasePendingGas(dropped...)
 
 	// update metrics
 	p.metrics.PendingTxs.Add(float64(-1 * len(dropped)))
@@ -504,6 +540,21 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrNegativeValue
 	}
 
+	// Grab the latest block header, the transaction
+	// is validated against the rules of the next block
+	header := p.store.Header()
+	forks := p.forks.At(header.Number + 1)
+
+	// Check if the transaction type is supported
+	if !state.IsTxTypeSupported(tx.Type, forks) {
+		return ErrTxTypeNotSupported
+	}
+
+	// Check if the tip of a dynamic fee transaction is within its fee cap
+	if tx.IsDynamicFee() && tx.GasTipCap.Cmp(tx.GasPrice) > 0 {
+		return ErrTipAboveFeeCap
+	}
+
 	// Check if the transaction is signed properly
 
 	// Extract the sender
@@ -530,7 +581,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Grab the state root for the latest block
-	stateRoot := p.store.Header().StateRoot
+	stateRoot := header.StateRoot
 
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
@@ -548,7 +599,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, p.forks.Homestead, p.forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul)
 	if err != nil {
 		return err
 	}
@@ -558,7 +609,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Grab the block gas limit for the latest block
-	latestBlockGasLimit := p.store.Header().GasLimit
+	latestBlockGasLimit := header.GasLimit
 
 	if tx.Gas > latestBlockGasLimit {
 		return ErrBlockLimitExceeded
@@ -652,6 +703,9 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 	promoted := account.promote()
 	p.logger.Debug("promote request", "promoted", promoted, "addr", addr.String())
 
+	// update state
+	atomic.AddUint64(&p.pendingGas, gasLimit(promoted...))
+
 	// update metrics
 	p.metrics.PendingTxs.Add(float64(len(promoted)))
 	p.eventManager.signalEvent(proto.EventType_PROMOTED, toHash(promoted...)...)
@@ -659,11 +713,18 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 
 // addGossipTx handles receiving transactions
 // gossiped by the network.
-func (p *TxPool) addGossipTx(obj interface{}) {
+func (p *TxPool) addGossipTx(obj interface{}, from peer.ID) {
 	if !p.sealing {
 		return
 	}
 
+	// the peer is only relaying the message, so exceeding the limit doesn't affect its score
+	if p.isRemotePeer(from) && !p.gossipPeers.allow(from) {
+		p.metrics.GossipRateLimitedTxs.Add(1)
+
+		return
+	}
+
 	raw, ok := obj.(*proto.Txn)
 	if !ok {
 		p.logger.Error("failed to cast gossiped message to txn")
@@ -674,6 +735,7 @@ func (p *TxPool) addGossipTx(obj interface{}) {
 	// Verify that the gossiped transaction message is not empty
 	if raw == nil || raw.Raw == nil {
 		p.logger.Error("malformed gossip transaction message received")
+		p.penalizeInvalidGossip(from, "malformed gossip message")
 
 		return
 	}
@@ -683,6 +745,7 @@ func (p *TxPool) addGossipTx(obj interface{}) {
 	// decode tx
 	if err := tx.UnmarshalRLP(raw.Raw.Value); err != nil {
 		p.logger.Error("failed to decode broadcast tx", "err", err)
+		p.penalizeInvalidGossip(from, "undecodable transaction")
 
 		return
 	}
@@ -691,11 +754,58 @@ func (p *TxPool) addGossipTx(obj interface{}) {
 	if err := p.addTx(gossip, tx); err != nil {
 		if errors.Is(err, ErrAlreadyKnown) {
 			p.logger.Debug("rejecting known tx (gossip)", "hash", tx.Hash.String())
+			p.updatePeerScore(from, duplicateGossipPenalty, "duplicate transactions")
 
 			return
 		}
 
 		p.logger.Error("failed to add broadcast tx", "err", err, "hash", tx.Hash.String())
+
+		if isInvalidGossipErr(err) {
+			p.penalizeInvalidGossip(from, err.Error())
+		}
+
+		return
+	}
+
+	p.updatePeerScore(from, validGossipReward, "")
+}
+
+// isRemotePeer checks if the gossiped message was relayed by another node
+func (p *TxPool) isRemotePeer(from peer.ID) bool {
+	return from != "" && from != p.localPeerID
+}
+
+// penalizeInvalidGossip lowers the score of a peer which relayed an invalid transaction
+func (p *TxPool) penalizeInvalidGossip(from peer.ID, reason string) {
+	p.metrics.GossipInvalidTxs.Add(1)
+	p.updatePeerScore(from, invalidGossipPenalty, reason)
+}
+
+// updatePeerScore applies the delta to the score of the peer which relayed a transaction,
+// and disconnects the peer if its score drops below the threshold
+func (p *TxPool) updatePeerScore(from peer.ID, delta int64, reason string) {
+	if !p.isRemotePeer(from) {
+		return
+	}
+
+	score := p.gossipPeers.updateScore(from, delta)
+	if score >= minPeerScore {
+		return
+	}
+
+	p.logger.Warn(
+		"disconnecting misbehaving gossip peer",
+		"peer", from.String(),
+		"score", score,
+		"reason", reason,
+	)
+
+	p.metrics.GossipDisconnectedPeers.Add(1)
+	p.gossipPeers.remove(from)
+
+	if p.disconnector != nil {
+		p.disconnector.DisconnectFromPeer(from, fmt.Sprintf("bad txpool gossip score: %s", reason))
 	}
 }
 
@@ -733,6 +843,7 @@ func (p *TxPool) resetAccounts(stateNonces map[types.Address]uint64) {
 	//	prune pool state
 	if len(allPrunedPromoted) > 0 {
 		cleanup(allPrunedPromoted...)
+		p.decreasePendingGas(allPrunedPromoted...)
 		p.eventManager.signalEvent(
 			proto.EventType_PRUNED_PROMOTED,
 			toHash(allPrunedPromoted...)...,
@@ -768,6 +879,28 @@ func (p *TxPool) Length() uint64 {
 	return p.accounts.promoted()
 }
 
+// PendingGas returns the gas limit of all promoted transactions.
+func (p *TxPool) PendingGas() uint64 {
+	return atomic.LoadUint64(&p.pendingGas)
+}
+
+// decreasePendingGas removes the gas limit of the transactions,
+// once they are no longer promoted
+func (p *TxPool) decreasePendingGas(txs ...*types.Transaction) {
+	if gas := gasLimit(txs...); gas != 0 {
+		atomic.AddUint64(&p.pendingGas, ^(gas - 1))
+	}
+}
+
+// gasLimit returns the total gas limit of the transactions
+func gasLimit(txs ...*types.Transaction) (total uint64) {
+	for _, tx := range txs {
+		total += tx.Gas
+	}
+
+	return
+}
+
 //	toHash returns the hash(es) of given transaction(s)
 func toHash(txs ...*types.Transaction) (hashes []types.Hash) {
 	for _, tx := range txs {
@@ -82,7 +82,7 @@ func newTestPoolWithSlots(maxSlots uint64, mockStore ...store) (*TxPool, error)
 
 	return NewTxPool(
 		hclog.NewNullLogger(),
-		forks.At(0),
+		forks,
 		storeToUse,
 		nil,
 		nil,
@@ -253,6 +253,42 @@ func TestAddTxErrors(t *testing.T) {
 		)
 	})
 
+	t.Run("ErrTxTypeNotSupported", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		// access list txs are not accepted before Berlin
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.AccessListTx
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrTxTypeNotSupported,
+		)
+	})
+
+	t.Run("ErrTipAboveFeeCap", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.forks = &chain.Forks{
+			Homestead: chain.NewFork(0),
+			Istanbul:  chain.NewFork(0),
+			Berlin:    chain.NewFork(0),
+			London:    chain.NewFork(0),
+		}
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.DynamicFeeTx
+		tx.GasTipCap = new(big.Int).Add(tx.GasPrice, big.NewInt(1))
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrTipAboveFeeCap,
+		)
+	})
+
 	t.Run("ErrAlreadyKnown", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
@@ -353,7 +389,7 @@ func TestAddGossipTx(t *testing.T) {
 					Value: signedTx.MarshalRLP(),
 				},
 			}
-			pool.addGossipTx(protoTx)
+			pool.addGossipTx(protoTx, "")
 		}()
 		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
 
@@ -382,7 +418,7 @@ func TestAddGossipTx(t *testing.T) {
 				Value: signedTx.MarshalRLP(),
 			},
 		}
-		pool.addGossipTx(protoTx)
+		pool.addGossipTx(protoTx, "")
 
 		assert.Equal(t, uint64(0), pool.accounts.get(sender).enqueued.length())
 	})
@@ -1045,7 +1081,7 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
 
 	// pop the tx
-	pool.Prepare()
+	pool.Prepare(0)
 	tx := pool.Peek()
 	pool.Pop(tx)
 
@@ -1053,6 +1089,38 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
 }
 
+func TestPendingGas(t *testing.T) {
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	assert.Equal(t, uint64(0), pool.PendingGas())
+
+	// send 2 txs and promote them
+	go func() {
+		assert.NoError(t, pool.addTx(local, newTx(addr1, 0, 1)))
+		assert.NoError(t, pool.addTx(local, newTx(addr1, 1, 1)))
+	}()
+
+	for i := 0; i < 2; i++ {
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	assert.Equal(t, 2*validGasLimit, pool.PendingGas())
+
+	// pop a tx
+	pool.Prepare(0)
+	pool.Pop(pool.Peek())
+
+	assert.Equal(t, validGasLimit, pool.PendingGas())
+
+	// drop the account
+	pool.Drop(pool.Peek())
+
+	assert.Equal(t, uint64(0), pool.PendingGas())
+}
+
 func TestDrop(t *testing.T) {
 	pool, err := newTestPool()
 	assert.NoError(t, err)
@@ -1071,7 +1139,7 @@ func TestDrop(t *testing.T) {
 	assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
 
 	// pop the tx
-	pool.Prepare()
+	pool.Prepare(0)
 	tx := pool.Peek()
 	pool.Drop(tx)
 
@@ -1104,7 +1172,7 @@ func TestDemote(t *testing.T) {
 		assert.Equal(t, uint(0), pool.accounts.get(addr1).demotions)
 
 		//	call demote
-		pool.Prepare()
+		pool.Prepare(0)
 		tx := pool.Peek()
 		pool.Demote(tx)
 
@@ -1140,7 +1208,7 @@ func TestDemote(t *testing.T) {
 		pool.accounts.get(addr1).demotions = maxAccountDemotions
 
 		//	call demote
-		pool.Prepare()
+		pool.Prepare(0)
 		tx := pool.Peek()
 		pool.Demote(tx)
 
@@ -1741,6 +1809,44 @@ func TestExecutablesOrder(t *testing.T) {
 	}
 }
 
+func TestPricedQueue_BaseFee(t *testing.T) {
+	t.Parallel()
+
+	newDynamicTx := func(addr types.Address, feeCap, tipCap uint64) *types.Transaction {
+		tx := newTx(addr, 0, 1)
+		tx.Type = types.DynamicFeeTx
+		tx.GasPrice.SetUint64(feeCap)
+		tx.GasTipCap = new(big.Int).SetUint64(tipCap)
+
+		return tx
+	}
+
+	var (
+		// pays the highest price, but the lowest tip once the base fee is paid
+		legacyTx = newTx(addr1, 0, 1)
+
+		// pays the highest tip, capped by the fee cap
+		cappedTx = newDynamicTx(addr2, 16, 10)
+
+		// pays the whole tip
+		tipTx = newDynamicTx(addr3, 20, 4)
+	)
+
+	legacyTx.GasPrice.SetUint64(12)
+
+	q := newPricedQueue()
+	q.setBaseFee(10)
+
+	for _, tx := range []*types.Transaction{legacyTx, tipTx, cappedTx} {
+		q.push(tx)
+	}
+
+	assert.Equal(t, cappedTx, q.pop())
+	assert.Equal(t, tipTx, q.pop())
+	assert.Equal(t, legacyTx, q.pop())
+	assert.Nil(t, q.pop())
+}
+
 type status int
 
 // Status of a transaction resulted
@@ -1919,7 +2025,7 @@ func TestRecovery(t *testing.T) {
 			assert.Len(t, waitForEvents(ctx, promoteSubscription, totalTx), totalTx)
 
 			func() {
-				pool.Prepare()
+				pool.Prepare(0)
 				for {
 					tx := pool.Peek()
 					if tx == nil {
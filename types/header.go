@@ -27,6 +27,7 @@ type Header struct {
 	ExtraData    []byte  `json:"extraData"`
 	MixHash      Hash    `json:"mixHash"`
 	Nonce        Nonce   `json:"nonce"`
+	BaseFee      uint64  `json:"baseFeePerGas"`
 	Hash         Hash    `json:"hash"`
 }
 
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrStateAndStateDiff is returned when the storage of an account is both replaced and patched
+var ErrStateAndStateDiff = errors.New("state and stateDiff can't be overridden together")
+
+// CallOverride is the hypothetical state and block context a call is executed with
+type CallOverride struct {
+	State StateOverride
+	// Block is applied on the header of the call by the caller,
+	// its coinbase also replaces the block creator recovered by the consensus
+	Block *BlockOverride
+}
+
+// StateOverride are the overridden accounts, by address
+type StateOverride map[Address]OverrideAccount
+
+// OverrideAccount is the overridden state of an account, the unset fields keep their value.
+// State replaces the whole storage of the account, while StateDiff only replaces the given slots
+type OverrideAccount struct {
+	Nonce     *uint64
+	Code      []byte
+	Balance   *big.Int
+	State     map[Hash]Hash
+	StateDiff map[Hash]Hash
+}
+
+// Validate checks the overrides can be applied
+func (o StateOverride) Validate() error {
+	for addr, account := range o {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("%w, account %s", ErrStateAndStateDiff, addr)
+		}
+	}
+
+	return nil
+}
+
+// BlockOverride is the overridden context of the block, the unset fields keep their value
+type BlockOverride struct {
+	Number     *uint64
+	Difficulty *uint64
+	Time       *uint64
+	GasLimit   *uint64
+	Coinbase   *Address
+	BaseFee    *uint64
+}
+
+// Apply returns a copy of the header with the overridden fields
+func (o *BlockOverride) Apply(header *Header) *Header {
+	if o == nil {
+		return header
+	}
+
+	header = header.Copy()
+
+	if o.Number != nil {
+		header.Number = *o.Number
+	}
+
+	if o.Difficulty != nil {
+		header.Difficulty = *o.Difficulty
+	}
+
+	if o.Time != nil {
+		header.Timestamp = *o.Time
+	}
+
+	if o.GasLimit != nil {
+		header.GasLimit = *o.GasLimit
+	}
+
+	if o.Coinbase != nil {
+		header.Miner = *o.Coinbase
+	}
+
+	if o.BaseFee != nil {
+		header.BaseFee = *o.BaseFee
+	}
+
+	return header
+}
@@ -28,6 +28,9 @@ type Receipt struct {
 	Logs              []*Log
 	Status            *ReceiptStatus
 
+	// TransactionType is the type of the transaction the receipt belongs to
+	TransactionType TxType
+
 	// context fields
 	GasUsed         uint64
 	ContractAddress *Address
@@ -5,7 +5,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/fastrlp"
 )
 
 type codec interface {
@@ -129,3 +131,216 @@ func TestRLPUnmarshal_Header_ComputeHash(t *testing.T) {
 	assert.NoError(t, h2.UnmarshalRLP(data))
 	assert.Equal(t, h.Hash, h2.Hash)
 }
+
+func TestRLPMarshall_And_Unmarshall_AccessListTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	txn := &Transaction{
+		Type:     AccessListTx,
+		ChainID:  big.NewInt(100),
+		Nonce:    1,
+		GasPrice: big.NewInt(11),
+		Gas:      11,
+		To:       &addrTo,
+		Value:    big.NewInt(1),
+		Input:    []byte{1, 2},
+		V:        big.NewInt(1),
+		S:        big.NewInt(26),
+		R:        big.NewInt(27),
+		AccessList: TxAccessList{
+			{
+				Address:     addrTo,
+				StorageKeys: []Hash{StringToHash("1"), StringToHash("2")},
+			},
+		},
+	}
+	txn.ComputeHash()
+
+	marshaledRlp := txn.MarshalRLP()
+
+	// typed transactions are encoded as an envelope prefixed by the type
+	assert.Equal(t, byte(AccessListTx), marshaledRlp[0])
+	assert.Equal(t, BytesToHash(keccak.Keccak256(nil, marshaledRlp)), txn.Hash)
+
+	unmarshalledTxn := new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// typed transactions in blocks and in storage
+	block := &Block{
+		Header:       &Header{},
+		Transactions: []*Transaction{txn},
+	}
+
+	unmarshalledBlock := new(Block)
+	assert.NoError(t, unmarshalledBlock.UnmarshalRLP(block.MarshalRLP()))
+	assert.Equal(t, txn, unmarshalledBlock.Transactions[0])
+
+	txn.From = StringToAddress("22")
+
+	unmarshalledTxn = new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalStoreRLP(txn.MarshalStoreRLPTo(nil)))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// the payload is applied by consensus, so it must not carry extra elements
+	unmarshalledTxn = new(Transaction)
+	assert.Error(t, unmarshalledTxn.UnmarshalRLP(appendTypedPayloadElem(t, marshaledRlp)))
+}
+
+func TestRLPMarshall_And_Unmarshall_DynamicFeeTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	txn := &Transaction{
+		Type:      DynamicFeeTx,
+		ChainID:   big.NewInt(100),
+		Nonce:     1,
+		GasPrice:  big.NewInt(20),
+		GasTipCap: big.NewInt(2),
+		Gas:       11,
+		To:        &addrTo,
+		Value:     big.NewInt(1),
+		Input:     []byte{1, 2},
+		V:         big.NewInt(1),
+		S:         big.NewInt(26),
+		R:         big.NewInt(27),
+		AccessList: TxAccessList{
+			{
+				Address:     addrTo,
+				StorageKeys: []Hash{StringToHash("1")},
+			},
+		},
+	}
+	txn.ComputeHash()
+
+	marshaledRlp := txn.MarshalRLP()
+	assert.Equal(t, byte(DynamicFeeTx), marshaledRlp[0])
+
+	unmarshalledTxn := new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	txn.From = StringToAddress("22")
+
+	unmarshalledTxn = new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalStoreRLP(txn.MarshalStoreRLPTo(nil)))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// the payload is applied by consensus, so it must not carry extra elements
+	unmarshalledTxn = new(Transaction)
+	assert.Error(t, unmarshalledTxn.UnmarshalRLP(appendTypedPayloadElem(t, marshaledRlp)))
+}
+
+func TestRLPMarshall_And_Unmarshall_HeaderBaseFee(t *testing.T) {
+	header := &Header{
+		Number:  10,
+		BaseFee: 1000,
+	}
+	header.ComputeHash()
+
+	unmarshalledHeader := new(Header)
+	assert.NoError(t, unmarshalledHeader.UnmarshalRLP(header.MarshalRLP()))
+	assert.Equal(t, uint64(1000), unmarshalledHeader.BaseFee)
+	assert.Equal(t, header.Hash, unmarshalledHeader.Hash)
+
+	// the base fee changes the header hash
+	legacyHeader := header.Copy()
+	legacyHeader.BaseFee = 0
+	legacyHeader.ComputeHash()
+
+	assert.NotEqual(t, header.Hash, legacyHeader.Hash)
+
+	unmarshalledHeader = new(Header)
+	assert.NoError(t, unmarshalledHeader.UnmarshalRLP(legacyHeader.MarshalRLP()))
+	assert.Equal(t, uint64(0), unmarshalledHeader.BaseFee)
+}
+
+func TestRLPUnmarshal_UnsupportedTxType(t *testing.T) {
+	txn := new(Transaction)
+	assert.ErrorIs(t, txn.UnmarshalRLP([]byte{0x7f, 0xc0}), ErrTxTypeNotSupported)
+}
+
+// appendTypedPayloadElem returns the typed transaction envelope with an extra element at the end of its payload
+func appendTypedPayloadElem(t *testing.T, envelope []byte) []byte {
+	t.Helper()
+
+	p := &fastrlp.Parser{}
+
+	v, err := p.Parse(envelope[1:])
+	assert.NoError(t, err)
+
+	elems, err := v.GetElems()
+	assert.NoError(t, err)
+
+	arena := &fastrlp.Arena{}
+
+	payload := arena.NewArray()
+	for _, elem := range elems {
+		payload.Set(elem)
+	}
+
+	payload.Set(arena.NewNull())
+
+	return payload.MarshalTo([]byte{envelope[0]})
+}
+
+func TestRLPUnmarshal_TypedTransactionTrailingElems(t *testing.T) {
+	addrTo := StringToAddress("11")
+
+	for _, txType := range []TxType{AccessListTx, DynamicFeeTx} {
+		txn := &Transaction{
+			Type:      txType,
+			ChainID:   big.NewInt(100),
+			GasPrice:  big.NewInt(20),
+			GasTipCap: big.NewInt(2),
+			To:        &addrTo,
+			Value:     big.NewInt(1),
+			V:         big.NewInt(1),
+			S:         big.NewInt(26),
+			R:         big.NewInt(27),
+		}
+
+		unmarshalledTxn := new(Transaction)
+		assert.Error(t, unmarshalledTxn.UnmarshalRLP(appendTypedPayloadElem(t, txn.MarshalRLP())), txType)
+	}
+}
+
+func TestRLPStorage_Marshall_And_Unmarshall_TypedReceipt(t *testing.T) {
+	receipt := &Receipt{
+		CumulativeGasUsed: 10,
+		GasUsed:           100,
+		TxHash:            StringToHash("10"),
+		TransactionType:   AccessListTx,
+	}
+	receipt.SetStatus(ReceiptSuccess)
+
+	data := receipt.MarshalRLP()
+	assert.Equal(t, byte(AccessListTx), data[0])
+
+	unmarshalledReceipt := new(Receipt)
+	assert.NoError(t, unmarshalledReceipt.UnmarshalRLP(data))
+	assert.Equal(t, AccessListTx, unmarshalledReceipt.TransactionType)
+
+	unmarshalledReceipt = new(Receipt)
+	assert.NoError(t, unmarshalledReceipt.UnmarshalStoreRLP(receipt.MarshalStoreRLPTo(nil)))
+	assert.Exactly(t, receipt, unmarshalledReceipt)
+}
+
+func TestRLPMarshall_And_Unmarshall_SystemTransaction(t *testing.T) {
+	txn := NewSystemTx(big.NewInt(100), 5, StringToAddress("1001"), []byte{1, 2})
+	txn.ComputeHash()
+
+	marshaledRlp := txn.MarshalRLP()
+	assert.Equal(t, byte(SystemTx), marshaledRlp[0])
+
+	// the sender is part of the payload, since system transactions aren't signed
+	unmarshalledTxn := new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+	assert.Equal(t, txn, unmarshalledTxn)
+	assert.Equal(t, SystemAddress, unmarshalledTxn.From)
+
+	unmarshalledTxn = new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalStoreRLP(txn.MarshalStoreRLPTo(nil)))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// the payload is applied by consensus, so it must not carry extra elements
+	unmarshalledTxn = new(Transaction)
+	assert.Error(t, unmarshalledTxn.UnmarshalRLP(appendTypedPayloadElem(t, marshaledRlp)))
+}
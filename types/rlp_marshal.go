@@ -83,6 +83,11 @@ func (h *Header) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewBytes(h.MixHash.Bytes()))
 	vv.Set(arena.NewCopyBytes(h.Nonce[:]))
 
+	// the base fee is only present from the london fork on (EIP-1559)
+	if h.BaseFee != 0 {
+		vv.Set(arena.NewUint(h.BaseFee))
+	}
+
 	return vv
 }
 
@@ -103,12 +108,27 @@ func (r *Receipt) MarshalRLP() []byte {
 	return r.MarshalRLPTo(nil)
 }
 
+// MarshalRLPTo appends the consensus encoding of the receipt to dst.
+// Receipts of typed transactions are encoded as the EIP-2718 envelope (type || payload)
 func (r *Receipt) MarshalRLPTo(dst []byte) []byte {
-	return MarshalRLPTo(r.MarshalRLPWith, dst)
+	if r.TransactionType != LegacyTx {
+		dst = append(dst, byte(r.TransactionType))
+	}
+
+	return MarshalRLPTo(r.marshalPayloadRLPWith, dst)
 }
 
-// MarshalRLPWith marshals a receipt with a specific fastrlp.Arena
+// MarshalRLPWith marshals a receipt with a specific fastrlp.Arena.
+// Inside of RLP lists, typed receipts are included as a byte string holding the envelope
 func (r *Receipt) MarshalRLPWith(a *fastrlp.Arena) *fastrlp.Value {
+	if r.TransactionType != LegacyTx {
+		return a.NewBytes(r.MarshalRLPTo(nil))
+	}
+
+	return r.marshalPayloadRLPWith(a)
+}
+
+func (r *Receipt) marshalPayloadRLPWith(a *fastrlp.Arena) *fastrlp.Value {
 	vv := a.NewArray()
 	if r.Status != nil {
 		vv.Set(a.NewUint(uint64(*r.Status)))
@@ -158,15 +178,39 @@ func (t *Transaction) MarshalRLP() []byte {
 	return t.MarshalRLPTo(nil)
 }
 
+// MarshalRLPTo appends the consensus encoding of the transaction to dst.
+// Typed transactions are encoded as the EIP-2718 envelope (type || payload)
 func (t *Transaction) MarshalRLPTo(dst []byte) []byte {
-	return MarshalRLPTo(t.MarshalRLPWith, dst)
+	if t.IsTyped() {
+		dst = append(dst, byte(t.Type))
+	}
+
+	return MarshalRLPTo(t.marshalPayloadRLPWith, dst)
 }
 
-// MarshalRLPWith marshals the transaction to RLP with a specific fastrlp.Arena
+// MarshalRLPWith marshals the transaction to RLP with a specific fastrlp.Arena.
+// Inside of RLP lists, typed transactions are included as a byte string holding the envelope
 func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	if t.IsTyped() {
+		return arena.NewBytes(t.MarshalRLPTo(nil))
+	}
+
+	return t.marshalPayloadRLPWith(arena)
+}
+
+func (t *Transaction) marshalPayloadRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv := arena.NewArray()
 
+	if t.IsTyped() {
+		vv.Set(arena.NewBigInt(t.ChainID))
+	}
+
 	vv.Set(arena.NewUint(t.Nonce))
+
+	if t.IsDynamicFee() {
+		vv.Set(arena.NewBigInt(t.GasTipCap))
+	}
+
 	vv.Set(arena.NewBigInt(t.GasPrice))
 	vv.Set(arena.NewUint(t.Gas))
 
@@ -180,10 +224,43 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewBigInt(t.Value))
 	vv.Set(arena.NewCopyBytes(t.Input))
 
+	if t.hasAccessList() {
+		vv.Set(t.AccessList.MarshalRLPWith(arena))
+	}
+
 	// signature values
 	vv.Set(arena.NewBigInt(t.V))
 	vv.Set(arena.NewBigInt(t.R))
 	vv.Set(arena.NewBigInt(t.S))
 
+	// system transactions carry their sender, since they aren't signed
+	if t.IsSystem() {
+		vv.Set(arena.NewBytes(t.From.Bytes()))
+	}
+
+	return vv
+}
+
+// MarshalRLPWith marshals the access list to RLP with a specific fastrlp.Arena
+func (al TxAccessList) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	if len(al) == 0 {
+		return arena.NewNullArray()
+	}
+
+	vv := arena.NewArray()
+
+	for _, tuple := range al {
+		accessTuple := arena.NewArray()
+		accessTuple.Set(arena.NewCopyBytes(tuple.Address.Bytes()))
+
+		storageKeys := arena.NewArray()
+		for _, key := range tuple.StorageKeys {
+			storageKeys.Set(arena.NewCopyBytes(key.Bytes()))
+		}
+
+		accessTuple.Set(storageKeys)
+		vv.Set(accessTuple)
+	}
+
 	return vv
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/umbracle/fastrlp"
 )
 
@@ -165,6 +166,14 @@ func (h *Header) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 
 	h.SetNonce(nonce)
 
+	// baseFee
+	h.BaseFee = 0
+	if len(elems) > 15 {
+		if h.BaseFee, err = elems[15].GetUint64(); err != nil {
+			return err
+		}
+	}
+
 	// compute the hash after the decoding
 	h.ComputeHash()
 
@@ -193,12 +202,45 @@ func (r *Receipts) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	return nil
 }
 
+// UnmarshalRLP unmarshals the consensus encoding of a receipt,
+// either a legacy RLP list or an EIP-2718 envelope
 func (r *Receipt) UnmarshalRLP(input []byte) error {
+	if isTypedEnvelope(input) {
+		return r.unmarshalEnvelope(input)
+	}
+
 	return UnmarshalRlp(r.UnmarshalRLPFrom, input)
 }
 
 // UnmarshalRLP unmarshals a Receipt in RLP format
 func (r *Receipt) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	if v.Type() == fastrlp.TypeBytes {
+		// typed receipts are included in RLP lists as byte strings
+		envelope, err := v.Bytes()
+		if err != nil {
+			return err
+		}
+
+		return r.unmarshalEnvelope(envelope)
+	}
+
+	r.TransactionType = LegacyTx
+
+	return r.unmarshalPayloadFrom(p, v)
+}
+
+// unmarshalEnvelope unmarshals a typed receipt envelope (type || payload)
+func (r *Receipt) unmarshalEnvelope(envelope []byte) error {
+	if !isTypedEnvelope(envelope) {
+		return fmt.Errorf("invalid receipt envelope")
+	}
+
+	r.TransactionType = TxType(envelope[0])
+
+	return UnmarshalRlp(r.unmarshalPayloadFrom, envelope[1:])
+}
+
+func (r *Receipt) unmarshalPayloadFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	elems, err := v.GetElems()
 	if err != nil {
 		return err
@@ -288,12 +330,28 @@ func (l *Log) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	return nil
 }
 
+// UnmarshalRLP unmarshals the consensus encoding of a transaction,
+// either a legacy RLP list or an EIP-2718 envelope
 func (t *Transaction) UnmarshalRLP(input []byte) error {
+	if isTypedEnvelope(input) {
+		return t.unmarshalEnvelope(input)
+	}
+
 	return UnmarshalRlp(t.UnmarshalRLPFrom, input)
 }
 
-// UnmarshalRLP unmarshals a Transaction in RLP format
+// UnmarshalRLPFrom unmarshals a Transaction in RLP format
 func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	if v.Type() == fastrlp.TypeBytes {
+		// typed transactions are included in RLP lists as byte strings
+		envelope, err := v.Bytes()
+		if err != nil {
+			return err
+		}
+
+		return t.unmarshalEnvelope(envelope)
+	}
+
 	elems, err := v.GetElems()
 	if err != nil {
 		return err
@@ -303,8 +361,123 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return fmt.Errorf("incorrect number of elements to decode transaction, expected 9 but found %d", len(elems))
 	}
 
+	t.Type = LegacyTx
+	t.ChainID = nil
+	t.AccessList = nil
+	t.GasTipCap = nil
+
 	p.Hash(t.Hash[:0], v)
 
+	return t.unmarshalFields(elems)
+}
+
+// unmarshalEnvelope unmarshals a typed transaction envelope (type || payload)
+func (t *Transaction) unmarshalEnvelope(envelope []byte) error {
+	if !isTypedEnvelope(envelope) {
+		return fmt.Errorf("invalid transaction envelope")
+	}
+
+	t.Type = TxType(envelope[0])
+
+	unmarshalPayload := t.unmarshalTypedPayloadFrom
+
+	switch t.Type {
+	case AccessListTx, DynamicFeeTx:
+	case SystemTx:
+		unmarshalPayload = t.unmarshalSystemPayloadFrom
+	default:
+		return fmt.Errorf("%w: %d", ErrTxTypeNotSupported, t.Type)
+	}
+
+	if err := UnmarshalRlp(unmarshalPayload, envelope[1:]); err != nil {
+		return err
+	}
+
+	keccak.Keccak256(t.Hash[:0], envelope)
+
+	return nil
+}
+
+func (t *Transaction) unmarshalTypedPayloadFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	// dynamic fee transactions have the tip cap in front of the fee cap
+	feeFields := 1
+	if t.IsDynamicFee() {
+		feeFields = 2
+	}
+
+	if expected := 10 + feeFields; len(elems) != expected {
+		return fmt.Errorf("incorrect number of elements to decode %s, expected %d but found %d", t.Type, expected, len(elems))
+	}
+
+	// chainID
+	t.ChainID = new(big.Int)
+	if err := elems[0].GetBigInt(t.ChainID); err != nil {
+		return err
+	}
+
+	// gasTipCap
+	t.GasTipCap = nil
+	if t.IsDynamicFee() {
+		t.GasTipCap = new(big.Int)
+		if err := elems[2].GetBigInt(t.GasTipCap); err != nil {
+			return err
+		}
+	}
+
+	// access list, placed between the input and the signature values
+	accessListIndx := 6 + feeFields
+
+	t.AccessList = nil
+	if err := t.AccessList.unmarshalRLPFrom(elems[accessListIndx]); err != nil {
+		return err
+	}
+
+	// nonce, gasPrice (the fee cap of dynamic fee transactions), gas, to, value, input, v, r and s
+	fields := make([]*fastrlp.Value, 0, 9)
+	fields = append(fields, elems[1])
+	fields = append(fields, elems[feeFields+1:accessListIndx]...)
+	fields = append(fields, elems[accessListIndx+1:accessListIndx+4]...)
+
+	return t.unmarshalFields(fields)
+}
+
+// unmarshalSystemPayloadFrom unmarshals the payload of a system transaction:
+// the chain ID, the fields of the legacy transactions and the sender
+func (t *Transaction) unmarshalSystemPayloadFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if len(elems) != 11 {
+		return fmt.Errorf("incorrect number of elements to decode %s, expected 11 but found %d", t.Type, len(elems))
+	}
+
+	t.ChainID = new(big.Int)
+	if err := elems[0].GetBigInt(t.ChainID); err != nil {
+		return err
+	}
+
+	t.AccessList = nil
+	t.GasTipCap = nil
+
+	if err := t.unmarshalFields(elems[1:10]); err != nil {
+		return err
+	}
+
+	return elems[10].GetAddr(t.From[:])
+}
+
+// unmarshalFields unmarshals the fields shared by all the transaction types:
+// nonce, gasPrice, gas, to, value, input, v, r and s
+func (t *Transaction) unmarshalFields(elems []*fastrlp.Value) error {
+	var err error
+
 	// nonce
 	if t.Nonce, err = elems[0].GetUint64(); err != nil {
 		return err
@@ -319,7 +492,7 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return err
 	}
 	// to
-	if vv, _ := v.Get(3).Bytes(); len(vv) == 20 {
+	if vv, _ := elems[3].Bytes(); len(vv) == 20 {
 		// address
 		addr := BytesToAddress(vv)
 		t.To = &addr
@@ -356,3 +529,49 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 
 	return nil
 }
+
+func (al *TxAccessList) unmarshalRLPFrom(v *fastrlp.Value) error {
+	tuples, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	for _, tuple := range tuples {
+		elems, err := tuple.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(elems) != 2 {
+			return fmt.Errorf("incorrect number of elements to decode access tuple, expected 2 but found %d", len(elems))
+		}
+
+		accessTuple := AccessTuple{}
+		if err := elems[0].GetAddr(accessTuple.Address[:]); err != nil {
+			return err
+		}
+
+		keys, err := elems[1].GetElems()
+		if err != nil {
+			return err
+		}
+
+		accessTuple.StorageKeys = make([]Hash, len(keys))
+
+		for indx, key := range keys {
+			if err := key.GetHash(accessTuple.StorageKeys[indx][:]); err != nil {
+				return err
+			}
+		}
+
+		*al = append(*al, accessTuple)
+	}
+
+	return nil
+}
+
+// isTypedEnvelope checks if the encoding is an EIP-2718 envelope.
+// The first byte of an RLP list is always bigger than 0x7f
+func isTypedEnvelope(input []byte) bool {
+	return len(input) > 0 && input[0] <= 0x7f
+}
@@ -1,13 +1,96 @@
 package types
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"sync/atomic"
 
 	"github.com/0xPolygon/polygon-edge/helper/keccak"
 )
 
+var (
+	ErrTxTypeNotSupported = errors.New("transaction type not supported")
+)
+
+// TxType is the type of the transaction envelope (EIP-2718)
+type TxType byte
+
+const (
+	// LegacyTx is the type of the untyped transactions, preceding EIP-2718
+	LegacyTx TxType = 0x0
+
+	// AccessListTx is the type of the transactions carrying an access list (EIP-2930)
+	AccessListTx TxType = 0x1
+
+	// DynamicFeeTx is the type of the transactions paying the base fee and a tip (EIP-1559)
+	DynamicFeeTx TxType = 0x2
+
+	// SystemTx is the type of the transactions added to the blocks by the consensus.
+	// They are sent by the system address, carry no signature and pay no gas
+	SystemTx TxType = 0x7e
+)
+
+// SystemAddress is the sender of the system transactions
+var SystemAddress = StringToAddress("0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE")
+
+func (t TxType) String() string {
+	switch t {
+	case LegacyTx:
+		return "LegacyTx"
+	case AccessListTx:
+		return "AccessListTx"
+	case DynamicFeeTx:
+		return "DynamicFeeTx"
+	case SystemTx:
+		return "SystemTx"
+	default:
+		return fmt.Sprintf("TxType(%d)", byte(t))
+	}
+}
+
+// AccessTuple is an address and the storage keys the transaction
+// is going to access in the account
+type AccessTuple struct {
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
+}
+
+// TxAccessList is the list of addresses and storage keys
+// warmed up before the transaction execution (EIP-2930)
+type TxAccessList []AccessTuple
+
+// StorageKeys returns the total number of storage keys in the access list
+func (al TxAccessList) StorageKeys() int {
+	count := 0
+	for _, tuple := range al {
+		count += len(tuple.StorageKeys)
+	}
+
+	return count
+}
+
+// Copy returns a deep copy of the access list
+func (al TxAccessList) Copy() TxAccessList {
+	if al == nil {
+		return nil
+	}
+
+	newAccessList := make(TxAccessList, len(al))
+
+	for i, tuple := range al {
+		newAccessList[i] = AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]Hash{}, tuple.StorageKeys...),
+		}
+	}
+
+	return newAccessList
+}
+
 type Transaction struct {
+	Type     TxType
+	ChainID  *big.Int
 	Nonce    uint64
 	GasPrice *big.Int
 	Gas      uint64
@@ -20,6 +103,13 @@ type Transaction struct {
 	Hash     Hash
 	From     Address
 
+	// AccessList is only set for typed transactions
+	AccessList TxAccessList
+
+	// GasTipCap is only set for dynamic fee transactions,
+	// for which GasPrice holds the fee cap (max fee per gas)
+	GasTipCap *big.Int
+
 	// Cache
 	size atomic.Value
 }
@@ -28,8 +118,77 @@ func (t *Transaction) IsContractCreation() bool {
 	return t.To == nil
 }
 
-// ComputeHash computes the hash of the transaction
+// IsTyped returns true if the transaction is wrapped in an EIP-2718 envelope
+func (t *Transaction) IsTyped() bool {
+	return t.Type != LegacyTx
+}
+
+// IsDynamicFee returns true if the transaction pays the base fee and a tip (EIP-1559)
+func (t *Transaction) IsDynamicFee() bool {
+	return t.Type == DynamicFeeTx
+}
+
+// IsSystem returns true if the transaction has been added to the block by the consensus
+func (t *Transaction) IsSystem() bool {
+	return t.Type == SystemTx
+}
+
+// hasAccessList returns true if the encoding of the transaction carries an access list (EIP-2930)
+func (t *Transaction) hasAccessList() bool {
+	return t.Type == AccessListTx || t.Type == DynamicFeeTx
+}
+
+// NewSystemTx creates a system transaction calling the given contract
+func NewSystemTx(chainID *big.Int, nonce uint64, to Address, input []byte) *Transaction {
+	return &Transaction{
+		Type:     SystemTx,
+		ChainID:  new(big.Int).Set(chainID),
+		Nonce:    nonce,
+		GasPrice: big.NewInt(0),
+		To:       &to,
+		Value:    big.NewInt(0),
+		Input:    input,
+		V:        big.NewInt(0),
+		R:        big.NewInt(0),
+		S:        big.NewInt(0),
+		From:     SystemAddress,
+	}
+}
+
+// EffectiveGasPrice returns the price per gas paid by the transaction in a block with the given base fee.
+// Dynamic fee transactions pay the base fee plus the tip, capped by the fee cap
+func (t *Transaction) EffectiveGasPrice(baseFee uint64) *big.Int {
+	if !t.IsDynamicFee() {
+		return new(big.Int).Set(t.GasPrice)
+	}
+
+	price := new(big.Int).SetUint64(baseFee)
+	price.Add(price, t.GasTipCap)
+
+	if price.Cmp(t.GasPrice) > 0 {
+		return new(big.Int).Set(t.GasPrice)
+	}
+
+	return price
+}
+
+// EffectiveTip returns the price per gas left to the block creator once the base fee is paid.
+// The result is negative if the transaction can't pay the base fee
+func (t *Transaction) EffectiveTip(baseFee uint64) *big.Int {
+	tip := t.EffectiveGasPrice(baseFee)
+
+	return tip.Sub(tip, new(big.Int).SetUint64(baseFee))
+}
+
+// ComputeHash computes the hash of the transaction.
+// The hash of typed transactions is the hash of the whole envelope
 func (t *Transaction) ComputeHash() *Transaction {
+	if t.IsTyped() {
+		keccak.Keccak256(t.Hash[:0], t.MarshalRLP())
+
+		return t
+	}
+
 	ar := marshalArenaPool.Get()
 	hash := keccak.DefaultKeccakPool.Get()
 
@@ -56,6 +215,14 @@ func (t *Transaction) Copy() *Transaction {
 		tt.Value.Set(t.Value)
 	}
 
+	if t.ChainID != nil {
+		tt.ChainID = new(big.Int).Set(t.ChainID)
+	}
+
+	if t.GasTipCap != nil {
+		tt.GasTipCap = new(big.Int).Set(t.GasTipCap)
+	}
+
 	if t.R != nil {
 		tt.R = new(big.Int)
 		tt.R = big.NewInt(0).SetBits(t.R.Bits())
@@ -69,10 +236,13 @@ func (t *Transaction) Copy() *Transaction {
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
+	tt.AccessList = t.AccessList.Copy()
+
 	return tt
 }
 
-// Cost returns gas * gasPrice + value
+// Cost returns gas * gasPrice + value.
+// For dynamic fee transactions the fee cap is used as the gas price
 func (t *Transaction) Cost() *big.Int {
 	total := new(big.Int).Mul(t.GasPrice, new(big.Int).SetUint64(t.Gas))
 	total.Add(total, t.Value)
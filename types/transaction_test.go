@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_EffectiveGasPrice(t *testing.T) {
+	testTable := []struct {
+		name          string
+		txn           *Transaction
+		baseFee       uint64
+		expectedPrice int64
+		expectedTip   int64
+	}{
+		{
+			"legacy transaction pays the gas price",
+			&Transaction{GasPrice: big.NewInt(10)},
+			4,
+			10,
+			6,
+		},
+		{
+			"dynamic fee transaction pays the base fee plus the tip",
+			&Transaction{Type: DynamicFeeTx, GasPrice: big.NewInt(10), GasTipCap: big.NewInt(2)},
+			4,
+			6,
+			2,
+		},
+		{
+			"dynamic fee transaction is capped by the fee cap",
+			&Transaction{Type: DynamicFeeTx, GasPrice: big.NewInt(10), GasTipCap: big.NewInt(8)},
+			4,
+			10,
+			6,
+		},
+		{
+			"fee cap below the base fee",
+			&Transaction{Type: DynamicFeeTx, GasPrice: big.NewInt(3), GasTipCap: big.NewInt(1)},
+			4,
+			3,
+			-1,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(
+				t,
+				big.NewInt(testCase.expectedPrice),
+				testCase.txn.EffectiveGasPrice(testCase.baseFee),
+			)
+			assert.Equal(
+				t,
+				big.NewInt(testCase.expectedTip),
+				testCase.txn.EffectiveTip(testCase.baseFee),
+			)
+		})
+	}
+}